@@ -0,0 +1,156 @@
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+// AggregateFunc reduces the values of one day to a single value.
+// NaN values (missing data) are skipped; if every value is missing the result is NaN.
+type AggregateFunc func(values []float64) float64
+
+// Aggregations commonly used to derive daily values from hourly data.
+var (
+	// AggregateSum adds up the values (e.g., daily precipitation totals)
+	AggregateSum AggregateFunc = aggregateSum
+
+	// AggregateMean averages the values (e.g., mean daily temperature)
+	AggregateMean AggregateFunc = aggregateMean
+
+	// AggregateMin returns the smallest value (e.g., daily minimum temperature)
+	AggregateMin AggregateFunc = aggregateMin
+
+	// AggregateMax returns the largest value (e.g., daily maximum wind gusts)
+	AggregateMax AggregateFunc = aggregateMax
+)
+
+// DayValue is the aggregate of a variable over one local calendar day.
+type DayValue struct {
+	// Date is local midnight at the start of the day, in the series' Location
+	Date time.Time
+
+	// Value is the aggregated value (NaN if no data was available)
+	Value float64
+
+	// Steps is the number of time steps that fell on this day
+	// (23 or 25 for complete hourly days across DST transitions)
+	Steps int
+
+	// Length is the duration of the local day (23h or 25h on DST transition days)
+	Length time.Duration
+}
+
+// LocalDayBounds returns the start (local midnight) and end (next local midnight) of the
+// calendar day containing t in loc. Days on which daylight saving time starts or ends
+// are 23 or 25 hours long; the bounds account for this instead of assuming 24 hours.
+func LocalDayBounds(t time.Time, loc *time.Location) (start, end time.Time) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc), time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+}
+
+// AggregateDaily groups the values of variable v by local calendar day in the series'
+// Location (see WithTimezone) and reduces each day with fn. Day boundaries follow the
+// local clock, so DST transition days contain 23 or 25 hourly steps.
+// It returns nil if the variable is not present in the series.
+//
+// Example:
+//
+//	totals := forecast.Hourly.AggregateDaily(openmeteo.HourlyPrecipitation, openmeteo.AggregateSum)
+//	for _, d := range totals {
+//	    fmt.Printf("%s: %.1f mm\n", d.Date.Format("Mon 02 Jan"), d.Value)
+//	}
+func (s *Series) AggregateDaily(v Variable, fn AggregateFunc) []DayValue {
+	values, ok := s.Values[v]
+	if !ok {
+		return nil
+	}
+
+	var days []DayValue
+	var bucket []float64
+	var start, end time.Time
+	flush := func() {
+		if bucket == nil {
+			return
+		}
+		days = append(days, DayValue{
+			Date:   start,
+			Value:  fn(bucket),
+			Steps:  len(bucket),
+			Length: end.Sub(start),
+		})
+		bucket = nil
+	}
+
+	for i, t := range s.Time {
+		if bucket == nil || !t.Before(end) || t.Before(start) {
+			flush()
+			start, end = LocalDayBounds(t, s.Location)
+		}
+		bucket = append(bucket, values[i])
+	}
+	flush()
+
+	return days
+}
+
+// nonMissing returns the values that are not NaN.
+func nonMissing(values []float64) []float64 {
+	out := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// aggregateSum returns the sum of the non-missing values, or NaN if all are missing.
+func aggregateSum(values []float64) float64 {
+	vals := nonMissing(values)
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for _, v := range vals {
+		sum += v
+	}
+	return sum
+}
+
+// aggregateMean returns the mean of the non-missing values, or NaN if all are missing.
+func aggregateMean(values []float64) float64 {
+	vals := nonMissing(values)
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	return aggregateSum(vals) / float64(len(vals))
+}
+
+// aggregateMin returns the smallest non-missing value, or NaN if all are missing.
+func aggregateMin(values []float64) float64 {
+	vals := nonMissing(values)
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		m = math.Min(m, v)
+	}
+	return m
+}
+
+// aggregateMax returns the largest non-missing value, or NaN if all are missing.
+func aggregateMax(values []float64) float64 {
+	vals := nonMissing(values)
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		m = math.Max(m, v)
+	}
+	return m
+}
@@ -0,0 +1,173 @@
+package openmeteo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// berlin loads Europe/Berlin or skips the test if the time zone database is unavailable
+func berlin(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+	return loc
+}
+
+// localHourlyBlock builds an hourly JSON block as the API emits it in a local time zone:
+// one entry per elapsed hour, with the repeated hour listed twice when DST ends.
+func localHourlyBlock(t *testing.T, start time.Time, hours int, loc *time.Location) map[string]json.RawMessage {
+	t.Helper()
+	times := make([]string, hours)
+	values := make([]string, hours)
+	for i := 0; i < hours; i++ {
+		times[i] = fmt.Sprintf("%q", start.Add(time.Duration(i)*time.Hour).In(loc).Format(apiTimeLayout))
+		values[i] = "1"
+	}
+	raw := fmt.Sprintf(`{"time": [%s], "precipitation": [%s]}`, strings.Join(times, ","), strings.Join(values, ","))
+
+	var block map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &block); err != nil {
+		t.Fatalf("Failed to build block: %v", err)
+	}
+	return block
+}
+
+// TestParseSeries_DSTFallBack tests that a repeated local hour keeps the series monotonic
+func TestParseSeries_DSTFallBack(t *testing.T) {
+	loc := berlin(t)
+	start := time.Date(2025, 10, 26, 0, 0, 0, 0, loc)
+
+	s, err := parseSeries(localHourlyBlock(t, start, 6, loc), nil, loc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i := 1; i < s.Len(); i++ {
+		if got := s.Time[i].Sub(s.Time[i-1]); got != time.Hour {
+			t.Errorf("Expected 1h between steps %d and %d, got %v", i-1, i, got)
+		}
+	}
+}
+
+// TestAggregateDaily_DST tests daily aggregation across 23h and 25h days
+func TestAggregateDaily_DST(t *testing.T) {
+	loc := berlin(t)
+
+	testCases := []struct {
+		name     string
+		day      time.Time
+		expected int
+	}{
+		{"Regular day", time.Date(2025, 6, 1, 0, 0, 0, 0, loc), 24},
+		{"Spring forward", time.Date(2025, 3, 30, 0, 0, 0, 0, loc), 23},
+		{"Fall back", time.Date(2025, 10, 26, 0, 0, 0, 0, loc), 25},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, end := LocalDayBounds(tc.day, loc)
+			hours := int(end.Sub(tc.day) / time.Hour)
+
+			// Include one hour of the following day to check boundaries
+			s, err := parseSeries(localHourlyBlock(t, tc.day, hours+1, loc), nil, loc)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			days := s.AggregateDaily(HourlyPrecipitation, AggregateSum)
+			if len(days) != 2 {
+				t.Fatalf("Expected 2 days, got %d", len(days))
+			}
+			if days[0].Steps != tc.expected || days[0].Value != float64(tc.expected) {
+				t.Errorf("Expected %d steps summing to %d, got %d steps summing to %.0f", tc.expected, tc.expected, days[0].Steps, days[0].Value)
+			}
+			if days[0].Length != time.Duration(tc.expected)*time.Hour {
+				t.Errorf("Expected day length %dh, got %v", tc.expected, days[0].Length)
+			}
+			if !days[0].Date.Equal(tc.day) {
+				t.Errorf("Expected day to start at %v, got %v", tc.day, days[0].Date)
+			}
+			if days[1].Steps != 1 {
+				t.Errorf("Expected 1 step on the following day, got %d", days[1].Steps)
+			}
+		})
+	}
+}
+
+// TestAggregateDaily_UTC tests aggregation without a time zone and with missing values
+func TestAggregateDaily_UTC(t *testing.T) {
+	base := time.Date(2025, 1, 1, 22, 0, 0, 0, time.UTC)
+	s := Series{
+		Time: []time.Time{base, base.Add(time.Hour), base.Add(2 * time.Hour), base.Add(3 * time.Hour)},
+		Values: map[Variable][]float64{
+			HourlyTemperature2m: {4, math.NaN(), 1, 3},
+		},
+	}
+
+	days := s.AggregateDaily(HourlyTemperature2m, AggregateMax)
+	if len(days) != 2 {
+		t.Fatalf("Expected 2 days, got %d", len(days))
+	}
+	if days[0].Value != 4 || days[1].Value != 3 {
+		t.Errorf("Expected maxima 4 and 3, got %.0f and %.0f", days[0].Value, days[1].Value)
+	}
+	if days[0].Length != 24*time.Hour {
+		t.Errorf("Expected 24h day, got %v", days[0].Length)
+	}
+
+	if s.AggregateDaily(HourlyRain, AggregateSum) != nil {
+		t.Error("Expected nil for missing variable")
+	}
+}
+
+// TestAggregateFuncs tests the built-in aggregations
+func TestAggregateFuncs(t *testing.T) {
+	values := []float64{2, math.NaN(), 4, 6}
+
+	if got := AggregateSum(values); got != 12 {
+		t.Errorf("Expected sum 12, got %v", got)
+	}
+	if got := AggregateMean(values); got != 4 {
+		t.Errorf("Expected mean 4, got %v", got)
+	}
+	if got := AggregateMin(values); got != 2 {
+		t.Errorf("Expected min 2, got %v", got)
+	}
+	if got := AggregateMax(values); got != 6 {
+		t.Errorf("Expected max 6, got %v", got)
+	}
+
+	missing := []float64{math.NaN(), math.NaN()}
+	for name, fn := range map[string]AggregateFunc{"sum": AggregateSum, "mean": AggregateMean, "min": AggregateMin, "max": AggregateMax} {
+		if !math.IsNaN(fn(missing)) {
+			t.Errorf("Expected NaN %s for all-missing values", name)
+		}
+	}
+}
+
+// TestOccurrenceAfter tests disambiguation of repeated local hours
+func TestOccurrenceAfter(t *testing.T) {
+	loc := berlin(t)
+	first := time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC)  // 02:00 CEST
+	second := time.Date(2025, 10, 26, 1, 0, 0, 0, time.UTC) // 02:00 CET
+
+	if got := occurrenceAfter(second, time.Time{}, loc); !got.Equal(first) {
+		t.Errorf("Expected first occurrence without previous step, got %v", got)
+	}
+	if got := occurrenceAfter(first, first.Add(-time.Hour), loc); !got.Equal(first) {
+		t.Errorf("Expected first occurrence after 01:00 CEST, got %v", got)
+	}
+	if got := occurrenceAfter(first, first, loc); !got.Equal(second) {
+		t.Errorf("Expected second occurrence after first, got %v", got)
+	}
+
+	regular := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	if got := occurrenceAfter(regular, regular.Add(-time.Hour), loc); !got.Equal(regular) {
+		t.Errorf("Expected unambiguous time unchanged, got %v", got)
+	}
+}
@@ -0,0 +1,251 @@
+package openmeteo
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"time"
+)
+
+// defaultAirQualityBaseURL is the base URL of the Open Meteo air quality API
+const defaultAirQualityBaseURL = "https://air-quality-api.open-meteo.com/v1"
+
+// Hourly pollen variables available from the air quality API, in grains/m³.
+// Pollen data is only available for Europe; elsewhere the values are missing (NaN).
+const (
+	HourlyAlderPollen   Variable = "alder_pollen"
+	HourlyBirchPollen   Variable = "birch_pollen"
+	HourlyGrassPollen   Variable = "grass_pollen"
+	HourlyMugwortPollen Variable = "mugwort_pollen"
+	HourlyOlivePollen   Variable = "olive_pollen"
+	HourlyRagweedPollen Variable = "ragweed_pollen"
+)
+
+// PollenVariables lists all pollen variables, for use with GetAirQuality.
+var PollenVariables = []Variable{
+	HourlyAlderPollen,
+	HourlyBirchPollen,
+	HourlyGrassPollen,
+	HourlyMugwortPollen,
+	HourlyOlivePollen,
+	HourlyRagweedPollen,
+}
+
+// AirQualityDomain selects the CAMS domain of air quality data (see WithAirQualityDomain).
+type AirQualityDomain string
+
+const (
+	// AirQualityDomainAuto uses the European domain where available and the global domain
+	// elsewhere (the API default)
+	AirQualityDomainAuto AirQualityDomain = "auto"
+
+	// AirQualityDomainEurope is the CAMS European domain (11 km, includes pollen)
+	AirQualityDomainEurope AirQualityDomain = "cams_europe"
+
+	// AirQualityDomainGlobal is the CAMS global domain (45 km, no pollen)
+	AirQualityDomainGlobal AirQualityDomain = "cams_global"
+)
+
+// AirQualityForecast holds hourly air quality and pollen data for a location.
+type AirQualityForecast struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds
+	UTCOffsetSeconds int
+
+	// Hourly holds the requested hourly variables
+	Hourly Series
+
+	// Stale reports that the forecast was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since a stale forecast was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// GetAirQuality fetches hourly air quality data (e.g., pollen concentrations) from the
+// air quality API for the given variables at the specified coordinates.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - latitude: Latitude in degrees (-90 to 90)
+//   - longitude: Longitude in degrees (-180 to 180)
+//   - vars: Hourly air quality variables to request (at least one)
+//   - opts: Optional per-request settings (e.g., WithTimezone, WithAirQualityDomain)
+//
+// Example:
+//
+//	aq, err := client.GetAirQuality(ctx, 52.52, 13.41, openmeteo.PollenVariables)
+//	if err != nil {
+//	    return err
+//	}
+//	birch := aq.Hourly.Get(openmeteo.HourlyBirchPollen)
+func (c *Client) GetAirQuality(ctx context.Context, latitude, longitude float64, vars []Variable, opts ...RequestOption) (*AirQualityForecast, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if err := c.checkDateLimits(cfg, ServiceAirQuality, requestID); err != nil {
+		return nil, err
+	}
+	if len(vars) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one air quality variable is required",
+			RequestID: requestID,
+		}
+	}
+
+	q := url.Values{}
+	q.Set("hourly", joinVariables(vars))
+	if cfg.domain != "" {
+		q.Set("domains", string(cfg.domain))
+	}
+	if err := checkCompatibility(q, cfg, latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	reqURL, err := c.buildServiceURL(c.endpoints.AirQuality, "/air-quality", latitude, longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp forecastResponse
+	meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := resolveLocation(apiResp.Timezone, apiResp.TimezoneAbbreviation, apiResp.UTCOffsetSeconds)
+	hourly, err := parseSeries(apiResp.Hourly, apiResp.HourlyUnits, loc)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeAPI,
+			Message:   "failed to parse hourly data",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	return &AirQualityForecast{
+		Latitude:         apiResp.Latitude,
+		Longitude:        apiResp.Longitude,
+		Location:         loc,
+		Timezone:         apiResp.Timezone,
+		UTCOffsetSeconds: apiResp.UTCOffsetSeconds,
+		Hourly:           hourly,
+		Stale:            meta.stale,
+		Age:              meta.age,
+	}, nil
+}
+
+// PollenReading holds the pollen concentrations of one hour in grains/m³. Pollen data is only
+// available for Europe and during the pollen season; unavailable concentrations are nil.
+type PollenReading struct {
+	// Time is the start of the hour in UTC
+	Time time.Time
+
+	Alder   *float64
+	Birch   *float64
+	Grass   *float64
+	Mugwort *float64
+	Olive   *float64
+	Ragweed *float64
+}
+
+// PollenForecast holds hourly pollen readings for a location (see GetPollen).
+type PollenForecast struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Hourly holds one reading per hour
+	Hourly []PollenReading
+
+	// Available reports whether any concentration was returned; it is false outside Europe
+	Available bool
+
+	// Stale reports that the forecast was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since a stale forecast was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// GetPollen fetches hourly alder, birch, grass, mugwort, olive and ragweed pollen concentrations
+// from the air quality API. It is a typed shorthand for GetAirQuality with PollenVariables.
+// Pollen data only covers Europe: elsewhere the call succeeds with Available set to false and
+// nil concentrations.
+//
+// Example:
+//
+//	pollen, err := client.GetPollen(ctx, 52.52, 13.41, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	if !pollen.Available {
+//	    fmt.Println("no pollen data for this location")
+//	}
+//	if birch := pollen.Hourly[0].Birch; birch != nil {
+//	    fmt.Printf("birch: %.0f grains/m³\n", *birch)
+//	}
+func (c *Client) GetPollen(ctx context.Context, latitude, longitude float64, opts ...RequestOption) (*PollenForecast, error) {
+	aq, err := c.GetAirQuality(ctx, latitude, longitude, PollenVariables, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast := &PollenForecast{
+		Latitude:  aq.Latitude,
+		Longitude: aq.Longitude,
+		Location:  aq.Location,
+		Hourly:    make([]PollenReading, aq.Hourly.Len()),
+		Stale:     aq.Stale,
+		Age:       aq.Age,
+	}
+	value := func(v Variable, i int) *float64 {
+		values := aq.Hourly.Get(v)
+		if i >= len(values) || math.IsNaN(values[i]) {
+			return nil
+		}
+		forecast.Available = true
+		x := values[i]
+		return &x
+	}
+	for i, t := range aq.Hourly.Time {
+		forecast.Hourly[i] = PollenReading{
+			Time:    t,
+			Alder:   value(HourlyAlderPollen, i),
+			Birch:   value(HourlyBirchPollen, i),
+			Grass:   value(HourlyGrassPollen, i),
+			Mugwort: value(HourlyMugwortPollen, i),
+			Olive:   value(HourlyOlivePollen, i),
+			Ragweed: value(HourlyRagweedPollen, i),
+		}
+	}
+	return forecast, nil
+}
@@ -0,0 +1,126 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetAirQuality tests fetching hourly data from the air quality API
+func TestGetAirQuality(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/air-quality" {
+			t.Errorf("Expected /air-quality path, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("hourly"); got != "birch_pollen,grass_pollen" {
+			t.Errorf("Unexpected hourly parameter %q", got)
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.5, "longitude": 13.4, "hourly_units": {"birch_pollen": "grains/m³"},
+			"hourly": {"time": ["2025-04-10T00:00", "2025-04-10T01:00"], "birch_pollen": [12.5, null], "grass_pollen": [0, 1]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithAirQualityBaseURL(server.URL))
+	aq, err := client.GetAirQuality(context.Background(), 52.52, 13.41, []Variable{HourlyBirchPollen, HourlyGrassPollen})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	birch := aq.Hourly.Get(HourlyBirchPollen)
+	if aq.Hourly.Len() != 2 || birch[0] != 12.5 || !math.IsNaN(birch[1]) {
+		t.Errorf("Unexpected birch pollen %v", birch)
+	}
+	if aq.Hourly.Unit(HourlyBirchPollen) != "grains/m³" {
+		t.Errorf("Unexpected unit %q", aq.Hourly.Unit(HourlyBirchPollen))
+	}
+
+	var apiErr *Error
+	if _, err := client.GetAirQuality(context.Background(), 52.52, 13.41, nil); !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error without variables, got %v", err)
+	}
+}
+
+// TestGetAirQuality_Domain tests the domains parameter and the validation of unknown domains
+func TestGetAirQuality_Domain(t *testing.T) {
+	var domains []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		domains = append(domains, r.URL.Query().Get("domains"))
+		_, _ = fmt.Fprintln(w, `{"latitude": 48.85, "longitude": 2.35, "hourly": {"time": ["2025-04-10T00:00"], "pm2_5": [8]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithAirQualityBaseURL(server.URL))
+	for _, opts := range [][]RequestOption{nil, {WithAirQualityDomain(AirQualityDomainEurope)}, {WithAirQualityDomain(AirQualityDomainGlobal)}} {
+		if _, err := client.GetAirQuality(context.Background(), 48.85, 2.35, []Variable{"pm2_5"}, opts...); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	_, err := client.GetAirQuality(context.Background(), 48.85, 2.35, []Variable{"pm2_5"}, WithAirQualityDomain("cams_asia"))
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error for unknown domain, got %v", err)
+	}
+
+	want := []string{"", "cams_europe", "cams_global"}
+	if len(domains) != len(want) {
+		t.Fatalf("Expected %d requests, got %d", len(want), len(domains))
+	}
+	for i := range want {
+		if domains[i] != want[i] {
+			t.Errorf("Request %d: expected domains=%q, got %q", i, want[i], domains[i])
+		}
+	}
+}
+
+// TestGetPollen tests typed pollen readings with missing values and locations without data
+func TestGetPollen(t *testing.T) {
+	outside := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("hourly"); got != joinVariables(PollenVariables) {
+			t.Errorf("Unexpected hourly parameter %q", got)
+		}
+		if outside {
+			_, _ = fmt.Fprintln(w, `{"latitude": 40.7, "longitude": -74, "hourly": {"time": ["2025-04-10T00:00"],
+				"alder_pollen": [null], "birch_pollen": [null], "grass_pollen": [null], "mugwort_pollen": [null], "olive_pollen": [null], "ragweed_pollen": [null]}}`)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.5, "longitude": 13.4, "hourly": {"time": ["2025-04-10T00:00", "2025-04-10T01:00"],
+			"alder_pollen": [1, 2], "birch_pollen": [12.5, null], "grass_pollen": [0, 1], "mugwort_pollen": [0, 0], "olive_pollen": [null, null], "ragweed_pollen": [0, 0]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithAirQualityBaseURL(server.URL))
+	pollen, err := client.GetPollen(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !pollen.Available || len(pollen.Hourly) != 2 {
+		t.Fatalf("Unexpected forecast %+v", pollen)
+	}
+	first, second := pollen.Hourly[0], pollen.Hourly[1]
+	if first.Birch == nil || *first.Birch != 12.5 || second.Birch != nil || first.Olive != nil {
+		t.Errorf("Unexpected readings %+v %+v", first, second)
+	}
+	if second.Alder == nil || *second.Alder != 2 || first.Grass == nil || *first.Grass != 0 {
+		t.Errorf("Expected zero and positive values to be set, got %+v %+v", first, second)
+	}
+
+	outside = true
+	pollen, err = client.GetPollen(context.Background(), 40.71, -74.01)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if pollen.Available || pollen.Hourly[0].Birch != nil {
+		t.Errorf("Expected no pollen data outside Europe, got %+v", pollen)
+	}
+
+	var apiErr *Error
+	if _, err := client.GetPollen(context.Background(), 95, 0); !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
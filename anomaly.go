@@ -0,0 +1,110 @@
+package openmeteo
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// DefaultAnomalyThresholds are the deviations from normal (in the API's default units) from
+// which an anomaly is considered significant. Anomalies converts deviations in other units
+// (see WithUnits) before comparing them.
+var DefaultAnomalyThresholds = map[Variable]float64{
+	DailyTemperature2mMax:       3,
+	DailyTemperature2mMin:       3,
+	DailyApparentTemperatureMax: 3,
+	DailyApparentTemperatureMin: 3,
+	DailyPrecipitationSum:       5,
+	DailyRainSum:                5,
+	DailySnowfallSum:            5,
+	DailyWindSpeed10mMax:        10,
+	DailyWindGusts10mMax:        15,
+}
+
+// Anomaly is the deviation of a daily value from its climatological normal.
+type Anomaly struct {
+	// Date is the start of the day in the series' Location
+	Date time.Time
+
+	// Variable is the daily variable
+	Variable Variable
+
+	// Value is the observed or forecast value
+	Value float64
+
+	// Normal is the normal of the variable for the calendar day (see Normals.ForDate)
+	Normal float64
+
+	// Delta is Value minus Normal
+	Delta float64
+
+	// Unit is the unit of the variable as reported by the API (e.g., "°C")
+	Unit Unit
+
+	// Significant reports that the absolute Delta reaches the variable's threshold
+	Significant bool
+}
+
+// String describes the anomaly, e.g. "6.0°C above the seasonal average".
+func (a Anomaly) String() string {
+	q := Quantity{Value: math.Abs(a.Delta), Unit: a.Unit}
+	switch {
+	case math.Abs(a.Delta) < 0.05:
+		return "at the seasonal average"
+	case a.Delta > 0:
+		return q.String() + " above the seasonal average"
+	default:
+		return q.String() + " below the seasonal average"
+	}
+}
+
+// Anomalies compares each day of a daily series (current or forecast data) with normals
+// computed by GetNormals and returns the anomaly of every variable present in both, ordered
+// by day and variable name. The series and the normals must use the same units. thresholds
+// sets the absolute deviation, in the API's default units, from which an anomaly is
+// significant per variable; nil uses DefaultAnomalyThresholds. Variables without a threshold
+// are never significant. Days where the value or the normal is missing are skipped.
+//
+// Example:
+//
+//	for _, a := range openmeteo.Anomalies(&forecast.Daily, normals, nil) {
+//	    if a.Significant {
+//	        fmt.Printf("%s %s: %s\n", a.Date.Format("Mon"), a.Variable, a) // e.g. "6.0°C above the seasonal average"
+//	    }
+//	}
+func Anomalies(daily *Series, normals *Normals, thresholds map[Variable]float64) []Anomaly {
+	if thresholds == nil {
+		thresholds = DefaultAnomalyThresholds
+	}
+	var vars []Variable
+	for v := range normals.Daily {
+		if _, ok := daily.Values[v]; ok {
+			vars = append(vars, v)
+		}
+	}
+	slices.Sort(vars)
+
+	var anomalies []Anomaly
+	for i, day := range daily.TimesInLocal() {
+		for _, v := range vars {
+			value, normal := daily.valueAt(v, i), normals.ForDate(v, day)
+			if math.IsNaN(value) || math.IsNaN(normal) {
+				continue
+			}
+			a := Anomaly{
+				Date:     day,
+				Variable: v,
+				Value:    value,
+				Normal:   normal,
+				Delta:    value - normal,
+				Unit:     Unit(daily.Unit(v)),
+			}
+			if threshold, ok := thresholds[v]; ok {
+				delta := toMetric(v, value, a.Unit) - toMetric(v, normal, a.Unit)
+				a.Significant = math.Abs(delta) >= threshold
+			}
+			anomalies = append(anomalies, a)
+		}
+	}
+	return anomalies
+}
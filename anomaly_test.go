@@ -0,0 +1,56 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestAnomalies tests deviations from normals and their significance
+func TestAnomalies(t *testing.T) {
+	normals := &Normals{Daily: map[Variable][]float64{
+		DailyTemperature2mMax: make([]float64, daysInLeapYear),
+		DailyPrecipitationSum: make([]float64, daysInLeapYear),
+		DailyWindSpeed10mMax:  make([]float64, daysInLeapYear),
+	}}
+	for d := range daysInLeapYear {
+		normals.Daily[DailyTemperature2mMax][d] = 20
+		normals.Daily[DailyPrecipitationSum][d] = 2
+		normals.Daily[DailyWindSpeed10mMax][d] = math.NaN()
+	}
+
+	s := dailySeries(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailyTemperature2mMax: {26, 18.5, math.NaN()},
+		DailyPrecipitationSum: {0, 9, 2},
+		DailyWindSpeed10mMax:  {10, 10, 10},
+		DailyWeatherCode:      {0, 61, 3},
+	}, map[Variable]string{DailyTemperature2mMax: "°C", DailyPrecipitationSum: "mm"})
+
+	anomalies := Anomalies(&s, normals, nil)
+	if len(anomalies) != 5 {
+		t.Fatalf("Expected 5 anomalies, got %d: %+v", len(anomalies), anomalies)
+	}
+
+	// Variables are ordered by name: precipitation_sum before temperature_2m_max
+	hot := anomalies[1]
+	if hot.Variable != DailyTemperature2mMax || hot.Delta != 6 || !hot.Significant {
+		t.Errorf("Expected significant +6 °C anomaly, got %+v", hot)
+	}
+	if got := hot.String(); got != "6.0°C above the seasonal average" {
+		t.Errorf("Unexpected description %q", got)
+	}
+	if cool := anomalies[3]; cool.Delta != -1.5 || cool.Significant || cool.String() != "1.5°C below the seasonal average" {
+		t.Errorf("Expected insignificant -1.5 °C anomaly, got %+v (%s)", cool, cool)
+	}
+	if wet := anomalies[2]; wet.Variable != DailyPrecipitationSum || wet.Delta != 7 || !wet.Significant || wet.String() != "7.0 mm above the seasonal average" {
+		t.Errorf("Expected significant wet anomaly, got %+v (%s)", wet, wet)
+	}
+	if normal := anomalies[4]; normal.String() != "at the seasonal average" {
+		t.Errorf("Expected average day, got %s", normal)
+	}
+
+	anomalies = Anomalies(&s, normals, map[Variable]float64{DailyTemperature2mMax: 1})
+	if !anomalies[3].Significant || anomalies[2].Significant {
+		t.Errorf("Expected custom thresholds to apply, got %+v", anomalies)
+	}
+}
@@ -0,0 +1,460 @@
+package openmeteo
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultArchiveBaseURL is the base URL of the Open Meteo historical weather API
+	defaultArchiveBaseURL = "https://archive-api.open-meteo.com/v1"
+
+	// historicalChunkYears is the length of one chunk of a historical download in years
+	historicalChunkYears = 1
+
+	// historicalParallelism is the maximum number of chunks downloaded at the same time
+	historicalParallelism = 4
+)
+
+// Reanalysis datasets served by the historical weather API (see WithReanalysis).
+const (
+	// ReanalysisERA5 is the global ECMWF ERA5 reanalysis (0.25°, from 1940)
+	ReanalysisERA5 Model = "era5"
+
+	// ReanalysisERA5Land is the land-only ERA5-Land reanalysis (0.1°, from 1950)
+	ReanalysisERA5Land Model = "era5_land"
+
+	// ReanalysisECMWFIFS is the ECMWF IFS analysis (9 km, from 2017)
+	ReanalysisECMWFIFS Model = "ecmwf_ifs"
+
+	// ReanalysisCERRA is the European regional CERRA reanalysis (5 km, 1985 to 2021)
+	ReanalysisCERRA Model = "cerra"
+)
+
+// HistoricalHourly holds a continuous hourly series assembled from the historical weather API.
+type HistoricalHourly struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds
+	UTCOffsetSeconds int
+
+	// Hourly holds the requested hourly variables for the whole range
+	Hourly Series
+
+	// Sources maps each variable to the dataset that served it when datasets were selected
+	// with WithReanalysis; nil otherwise. Variables without data in any dataset are absent.
+	Sources map[Variable]Model
+
+	// Gaps lists the periods without data: missing time steps, or steps where every
+	// variable is missing (e.g., the most recent days not yet in the archive)
+	Gaps []Gap
+}
+
+// HistoricalDaily holds a continuous daily series assembled from the historical weather API.
+type HistoricalDaily struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone)
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds
+	UTCOffsetSeconds int
+
+	// Daily holds the requested daily variables for the whole range
+	Daily Series
+
+	// Sources maps each variable to the dataset that served it when datasets were selected
+	// with WithReanalysis; nil otherwise
+	Sources map[Variable]Model
+
+	// Gaps lists the periods without data
+	Gaps []Gap
+}
+
+// Gap is a period [Start, End) without data in a Series.
+type Gap struct {
+	// Start is the first missing time step
+	Start time.Time
+
+	// End is the first time step after the gap
+	End time.Time
+}
+
+// historicalChunk is the date range and result of one part of a historical download.
+type historicalChunk struct {
+	start, end time.Time
+	resp       forecastResponse
+	series     Series
+}
+
+// DownloadHistoricalHourly fetches hourly reanalysis data from the historical weather API
+// for the calendar days from start to end (inclusive). Multi-year ranges are split into
+// yearly chunks that are downloaded in parallel (at most 4 at a time, within the client's
+// concurrency limit and quota policy) and merged into one continuous series. Periods
+// without data are reported in Gaps.
+//
+// Date and hour ranges set through opts are replaced by the chunk ranges; other request
+// options (e.g., WithTimezone) apply to every chunk. If any chunk fails, the download is
+// canceled and the error is returned.
+//
+// Example:
+//
+//	start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+//	history, err := client.DownloadHistoricalHourly(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyTemperature2m}, start, end)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, gap := range history.Gaps {
+//	    log.Printf("no data from %s to %s", gap.Start, gap.End)
+//	}
+func (c *Client) DownloadHistoricalHourly(ctx context.Context, latitude, longitude float64, vars []Variable, start, end time.Time, opts ...RequestOption) (*HistoricalHourly, error) {
+	result, err := c.downloadHistorical(ctx, "hourly", latitude, longitude, vars, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &HistoricalHourly{
+		Latitude:         result.latitude,
+		Longitude:        result.longitude,
+		Location:         result.series.Location,
+		Timezone:         result.timezone,
+		UTCOffsetSeconds: result.utcOffsetSeconds,
+		Hourly:           result.series,
+		Sources:          result.sources,
+		Gaps:             detectGaps(result.series),
+	}, nil
+}
+
+// DownloadHistoricalDaily fetches daily data (e.g., DailyTemperature2mMax) from the historical
+// weather API for the calendar days from start to end (inclusive), like DownloadHistoricalHourly.
+// Daily data requires a timezone (see WithTimezone).
+//
+// Example:
+//
+//	history, err := client.DownloadHistoricalDaily(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.DailyTemperature2mMax}, start, end, openmeteo.WithTimezone("auto"))
+func (c *Client) DownloadHistoricalDaily(ctx context.Context, latitude, longitude float64, vars []Variable, start, end time.Time, opts ...RequestOption) (*HistoricalDaily, error) {
+	result, err := c.downloadHistorical(ctx, "daily", latitude, longitude, vars, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &HistoricalDaily{
+		Latitude:         result.latitude,
+		Longitude:        result.longitude,
+		Location:         result.series.Location,
+		Timezone:         result.timezone,
+		UTCOffsetSeconds: result.utcOffsetSeconds,
+		Daily:            result.series,
+		Sources:          result.sources,
+		Gaps:             detectGaps(result.series),
+	}, nil
+}
+
+// historicalResult is the merged result of a historical download.
+type historicalResult struct {
+	latitude, longitude float64
+	timezone            string
+	utcOffsetSeconds    int
+	series              Series
+	sources             map[Variable]Model
+}
+
+// downloadHistorical downloads the variables of a block ("hourly" or "daily") in chunks
+// and merges them into one series.
+func (c *Client) downloadHistorical(ctx context.Context, block string, latitude, longitude float64, vars []Variable, start, end time.Time, opts []RequestOption) (*historicalResult, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if len(vars) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one " + block + " variable is required",
+			RequestID: requestID,
+		}
+	}
+	if err := validateDateRange(start, end); err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   err.Error(),
+			RequestID: requestID,
+		}
+	}
+	if err := checkCompatibility(url.Values{block: {joinVariables(vars)}}, cfg, latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	rangeCfg := *cfg
+	rangeCfg.startDate, rangeCfg.endDate = start, end
+	rangeCfg.startHour, rangeCfg.endHour = time.Time{}, time.Time{}
+	if err := c.checkDateLimits(&rangeCfg, ServiceArchive, requestID); err != nil {
+		return nil, err
+	}
+
+	chunks := splitDateRange(calendarDate(start), calendarDate(end), historicalChunkYears)
+	progress := newProgressTracker(cfg.progress, len(chunks))
+	cfg.received = &progress.bytes
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	jobs := make(chan *historicalChunk)
+	for range min(historicalParallelism, c.parallelism(), len(chunks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range jobs {
+				err := c.fetchHistoricalChunk(ctx, requestID, block, latitude, longitude, vars, cfg, chunk)
+				progress.complete(formatDate(chunk.start)+" to "+formatDate(chunk.end), err)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	for i := range chunks {
+		jobs <- &chunks[i]
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	first := chunks[0].resp
+	loc := resolveLocation(first.Timezone, first.TimezoneAbbreviation, first.UTCOffsetSeconds)
+	series := mergeSeries(chunks, datasetVariables(vars, cfg.datasets), loc)
+	sources := selectDatasets(&series, vars, cfg.datasets)
+	return &historicalResult{
+		latitude:         first.Latitude,
+		longitude:        first.Longitude,
+		timezone:         first.Timezone,
+		utcOffsetSeconds: first.UTCOffsetSeconds,
+		series:           series,
+		sources:          sources,
+	}, nil
+}
+
+// fetchHistoricalChunk downloads and parses the archive data of one chunk.
+func (c *Client) fetchHistoricalChunk(ctx context.Context, requestID, block string, latitude, longitude float64, vars []Variable, cfg *requestConfig, chunk *historicalChunk) error {
+	chunkCfg := *cfg
+	chunkCfg.startDate, chunkCfg.endDate = chunk.start, chunk.end
+	chunkCfg.startHour, chunkCfg.endHour = time.Time{}, time.Time{}
+
+	q := url.Values{}
+	q.Set(block, joinVariables(vars))
+	if len(cfg.datasets) > 0 {
+		q.Set("models", joinModels(cfg.datasets))
+	}
+	reqURL, err := c.buildServiceURL(c.endpoints.Archive, "/archive", latitude, longitude, q, &chunkCfg)
+	if err != nil {
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	if _, err := c.doRequest(ctx, requestID, reqURL, &chunkCfg, &chunk.resp); err != nil {
+		return err
+	}
+
+	loc := resolveLocation(chunk.resp.Timezone, chunk.resp.TimezoneAbbreviation, chunk.resp.UTCOffsetSeconds)
+	data, units := chunk.resp.Hourly, chunk.resp.HourlyUnits
+	if block == "daily" {
+		data, units = chunk.resp.Daily, chunk.resp.DailyUnits
+	}
+	chunk.series, err = parseSeries(data, units, loc)
+	if err != nil {
+		return &Error{
+			Type:      ErrorTypeAPI,
+			Message:   "failed to parse " + block + " data for " + formatDate(chunk.start) + " to " + formatDate(chunk.end),
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+	return nil
+}
+
+// splitDateRange splits the calendar days from start to end (inclusive) into consecutive
+// ranges of at most the given number of years.
+func splitDateRange(start, end time.Time, years int) []historicalChunk {
+	var chunks []historicalChunk
+	for s := start; !s.After(end); {
+		e := s.AddDate(years, 0, -1)
+		if e.After(end) {
+			e = end
+		}
+		chunks = append(chunks, historicalChunk{start: s, end: e})
+		s = e.AddDate(0, 0, 1)
+	}
+	return chunks
+}
+
+// mergeSeries concatenates the chunk series in order. Time steps already covered by an
+// earlier chunk are skipped, and variables missing from a chunk are filled with NaN.
+func mergeSeries(chunks []historicalChunk, vars []Variable, loc *time.Location) Series {
+	merged := Series{
+		Values:   make(map[Variable][]float64, len(vars)),
+		Units:    make(map[Variable]string, len(vars)),
+		Location: loc,
+	}
+
+	for _, chunk := range chunks {
+		for i, t := range chunk.series.Time {
+			if n := len(merged.Time); n > 0 && !t.After(merged.Time[n-1]) {
+				continue
+			}
+			merged.Time = append(merged.Time, t)
+			for _, v := range vars {
+				value := math.NaN()
+				if values := chunk.series.Values[v]; i < len(values) {
+					value = values[i]
+				}
+				merged.Values[v] = append(merged.Values[v], value)
+			}
+		}
+		for v, unit := range chunk.series.Units {
+			if _, ok := merged.Units[v]; !ok {
+				merged.Units[v] = unit
+			}
+		}
+	}
+	return merged
+}
+
+// datasetVariables returns the response keys of vars: with several datasets the API
+// returns each variable once per dataset, suffixed with the dataset name.
+func datasetVariables(vars []Variable, datasets []Model) []Variable {
+	if len(datasets) < 2 {
+		return vars
+	}
+	keys := make([]Variable, 0, len(vars)*len(datasets))
+	for _, v := range vars {
+		for _, d := range datasets {
+			keys = append(keys, Variable(string(v)+"_"+string(d)))
+		}
+	}
+	return keys
+}
+
+// selectDatasets replaces the per-dataset columns of s with one column per variable,
+// taken from the first dataset with data for it, and returns the dataset of each variable.
+// It returns nil when no datasets were selected.
+func selectDatasets(s *Series, vars []Variable, datasets []Model) map[Variable]Model {
+	if len(datasets) == 0 {
+		return nil
+	}
+	sources := make(map[Variable]Model, len(vars))
+	if len(datasets) == 1 {
+		for _, v := range vars {
+			if hasData(s.Values[v]) {
+				sources[v] = datasets[0]
+			}
+		}
+		return sources
+	}
+
+	for _, v := range vars {
+		s.Values[v] = nil
+		for _, d := range datasets {
+			key := Variable(string(v) + "_" + string(d))
+			if _, ok := sources[v]; !ok && hasData(s.Values[key]) {
+				sources[v] = d
+				s.Values[v] = s.Values[key]
+				if unit, ok := s.Units[key]; ok {
+					s.Units[v] = unit
+				}
+			}
+			delete(s.Values, key)
+			delete(s.Units, key)
+		}
+		if s.Values[v] == nil {
+			s.Values[v] = make([]float64, len(s.Time))
+			for i := range s.Values[v] {
+				s.Values[v][i] = math.NaN()
+			}
+		}
+	}
+	return sources
+}
+
+// detectGaps returns the periods of s without data: missing time steps (spacing larger
+// than the smallest spacing in the series) and runs of steps where every variable is NaN.
+// Adjacent periods are merged.
+func detectGaps(s Series) []Gap {
+	var step time.Duration
+	for i := 1; i < len(s.Time); i++ {
+		if d := s.Time[i].Sub(s.Time[i-1]); d > 0 && (step == 0 || d < step) {
+			step = d
+		}
+	}
+
+	var gaps []Gap
+	add := func(start, end time.Time) {
+		if n := len(gaps); n > 0 && !start.After(gaps[n-1].End) {
+			if end.After(gaps[n-1].End) {
+				gaps[n-1].End = end
+			}
+			return
+		}
+		gaps = append(gaps, Gap{Start: start, End: end})
+	}
+
+	for i, t := range s.Time {
+		// Allow for local days of 23 or 25 hours at daylight saving time changes
+		if i > 0 && step > 0 && t.Sub(s.Time[i-1]) > step+step/8 {
+			add(s.Time[i-1].Add(step), t)
+		}
+		missing := true
+		for _, values := range s.Values {
+			if i < len(values) && !math.IsNaN(values[i]) {
+				missing = false
+				break
+			}
+		}
+		if missing {
+			end := t.Add(step)
+			if i+1 < len(s.Time) {
+				end = s.Time[i+1]
+			}
+			add(t, end)
+		}
+	}
+	return gaps
+}
@@ -0,0 +1,260 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// archiveHandler serves hourly archive data for the requested dates. Hours listed in
+// missing are returned as null, and days listed in absent are left out entirely.
+func archiveHandler(t *testing.T, missing map[string]bool, absent map[string]bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/archive" {
+			t.Errorf("Expected /archive path, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		start, _ := time.Parse(apiDateLayout, q.Get("start_date"))
+		end, _ := time.Parse(apiDateLayout, q.Get("end_date"))
+
+		var times, values []string
+		for ts := start; ts.Before(end.AddDate(0, 0, 1)); ts = ts.Add(time.Hour) {
+			if absent[ts.Format(apiDateLayout)] {
+				continue
+			}
+			stamp := ts.Format(apiHourLayout)
+			times = append(times, `"`+stamp+`"`)
+			if missing[stamp] {
+				values = append(values, "null")
+			} else {
+				values = append(values, fmt.Sprint(ts.Hour()))
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"latitude": 52.5, "longitude": 13.4, "hourly_units": {"temperature_2m": "°C"}, "hourly": {"time": [%s], "temperature_2m": [%s]}}`,
+			strings.Join(times, ","), strings.Join(values, ","))
+	}
+}
+
+// TestDownloadHistoricalHourly tests chunked downloads merged into one series
+func TestDownloadHistoricalHourly(t *testing.T) {
+	var mu sync.Mutex
+	var ranges []string
+	handler := archiveHandler(t, map[string]bool{"2021-06-01T05:00": true, "2021-06-01T06:00": true}, map[string]bool{"2022-03-01": true})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		ranges = append(ranges, r.URL.Query().Get("start_date")+"/"+r.URL.Query().Get("end_date"))
+		mu.Unlock()
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithArchiveBaseURL(server.URL))
+	start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	history, err := client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m}, start, end, WithDateRange(end, end))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(ranges) != 3 {
+		t.Errorf("Expected 3 chunks, got %v", ranges)
+	}
+
+	hours := int(end.AddDate(0, 0, 1).Sub(start).Hours()) - 24
+	if history.Hourly.Len() != hours {
+		t.Errorf("Expected %d merged steps, got %d", hours, history.Hourly.Len())
+	}
+	for i := 1; i < history.Hourly.Len(); i++ {
+		if !history.Hourly.Time[i].After(history.Hourly.Time[i-1]) {
+			t.Fatalf("Expected increasing timestamps at %d", i)
+		}
+	}
+	if history.Hourly.Unit(HourlyTemperature2m) != "°C" || history.Latitude != 52.5 {
+		t.Errorf("Unexpected metadata %+v", history)
+	}
+
+	want := []Gap{
+		{Start: time.Date(2021, 6, 1, 5, 0, 0, 0, time.UTC), End: time.Date(2021, 6, 1, 7, 0, 0, 0, time.UTC)},
+		{Start: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	if fmt.Sprint(history.Gaps) != fmt.Sprint(want) {
+		t.Errorf("Expected gaps %v, got %v", want, history.Gaps)
+	}
+}
+
+// TestDownloadHistoricalHourly_Errors tests validation and chunk failures
+func TestDownloadHistoricalHourly_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Query().Get("start_date"), "2021") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		archiveHandler(t, nil, nil)(w, r)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithArchiveBaseURL(server.URL))
+	vars := []Variable{HourlyTemperature2m}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	var apiErr *Error
+	_, err := client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41, vars, start, end)
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeAPI {
+		t.Errorf("Expected API error from failing chunk, got %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		vars       []Variable
+		start, end time.Time
+	}{
+		{"no variables", nil, start, end},
+		{"reversed range", vars, end, start},
+		{"before archive", vars, time.Date(1939, 12, 31, 0, 0, 0, 0, time.UTC), end},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41, tt.vars, tt.start, tt.end)
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+}
+
+// TestSplitDateRange tests chunk boundaries
+func TestSplitDateRange(t *testing.T) {
+	chunks := splitDateRange(time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), 1)
+	var got []string
+	for _, c := range chunks {
+		got = append(got, formatDate(c.start)+"/"+formatDate(c.end))
+	}
+	if fmt.Sprint(got) != "[2020-02-29/2021-02-28 2021-03-01/2021-03-01]" {
+		t.Errorf("Unexpected chunks %v", got)
+	}
+}
+
+// TestDetectGaps tests detection of missing steps and missing values
+func TestDetectGaps(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+	s := Series{
+		Time:   []time.Time{hour(0), hour(1), hour(3), hour(4), hour(5)},
+		Values: map[Variable][]float64{HourlyTemperature2m: {1, 2, math.NaN(), 4, math.NaN()}},
+	}
+
+	want := []Gap{{Start: hour(2), End: hour(4)}, {Start: hour(5), End: hour(6)}}
+	if got := detectGaps(s); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if got := detectGaps(Series{}); len(got) != 0 {
+		t.Errorf("Expected no gaps for empty series, got %v", got)
+	}
+}
+
+// TestDownloadHistoricalHourly_Reanalysis tests dataset selection and per-variable sources
+func TestDownloadHistoricalHourly_Reanalysis(t *testing.T) {
+	var models string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		models = r.URL.Query().Get("models")
+		if models == "era5" {
+			_, _ = fmt.Fprintln(w, `{"latitude": 52.5, "longitude": 13.4, "hourly_units": {"temperature_2m": "°C"},
+				"hourly": {"time": ["2020-01-01T00:00", "2020-01-01T01:00"], "temperature_2m": [1.5, 2.5]}}`)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.5, "longitude": 13.4,
+			"hourly_units": {"temperature_2m_cerra": "°C", "temperature_2m_era5": "°C", "soil_moisture_0_to_7cm_cerra": "m³/m³", "soil_moisture_0_to_7cm_era5": "m³/m³"},
+			"hourly": {"time": ["2020-01-01T00:00", "2020-01-01T01:00"],
+				"temperature_2m_cerra": [null, null], "temperature_2m_era5": [1.5, 2.5],
+				"soil_moisture_0_to_7cm_cerra": [0.31, null], "soil_moisture_0_to_7cm_era5": [0.3, 0.3],
+				"snow_depth_cerra": [null, null], "snow_depth_era5": [null, null]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithArchiveBaseURL(server.URL))
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	vars := []Variable{HourlyTemperature2m, "soil_moisture_0_to_7cm", HourlySnowDepth}
+
+	history, err := client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41, vars, day, day,
+		WithReanalysis(ReanalysisCERRA, ReanalysisERA5))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if models != "cerra,era5" {
+		t.Errorf("Expected models=cerra,era5, got %q", models)
+	}
+	want := map[Variable]Model{HourlyTemperature2m: ReanalysisERA5, "soil_moisture_0_to_7cm": ReanalysisCERRA}
+	if len(history.Sources) != len(want) {
+		t.Errorf("Expected sources %v, got %v", want, history.Sources)
+	}
+	for v, d := range want {
+		if history.Sources[v] != d {
+			t.Errorf("Expected %s to be served by %s, got %q", v, d, history.Sources[v])
+		}
+	}
+	if got := history.Hourly.Get(HourlyTemperature2m); len(got) != 2 || got[1] != 2.5 {
+		t.Errorf("Expected ERA5 temperatures, got %v", got)
+	}
+	if history.Hourly.Unit(HourlyTemperature2m) != "°C" {
+		t.Errorf("Expected unit of the selected dataset, got %q", history.Hourly.Unit(HourlyTemperature2m))
+	}
+	if got := history.Hourly.Get(HourlySnowDepth); len(got) != 2 || !math.IsNaN(got[0]) {
+		t.Errorf("Expected missing snow depth, got %v", got)
+	}
+	if len(history.Hourly.Values) != len(vars) {
+		t.Errorf("Expected per-dataset columns to be removed, got %v", history.Hourly.Values)
+	}
+
+	history, err = client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41, []Variable{HourlyTemperature2m}, day, day,
+		WithReanalysis(ReanalysisERA5))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if models != "era5" || history.Sources[HourlyTemperature2m] != ReanalysisERA5 {
+		t.Errorf("Expected single dataset era5, got models=%q sources=%v", models, history.Sources)
+	}
+
+	history, err = client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41, []Variable{HourlyTemperature2m}, day, day)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if history.Sources != nil {
+		t.Errorf("Expected no sources without dataset selection, got %v", history.Sources)
+	}
+
+	var apiErr *Error
+	_, err = client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41, []Variable{HourlyTemperature2m}, day, day,
+		WithReanalysis(ReanalysisERA5, " "))
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error for an empty dataset, got %v", err)
+	}
+}
+
+// TestDetectGaps_DailyDST tests that local days of 23 and 25 hours are not reported as gaps
+func TestDetectGaps_DailyDST(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	var s Series
+	s.Values = map[Variable][]float64{DailyTemperature2mMax: nil}
+	for d := time.Date(2025, 3, 28, 0, 0, 0, 0, berlin); d.Before(time.Date(2025, 11, 1, 0, 0, 0, 0, berlin)); d = d.AddDate(0, 0, 1) {
+		if d.Month() == time.July && d.Day() == 10 {
+			continue
+		}
+		s.Time = append(s.Time, d.UTC())
+		s.Values[DailyTemperature2mMax] = append(s.Values[DailyTemperature2mMax], 20)
+	}
+
+	gaps := detectGaps(s)
+	if len(gaps) != 1 || gaps[0].End.In(berlin).Day() != 11 {
+		t.Errorf("Expected a single gap on July 10, got %v", gaps)
+	}
+}
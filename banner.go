@@ -0,0 +1,233 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// bannerDays is the number of days in the strip below the current conditions.
+const bannerDays = 3
+
+// bannerColumn is the inner width of a day column of the banner.
+const bannerColumn = 20
+
+// BannerDailyVariables are the daily variables used by Banner for the day strip.
+var BannerDailyVariables = []Variable{DailyWeatherCode, DailyTemperature2mMax, DailyTemperature2mMin, DailyPrecipitationSum}
+
+// weatherIcon groups WMO weather codes by the icon drawn for them.
+type weatherIcon int
+
+const (
+	iconUnknown weatherIcon = iota
+	iconClear
+	iconPartlyCloudy
+	iconCloudy
+	iconFog
+	iconRain
+	iconSnow
+	iconThunder
+)
+
+// iconArt is the five-line ASCII art of each icon, 13 characters wide.
+var iconArt = map[weatherIcon][5]string{
+	iconUnknown:      {"    .-.      ", "     __)     ", "    (        ", "     `-'     ", "      *      "},
+	iconClear:        {"    \\   /    ", "     .-.     ", "  - (   ) -  ", "     `-'     ", "    /   \\    "},
+	iconPartlyCloudy: {"   \\  /      ", " _ /\"\".-.    ", "   \\_(   ).  ", "   /(___(__) ", "             "},
+	iconCloudy:       {"             ", "     .--.    ", "  .-(    ).  ", " (___.__)__) ", "             "},
+	iconFog:          {"             ", " _ - _ - _ - ", "  _ - _ - _  ", " _ - _ - _ - ", "             "},
+	iconRain:         {"     .-.     ", "    (   ).   ", "   (___(__)  ", "    ' ' ' '  ", "   ' ' ' '   "},
+	iconSnow:         {"     .-.     ", "    (   ).   ", "   (___(__)  ", "    *  *  *  ", "   *  *  *   "},
+	iconThunder:      {"     .-.     ", "    (   ).   ", "   (___(__)  ", "    /_  /_   ", "     /   /   "},
+}
+
+// weatherCodeDescriptions names the WMO weather codes returned by the API.
+var weatherCodeDescriptions = map[int]string{
+	0: "Clear sky", 1: "Mainly clear", 2: "Partly cloudy", 3: "Overcast",
+	45: "Fog", 48: "Depositing rime fog",
+	51: "Light drizzle", 53: "Moderate drizzle", 55: "Dense drizzle",
+	56: "Light freezing drizzle", 57: "Dense freezing drizzle",
+	61: "Slight rain", 63: "Moderate rain", 65: "Heavy rain",
+	66: "Light freezing rain", 67: "Heavy freezing rain",
+	71: "Slight snowfall", 73: "Moderate snowfall", 75: "Heavy snowfall", 77: "Snow grains",
+	80: "Slight rain showers", 81: "Moderate rain showers", 82: "Violent rain showers",
+	85: "Slight snow showers", 86: "Heavy snow showers",
+	95: "Thunderstorm", 96: "Thunderstorm with slight hail", 99: "Thunderstorm with heavy hail",
+}
+
+// iconFor returns the icon of a WMO weather code.
+func iconFor(code int) weatherIcon {
+	switch {
+	case code == 0 || code == 1:
+		return iconClear
+	case code == 2:
+		return iconPartlyCloudy
+	case code == 3:
+		return iconCloudy
+	case code == 45 || code == 48:
+		return iconFog
+	case code >= 51 && code <= 67, code >= 80 && code <= 82:
+		return iconRain
+	case code >= 71 && code <= 77, code == 85 || code == 86:
+		return iconSnow
+	case code >= 95 && code <= 99:
+		return iconThunder
+	default:
+		return iconUnknown
+	}
+}
+
+// describeWeatherCode returns the description of a WMO weather code, or "Unknown".
+func describeWeatherCode(code int) string {
+	if d, ok := weatherCodeDescriptions[code]; ok {
+		return d
+	}
+	return "Unknown"
+}
+
+// windArrow returns the arrow pointing where the wind blows to, from its direction in degrees
+// (where it blows from).
+func windArrow(degrees float64) string {
+	if math.IsNaN(degrees) {
+		return " "
+	}
+	arrows := []string{"↓", "↙", "←", "↖", "↑", "↗", "→", "↘"}
+	degrees = math.Mod(math.Mod(degrees, 360)+360, 360)
+	return arrows[int(math.Round(degrees/45))%8]
+}
+
+// bannerPainter applies ANSI colors when enabled.
+type bannerPainter bool
+
+// temperature formats a temperature in °C, colored with TemperatureColor.
+func (p bannerPainter) temperature(celsius float64) string {
+	text := fmt.Sprintf("%.0f°C", celsius)
+	if math.IsNaN(celsius) {
+		text = "-"
+	}
+	if !p {
+		return text
+	}
+	c := TemperatureColor(celsius)
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s\x1b[0m", c.R, c.G, c.B, text)
+}
+
+// bold formats text in bold.
+func (p bannerPainter) bold(text string) string {
+	if !p {
+		return text
+	}
+	return "\x1b[1m" + text + "\x1b[0m"
+}
+
+// visibleWidth returns the number of runes of s excluding ANSI escape sequences.
+func visibleWidth(s string) int {
+	width, escape := 0, false
+	for _, r := range s {
+		switch {
+		case escape:
+			escape = r != 'm'
+		case r == '\x1b':
+			escape = true
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+// fit pads s with spaces to width visible characters, truncating plain text that is too long.
+func fit(s string, width int) string {
+	if w := visibleWidth(s); w <= width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	if utf8.RuneCountInString(s) == visibleWidth(s) {
+		runes := []rune(s)
+		return string(runes[:width-1]) + "…"
+	}
+	return s
+}
+
+// Banner renders a boxed terminal summary of a forecast in the style of wttr.in: icon art,
+// the current temperature, wind with an arrow, precipitation and humidity, followed by a
+// strip of the first three days when the forecast includes BannerDailyVariables. When color
+// is true temperatures are colored (24-bit ANSI) and headings are bold; pass false for
+// terminals without color support or for logs.
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{Latitude: 52.52, Longitude: 13.41,
+//	    Current: true, Daily: openmeteo.BannerDailyVariables}, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Print(openmeteo.Banner(f, true))
+func Banner(f *Forecast, color bool) string {
+	p := bannerPainter(color)
+	inner := bannerDays*bannerColumn + bannerDays - 1
+
+	var b strings.Builder
+	line := func(content string) {
+		fmt.Fprintf(&b, "│ %s │\n", fit(content, inner-2))
+	}
+	b.WriteString("┌" + strings.Repeat("─", inner) + "┐\n")
+	line(p.bold(fmt.Sprintf("Weather at %.2f, %.2f", f.Latitude, f.Longitude)))
+
+	if c := f.Current; c != nil {
+		art := iconArt[iconFor(int(c.WeatherCode))]
+		details := []string{
+			p.bold(describeWeatherCode(int(c.WeatherCode))),
+			p.temperature(c.Temperature) + " (feels like " + p.temperature(c.ApparentTemperature) + ")",
+			fmt.Sprintf("%s %.0f km/h %s, gusts %.0f km/h", windArrow(c.WindDirection), c.WindSpeed, c.WindCompass(), c.WindGusts),
+			fmt.Sprintf("%.1f mm", c.Precipitation),
+			fmt.Sprintf("%.0f%% humidity", c.RelativeHumidity),
+		}
+		for i := range art {
+			line(art[i] + " " + details[i])
+		}
+	}
+
+	days := min(f.Daily.Len(), bannerDays)
+	if days == 0 {
+		b.WriteString("└" + strings.Repeat("─", inner) + "┘\n")
+		return b.String()
+	}
+
+	column := strings.Repeat("─", bannerColumn)
+	cells := make([][]string, 4)
+	for i, day := range f.Daily.TimesInLocal()[:days] {
+		code := f.Daily.valueAt(DailyWeatherCode, i)
+		description := "-"
+		if !math.IsNaN(code) {
+			description = describeWeatherCode(int(code))
+		}
+		cells[0] = append(cells[0], p.bold(day.Format("Mon 02 Jan")))
+		cells[1] = append(cells[1], description)
+		cells[2] = append(cells[2], p.temperature(f.Daily.valueAt(DailyTemperature2mMax, i))+" / "+p.temperature(f.Daily.valueAt(DailyTemperature2mMin, i)))
+		cells[3] = append(cells[3], fmt.Sprintf("%.1f mm", f.Daily.valueAt(DailyPrecipitationSum, i)))
+	}
+	rest := inner - days*(bannerColumn+1) + 1 // width left of the box when fewer days are available
+	rule := func(left, joint, right string) {
+		b.WriteString(left + strings.Repeat(column+joint, days-1) + column)
+		if rest > 0 {
+			b.WriteString(joint + strings.Repeat("─", rest-1))
+		}
+		b.WriteString(right + "\n")
+	}
+	rule("├", "┬", "┤")
+	for _, row := range cells {
+		b.WriteString("│")
+		for _, cell := range row {
+			b.WriteString(" " + fit(cell, bannerColumn-2) + " │")
+		}
+		if rest > 0 {
+			b.WriteString(strings.Repeat(" ", rest-1) + "│")
+		}
+		b.WriteString("\n")
+	}
+	rule("└", "┴", "┘")
+	return b.String()
+}
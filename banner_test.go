@@ -0,0 +1,86 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// bannerForecast returns a forecast with current conditions and the given number of days
+func bannerForecast(days int) *Forecast {
+	f := &Forecast{
+		Latitude:  52.52,
+		Longitude: 13.41,
+		Current: &CurrentWeather{Temperature: 21.3, ApparentTemperature: 19.6, WeatherCode: 2,
+			WindSpeed: 14, WindDirection: 225, WindGusts: 30, RelativeHumidity: 65},
+	}
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	for i := range days {
+		f.Daily.Time = append(f.Daily.Time, start.AddDate(0, 0, i))
+	}
+	f.Daily.Values = map[Variable][]float64{
+		DailyWeatherCode:      []float64{0, 61, 95}[:days],
+		DailyTemperature2mMax: []float64{24, 18, 27}[:days],
+		DailyTemperature2mMin: []float64{13, 11, 16}[:days],
+		DailyPrecipitationSum: []float64{0, 4.2, 12}[:days],
+	}
+	return f
+}
+
+// TestBanner tests the plain banner layout
+func TestBanner(t *testing.T) {
+	banner := Banner(bannerForecast(3), false)
+	lines := strings.Split(strings.TrimSuffix(banner, "\n"), "\n")
+
+	width := utf8.RuneCountInString(lines[0])
+	for _, l := range lines {
+		if utf8.RuneCountInString(l) != width {
+			t.Errorf("Expected all lines %d wide, got %q", width, l)
+		}
+	}
+	for _, want := range []string{
+		"Weather at 52.52, 13.41", "Partly cloudy", "21°C (feels like 20°C)", "↗ 14 km/h SW, gusts 30 km/h",
+		"65% humidity", "Mon 02 Jun", "Slight rain", "27°C / 16°C", "12.0 mm", "┬", "┴",
+	} {
+		if !strings.Contains(banner, want) {
+			t.Errorf("Expected %q in banner:\n%s", want, banner)
+		}
+	}
+	if strings.Contains(banner, "\x1b[") {
+		t.Error("Expected no escape sequences without color")
+	}
+}
+
+// TestBanner_Color tests colored output keeps the layout aligned
+func TestBanner_Color(t *testing.T) {
+	banner := Banner(bannerForecast(2), true)
+	if !strings.Contains(banner, "\x1b[38;2;") || !strings.Contains(banner, "\x1b[1m") {
+		t.Errorf("Expected ANSI colors, got %q", banner)
+	}
+	lines := strings.Split(strings.TrimSuffix(banner, "\n"), "\n")
+	for _, l := range lines {
+		if visibleWidth(l) != visibleWidth(lines[0]) {
+			t.Errorf("Expected aligned lines, got %q", l)
+		}
+	}
+}
+
+// TestBanner_NoDaily tests a banner with current conditions only
+func TestBanner_NoDaily(t *testing.T) {
+	banner := Banner(bannerForecast(0), false)
+	if strings.Contains(banner, "├") || !strings.HasSuffix(banner, "┘\n") {
+		t.Errorf("Expected a closed box without day strip:\n%s", banner)
+	}
+}
+
+// TestWindArrow tests that arrows point where the wind blows to
+func TestWindArrow(t *testing.T) {
+	for degrees, want := range map[float64]string{0: "↓", 90: "←", 180: "↑", 270: "→", 225: "↗", -45: "↘", 359: "↓"} {
+		if got := windArrow(degrees); got != want {
+			t.Errorf("windArrow(%v) = %s, want %s", degrees, got, want)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Coordinates is a geographic point in degrees.
+type Coordinates struct {
+	// Latitude in degrees (-90 to 90)
+	Latitude float64
+
+	// Longitude in degrees (-180 to 180)
+	Longitude float64
+}
+
+// CurrentWeatherResult is the outcome of one entry of a GetCurrentWeatherMany call.
+type CurrentWeatherResult struct {
+	// Coordinates are the requested coordinates
+	Coordinates Coordinates
+
+	// Weather is the current weather (nil if Err is set)
+	Weather *CurrentWeather
+
+	// Err is the error of this entry (nil on success)
+	Err error
+}
+
+// GetCurrentWeatherMany fetches current weather for several coordinates concurrently.
+// Requests are fanned out without exceeding the client's concurrency limit, and results
+// are returned in input order with an individual error per entry, so one failing point
+// does not fail the batch. The request options apply to every entry.
+//
+// Calls made through the same client at the same time share the concurrency limit and
+// may cause entries to fail with a "concurrent request limit exceeded" validation error.
+//
+// Example:
+//
+//	results := client.GetCurrentWeatherMany(ctx, []openmeteo.Coordinates{
+//	    {Latitude: 52.52, Longitude: 13.41},
+//	    {Latitude: 48.85, Longitude: 2.35},
+//	})
+//	for _, r := range results {
+//	    if r.Err != nil {
+//	        log.Printf("%v: %v", r.Coordinates, r.Err)
+//	        continue
+//	    }
+//	    fmt.Println(r.Weather.Temperature)
+//	}
+func (c *Client) GetCurrentWeatherMany(ctx context.Context, coords []Coordinates, opts ...RequestOption) []CurrentWeatherResult {
+	results := make([]CurrentWeatherResult, len(coords))
+	for i, p := range coords {
+		results[i].Coordinates = p
+	}
+
+	progress := newProgressTracker(newRequestConfig(opts).progress, len(coords))
+	opts = append(opts[:len(opts):len(opts)], func(r *requestConfig) { r.received = &progress.bytes })
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(c.parallelism(), len(coords)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i].Weather, results[i].Err = c.GetCurrentWeather(ctx, coords[i].Latitude, coords[i].Longitude, opts...)
+				progress.complete(fmt.Sprintf("%.4f,%.4f", coords[i].Latitude, coords[i].Longitude), results[i].Err)
+			}
+		}()
+	}
+	for i := range coords {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,79 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestGetCurrentWeatherMany tests ordered results, per-entry errors and the concurrency limit
+func TestGetCurrentWeatherMany(t *testing.T) {
+	var mu sync.Mutex
+	active, peak := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		active++
+		peak = max(peak, active)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			active--
+			mu.Unlock()
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		lat, _ := strconv.ParseFloat(r.URL.Query().Get("latitude"), 64)
+		if lat == 13 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"latitude": %v, "longitude": 0, "current": {"time": "2025-12-29T10:00", "temperature_2m": %v}}`, lat, lat)
+	}))
+	defer server.Close()
+
+	coords := make([]Coordinates, 25)
+	for i := range coords {
+		coords[i] = Coordinates{Latitude: float64(i)}
+	}
+	coords[20].Latitude = 95 // invalid
+
+	client := NewClient(WithBaseURL(server.URL))
+	results := client.GetCurrentWeatherMany(context.Background(), coords)
+
+	if len(results) != len(coords) {
+		t.Fatalf("Expected %d results, got %d", len(coords), len(results))
+	}
+	var apiErr *Error
+	for i, r := range results {
+		if r.Coordinates != coords[i] {
+			t.Errorf("Result %d: expected coordinates %v, got %v", i, coords[i], r.Coordinates)
+		}
+		switch i {
+		case 13:
+			if !errors.As(r.Err, &apiErr) || apiErr.Type != ErrorTypeAPI || r.Weather != nil {
+				t.Errorf("Result 13: expected API error, got %v", r.Err)
+			}
+		case 20:
+			if !errors.As(r.Err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Result 20: expected validation error, got %v", r.Err)
+			}
+		default:
+			if r.Err != nil || r.Weather.Temperature != float64(i) {
+				t.Errorf("Result %d: expected temperature %d, got %+v (%v)", i, i, r.Weather, r.Err)
+			}
+		}
+	}
+	if peak > maxConcurrent {
+		t.Errorf("Expected at most %d concurrent requests, got %d", maxConcurrent, peak)
+	}
+
+	if got := client.GetCurrentWeatherMany(context.Background(), nil); len(got) != 0 {
+		t.Errorf("Expected no results for empty input, got %d", len(got))
+	}
+}
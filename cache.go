@@ -0,0 +1,141 @@
+package openmeteo
+
+import (
+	"container/list"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// defaultCacheTTL is the time responses are cached when WithCache is given no TTL. The API
+// updates current conditions every 15 minutes.
+const defaultCacheTTL = 15 * time.Minute
+
+// Cache stores raw API responses for WithCache. Keys identify a request (service URL, path,
+// coordinates, variables and all other query parameters); values are JSON response bodies.
+// Get must not return entries older than the TTL given to Set. Implementations must be safe
+// for concurrent use; an implementation backed by a shared store (e.g., Redis or memcached)
+// lets several processes share cached responses.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// WithCache serves repeated identical requests from c for ttl instead of calling the API
+// again. Only successful responses are cached, and cached responses do not count against
+// the concurrency limit, rate limits or quota. A ttl of zero or less uses 15 minutes, the
+// update interval of current conditions.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithCache(openmeteo.NewLRUCache(1000), 10*time.Minute))
+func WithCache(c Cache, ttl time.Duration) Option {
+	return func(client *Client) {
+		if ttl <= 0 {
+			ttl = defaultCacheTTL
+		}
+		client.cache, client.cacheTTL = c, ttl
+	}
+}
+
+// cacheKey returns the cache key of reqURL. The API key is left out so that it is never
+// written to the cache.
+func cacheKey(reqURL string) string {
+	u, err := url.Parse(reqURL)
+	if err != nil {
+		return reqURL
+	}
+	q := u.Query()
+	if !q.Has("apikey") {
+		return reqURL
+	}
+	q.Del("apikey")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// lruEntry is a cached value with its expiry time.
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// LRUCache is an in-memory Cache holding up to a fixed number of entries; when full, the
+// least recently used entry is evicted. It is safe for concurrent use.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	now     func() time.Time
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// NewLRUCache creates an in-memory LRU cache holding up to size entries (at least 1).
+//
+// Example:
+//
+//	cache := openmeteo.NewLRUCache(500)
+//	client := openmeteo.NewClient(openmeteo.WithCache(cache, 0))
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:    max(size, 1),
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// clock returns the current time.
+func (l *LRUCache) clock() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
+
+// Get returns the value stored for key unless it has expired.
+func (l *LRUCache) Get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*lruEntry)
+	if !l.clock().Before(e.expires) {
+		l.order.Remove(el)
+		delete(l.entries, key)
+		return nil, false
+	}
+	l.order.MoveToFront(el)
+	return e.value, true
+}
+
+// Set stores value for key until ttl has passed, evicting the least recently used entry
+// when the cache is full.
+func (l *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expires := l.clock().Add(ttl)
+	if el, ok := l.entries[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.value, e.expires = value, expires
+		l.order.MoveToFront(el)
+		return
+	}
+	if l.order.Len() >= l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+}
+
+// Len returns the number of entries in the cache, including expired entries not yet evicted.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.order.Len()
+}
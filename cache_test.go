@@ -0,0 +1,76 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestLRUCache tests expiry and least recently used eviction
+func TestLRUCache(t *testing.T) {
+	now := time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)
+	cache := NewLRUCache(2)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Hour)
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("Expected a to be cached")
+	}
+	cache.Set("c", []byte("3"), time.Hour) // evicts b, the least recently used entry
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected b to be evicted")
+	}
+	if v, ok := cache.Get("c"); !ok || string(v) != "3" {
+		t.Errorf("Expected c to be cached, got %q", v)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Expected a to expire after its TTL")
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Expected expired entries to be removed on access, got %d entries", cache.Len())
+	}
+}
+
+// TestWithCache tests that repeated requests are served from the cache
+func TestWithCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 15.3}}`)
+	}))
+	defer server.Close()
+
+	cache := NewLRUCache(10)
+	client := NewClient(WithBaseURL(server.URL), WithAPIKey("secret"), WithCache(cache, 0))
+	for range 3 {
+		weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if weather.Temperature != 15.3 {
+			t.Errorf("Expected temperature 15.3, got %v", weather.Temperature)
+		}
+	}
+	if _, err := client.GetCurrentWeather(context.Background(), 48.14, 11.58); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests for 2 distinct locations, got %d", requests)
+	}
+	if client.cacheTTL != defaultCacheTTL {
+		t.Errorf("Expected default TTL %v, got %v", defaultCacheTTL, client.cacheTTL)
+	}
+	for key := range cache.entries {
+		if strings.Contains(key, "secret") {
+			t.Errorf("Expected the API key to be left out of cache keys, got %s", key)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import "time"
+
+// ModelInfo describes a model available on an Open Meteo service.
+// The values are indicative and intended for model pickers and documentation;
+// the API remains the authority on actual availability.
+type ModelInfo struct {
+	// Model is the API name used in the models= parameter
+	Model Model
+
+	// Service is the API service serving this model
+	Service Service
+
+	// Name is a human-readable model name
+	Name string
+
+	// Provider is the organisation running the model (e.g., "ECMWF", "DWD")
+	Provider string
+
+	// Region describes the model's coverage ("Global", "Europe", "Central Europe", ...)
+	Region string
+
+	// ResolutionKm is the (finest) horizontal grid spacing in kilometers
+	ResolutionKm float64
+
+	// ForecastLength is the forecast horizon (0 for reanalysis and climate datasets)
+	ForecastLength time.Duration
+
+	// UpdateInterval is how often new model runs (or dataset updates) become available
+	// (0 for static datasets)
+	UpdateInterval time.Duration
+
+	// Members is the number of ensemble members (0 for deterministic models)
+	Members int
+}
+
+const oneDay = 24 * time.Hour
+
+// modelCatalog lists the known models per service, in the order they are presented.
+var modelCatalog = map[Service][]ModelInfo{
+	ServiceForecast: {
+		{Model: "best_match", Name: "Best match", Provider: "Open-Meteo", Region: "Global", ResolutionKm: 1, ForecastLength: 16 * oneDay, UpdateInterval: time.Hour},
+		{Model: "ecmwf_ifs025", Name: "ECMWF IFS 0.25°", Provider: "ECMWF", Region: "Global", ResolutionKm: 25, ForecastLength: 15 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "ecmwf_aifs025_single", Name: "ECMWF AIFS 0.25°", Provider: "ECMWF", Region: "Global", ResolutionKm: 25, ForecastLength: 15 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "gfs_seamless", Name: "GFS Seamless", Provider: "NOAA", Region: "Global", ResolutionKm: 3, ForecastLength: 16 * oneDay, UpdateInterval: time.Hour},
+		{Model: "gfs_global", Name: "GFS Global", Provider: "NOAA", Region: "Global", ResolutionKm: 13, ForecastLength: 16 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "icon_seamless", Name: "ICON Seamless", Provider: "DWD", Region: "Global", ResolutionKm: 2, ForecastLength: 7*oneDay + 12*time.Hour, UpdateInterval: 3 * time.Hour},
+		{Model: "icon_global", Name: "ICON Global", Provider: "DWD", Region: "Global", ResolutionKm: 11, ForecastLength: 7*oneDay + 12*time.Hour, UpdateInterval: 6 * time.Hour},
+		{Model: "icon_eu", Name: "ICON-EU", Provider: "DWD", Region: "Europe", ResolutionKm: 7, ForecastLength: 5 * oneDay, UpdateInterval: 3 * time.Hour},
+		{Model: "icon_d2", Name: "ICON-D2", Provider: "DWD", Region: "Central Europe", ResolutionKm: 2, ForecastLength: 2 * oneDay, UpdateInterval: 3 * time.Hour},
+		{Model: "meteofrance_seamless", Name: "Météo-France Seamless", Provider: "Météo-France", Region: "Global", ResolutionKm: 1.3, ForecastLength: 4 * oneDay, UpdateInterval: time.Hour},
+		{Model: "meteofrance_arpege_world", Name: "ARPEGE World", Provider: "Météo-France", Region: "Global", ResolutionKm: 25, ForecastLength: 4 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "meteofrance_arome_france", Name: "AROME France", Provider: "Météo-France", Region: "France", ResolutionKm: 1.3, ForecastLength: 2 * oneDay, UpdateInterval: 3 * time.Hour},
+		{Model: "jma_seamless", Name: "JMA Seamless", Provider: "JMA", Region: "Global", ResolutionKm: 5, ForecastLength: 11 * oneDay, UpdateInterval: 3 * time.Hour},
+		{Model: "gem_seamless", Name: "GEM Seamless", Provider: "Environment Canada", Region: "Global", ResolutionKm: 2.5, ForecastLength: 10 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "ukmo_seamless", Name: "UK Met Office Seamless", Provider: "UK Met Office", Region: "Global", ResolutionKm: 2, ForecastLength: 7 * oneDay, UpdateInterval: time.Hour},
+		{Model: "metno_nordic", Name: "MET Nordic", Provider: "MET Norway", Region: "Nordic countries", ResolutionKm: 1, ForecastLength: 2*oneDay + 12*time.Hour, UpdateInterval: time.Hour},
+		{Model: "knmi_seamless", Name: "KNMI Seamless", Provider: "KNMI", Region: "Europe", ResolutionKm: 2, ForecastLength: 2*oneDay + 12*time.Hour, UpdateInterval: time.Hour},
+		{Model: "dmi_seamless", Name: "DMI Seamless", Provider: "DMI", Region: "Europe", ResolutionKm: 2, ForecastLength: 2*oneDay + 12*time.Hour, UpdateInterval: 3 * time.Hour},
+		{Model: "cma_grapes_global", Name: "CMA GRAPES Global", Provider: "CMA", Region: "Global", ResolutionKm: 15, ForecastLength: 10 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "bom_access_global", Name: "BOM ACCESS Global", Provider: "BOM", Region: "Global", ResolutionKm: 15, ForecastLength: 10 * oneDay, UpdateInterval: 6 * time.Hour},
+	},
+	ServiceEnsemble: {
+		{Model: "icon_seamless", Name: "ICON-EPS Seamless", Provider: "DWD", Region: "Global", ResolutionKm: 13, ForecastLength: 7*oneDay + 12*time.Hour, UpdateInterval: 6 * time.Hour, Members: 40},
+		{Model: "icon_global", Name: "ICON-EPS Global", Provider: "DWD", Region: "Global", ResolutionKm: 26, ForecastLength: 7*oneDay + 12*time.Hour, UpdateInterval: 12 * time.Hour, Members: 40},
+		{Model: "icon_eu", Name: "ICON-EU-EPS", Provider: "DWD", Region: "Europe", ResolutionKm: 13, ForecastLength: 5 * oneDay, UpdateInterval: 6 * time.Hour, Members: 40},
+		{Model: "icon_d2", Name: "ICON-D2-EPS", Provider: "DWD", Region: "Central Europe", ResolutionKm: 2, ForecastLength: 2 * oneDay, UpdateInterval: 3 * time.Hour, Members: 20},
+		{Model: "gfs_seamless", Name: "GEFS Seamless", Provider: "NOAA", Region: "Global", ResolutionKm: 25, ForecastLength: 35 * oneDay, UpdateInterval: 6 * time.Hour, Members: 31},
+		{Model: "gfs025", Name: "GEFS 0.25°", Provider: "NOAA", Region: "Global", ResolutionKm: 25, ForecastLength: 10 * oneDay, UpdateInterval: 6 * time.Hour, Members: 31},
+		{Model: "gfs05", Name: "GEFS 0.5°", Provider: "NOAA", Region: "Global", ResolutionKm: 50, ForecastLength: 35 * oneDay, UpdateInterval: 6 * time.Hour, Members: 31},
+		{Model: "ecmwf_ifs04", Name: "ECMWF IFS ENS 0.4°", Provider: "ECMWF", Region: "Global", ResolutionKm: 44, ForecastLength: 15 * oneDay, UpdateInterval: 6 * time.Hour, Members: 51},
+		{Model: "ecmwf_ifs025", Name: "ECMWF IFS ENS 0.25°", Provider: "ECMWF", Region: "Global", ResolutionKm: 25, ForecastLength: 15 * oneDay, UpdateInterval: 6 * time.Hour, Members: 51},
+		{Model: "gem_global", Name: "GEM Global Ensemble", Provider: "Environment Canada", Region: "Global", ResolutionKm: 25, ForecastLength: 16 * oneDay, UpdateInterval: 12 * time.Hour, Members: 21},
+		{Model: "bom_access_global_ensemble", Name: "BOM ACCESS Global Ensemble", Provider: "BOM", Region: "Global", ResolutionKm: 40, ForecastLength: 10 * oneDay, UpdateInterval: 6 * time.Hour, Members: 18},
+	},
+	ServiceArchive: {
+		{Model: "best_match", Name: "Best match", Provider: "Open-Meteo", Region: "Global", ResolutionKm: 9, UpdateInterval: oneDay},
+		{Model: "era5_seamless", Name: "ERA5 Seamless", Provider: "ECMWF / Copernicus", Region: "Global", ResolutionKm: 9, UpdateInterval: oneDay},
+		{Model: "era5", Name: "ERA5", Provider: "ECMWF / Copernicus", Region: "Global", ResolutionKm: 25, UpdateInterval: oneDay},
+		{Model: "era5_land", Name: "ERA5-Land", Provider: "ECMWF / Copernicus", Region: "Global", ResolutionKm: 9, UpdateInterval: oneDay},
+		{Model: "ecmwf_ifs", Name: "ECMWF IFS Analysis", Provider: "ECMWF", Region: "Global", ResolutionKm: 9, UpdateInterval: oneDay},
+		{Model: "cerra", Name: "CERRA", Provider: "ECMWF / Copernicus", Region: "Europe", ResolutionKm: 5},
+	},
+	ServiceClimate: {
+		{Model: "CMCC_CM2_VHR4", Name: "CMCC-CM2-VHR4", Provider: "CMCC", Region: "Global", ResolutionKm: 30},
+		{Model: "FGOALS_f3_H", Name: "FGOALS-f3-H", Provider: "CAS", Region: "Global", ResolutionKm: 28},
+		{Model: "HiRAM_SIT_HR", Name: "HiRAM-SIT-HR", Provider: "AS-RCEC", Region: "Global", ResolutionKm: 25},
+		{Model: "MRI_AGCM3_2_S", Name: "MRI-AGCM3-2-S", Provider: "MRI", Region: "Global", ResolutionKm: 20},
+		{Model: "EC_Earth3P_HR", Name: "EC-Earth3P-HR", Provider: "EC-Earth consortium", Region: "Global", ResolutionKm: 29},
+		{Model: "MPI_ESM1_2_XR", Name: "MPI-ESM1-2-XR", Provider: "MPI", Region: "Global", ResolutionKm: 51},
+		{Model: "NICAM16_8S", Name: "NICAM16-8S", Provider: "MIROC", Region: "Global", ResolutionKm: 31},
+	},
+	ServiceAirQuality: {
+		{Model: "auto", Name: "Automatic (CAMS Europe where available)", Provider: "Copernicus CAMS", Region: "Global", ResolutionKm: 11, ForecastLength: 5 * oneDay, UpdateInterval: 12 * time.Hour},
+		{Model: "cams_europe", Name: "CAMS European", Provider: "Copernicus CAMS", Region: "Europe", ResolutionKm: 11, ForecastLength: 4 * oneDay, UpdateInterval: oneDay},
+		{Model: "cams_global", Name: "CAMS Global", Provider: "Copernicus CAMS", Region: "Global", ResolutionKm: 40, ForecastLength: 5 * oneDay, UpdateInterval: 12 * time.Hour},
+	},
+	ServiceMarine: {
+		{Model: "best_match", Name: "Best match", Provider: "Open-Meteo", Region: "Global", ResolutionKm: 5, ForecastLength: 16 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "ewam", Name: "EWAM", Provider: "DWD", Region: "Europe", ResolutionKm: 5, ForecastLength: 4 * oneDay, UpdateInterval: 12 * time.Hour},
+		{Model: "gwam", Name: "GWAM", Provider: "DWD", Region: "Global", ResolutionKm: 25, ForecastLength: 8 * oneDay, UpdateInterval: 12 * time.Hour},
+		{Model: "ecmwf_wam025", Name: "ECMWF WAM 0.25°", Provider: "ECMWF", Region: "Global", ResolutionKm: 25, ForecastLength: 15 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "ncep_gfswave025", Name: "GFS Wave 0.25°", Provider: "NOAA", Region: "Global", ResolutionKm: 25, ForecastLength: 16 * oneDay, UpdateInterval: 6 * time.Hour},
+		{Model: "meteofrance_wave", Name: "MFWAM", Provider: "Météo-France", Region: "Global", ResolutionKm: 8, ForecastLength: 10 * oneDay, UpdateInterval: 12 * time.Hour},
+	},
+	ServiceFlood: {
+		{Model: "seamless_v4", Name: "GloFAS v4 Seamless", Provider: "Copernicus CEMS", Region: "Global", ResolutionKm: 5, ForecastLength: 30 * oneDay, UpdateInterval: oneDay},
+		{Model: "forecast_v4", Name: "GloFAS v4 Forecast", Provider: "Copernicus CEMS", Region: "Global", ResolutionKm: 5, ForecastLength: 30 * oneDay, UpdateInterval: oneDay},
+		{Model: "consolidated_v4", Name: "GloFAS v4 Consolidated", Provider: "Copernicus CEMS", Region: "Global", ResolutionKm: 5, UpdateInterval: oneDay},
+	},
+}
+
+// Models returns the catalog of known models for a service, e.g., to populate a model picker.
+// It returns nil for unknown services. The returned slice is a copy and may be modified.
+//
+// Example:
+//
+//	for _, m := range openmeteo.Models(openmeteo.ServiceForecast) {
+//	    fmt.Printf("%-25s %-15s %5.1f km %v\n", m.Model, m.Provider, m.ResolutionKm, m.ForecastLength)
+//	}
+func Models(service Service) []ModelInfo {
+	catalog, ok := modelCatalog[service]
+	if !ok {
+		return nil
+	}
+	models := make([]ModelInfo, len(catalog))
+	for i, m := range catalog {
+		m.Service = service
+		models[i] = m
+	}
+	return models
+}
+
+// LookupModel returns the catalog entry of a model on a service.
+// The same model name can describe different configurations on different services
+// (e.g., icon_seamless on ServiceForecast and ServiceEnsemble).
+func LookupModel(service Service, model Model) (ModelInfo, bool) {
+	for _, m := range modelCatalog[service] {
+		if m.Model == model {
+			m.Service = service
+			return m, true
+		}
+	}
+	return ModelInfo{}, false
+}
@@ -0,0 +1,75 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"testing"
+	"time"
+)
+
+// TestModels tests the model catalog per service
+func TestModels(t *testing.T) {
+	services := []Service{
+		ServiceForecast, ServiceEnsemble, ServiceArchive, ServiceClimate,
+		ServiceAirQuality, ServiceMarine, ServiceFlood,
+	}
+
+	for _, service := range services {
+		t.Run(string(service), func(t *testing.T) {
+			models := Models(service)
+			if len(models) == 0 {
+				t.Fatal("Expected at least one model")
+			}
+			seen := make(map[Model]bool)
+			for _, m := range models {
+				if m.Service != service {
+					t.Errorf("Expected service %s, got %s for %s", service, m.Service, m.Model)
+				}
+				if m.Model == "" || m.Name == "" || m.Provider == "" || m.Region == "" {
+					t.Errorf("Incomplete metadata for %+v", m)
+				}
+				if m.ResolutionKm <= 0 {
+					t.Errorf("Expected positive resolution for %s", m.Model)
+				}
+				if seen[m.Model] {
+					t.Errorf("Duplicate model %s", m.Model)
+				}
+				seen[m.Model] = true
+			}
+		})
+	}
+
+	if Models("unknown") != nil {
+		t.Error("Expected nil for unknown service")
+	}
+}
+
+// TestModels_ReturnsCopy tests that callers cannot modify the catalog
+func TestModels_ReturnsCopy(t *testing.T) {
+	models := Models(ServiceForecast)
+	models[0].Name = "changed"
+
+	if Models(ServiceForecast)[0].Name == "changed" {
+		t.Error("Expected Models to return a copy of the catalog")
+	}
+}
+
+// TestLookupModel tests looking up individual models
+func TestLookupModel(t *testing.T) {
+	m, ok := LookupModel(ServiceForecast, "icon_d2")
+	if !ok {
+		t.Fatal("Expected icon_d2 in forecast catalog")
+	}
+	if m.Provider != "DWD" || m.ForecastLength != 48*time.Hour || m.Members != 0 {
+		t.Errorf("Unexpected metadata %+v", m)
+	}
+
+	ens, ok := LookupModel(ServiceEnsemble, "ecmwf_ifs025")
+	if !ok || ens.Members != 51 || ens.Service != ServiceEnsemble {
+		t.Errorf("Unexpected ensemble metadata %+v (ok=%v)", ens, ok)
+	}
+
+	if _, ok := LookupModel(ServiceClimate, "icon_d2"); ok {
+		t.Error("Expected icon_d2 not to be a climate model")
+	}
+}
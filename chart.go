@@ -0,0 +1,118 @@
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// sparkBlocks are the glyphs of a sparkline, from lowest to highest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// barEighths are the partial blocks used for the fractional end of a bar (1/8 to 7/8).
+var barEighths = []rune("▏▎▍▌▋▊▉")
+
+// Sparkline renders values as a single line of block glyphs scaled between their minimum and
+// maximum (e.g., "▁▂▄▆█▆▄▂"). Missing values (NaN) are rendered as spaces; if all values are
+// equal the line is flat.
+func Sparkline(values []float64) string {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case math.IsNaN(v):
+			b.WriteRune(' ')
+		case hi == lo:
+			b.WriteRune(sparkBlocks[0])
+		default:
+			level := int(math.Round((v - lo) / (hi - lo) * float64(len(sparkBlocks)-1)))
+			b.WriteRune(sparkBlocks[level])
+		}
+	}
+	return b.String()
+}
+
+// Sparkline renders variable v as a sparkline (see Sparkline), e.g. a temperature curve.
+//
+// Example:
+//
+//	fmt.Println(f.Hourly.Sparkline(openmeteo.HourlyTemperature2m)) // ▁▁▂▃▅▇██▇▅▃▂
+func (s *Series) Sparkline(v Variable) string {
+	return Sparkline(s.Get(v))
+}
+
+// BarChart renders one horizontal bar per value, labelled on the left and followed by the
+// value, for example:
+//
+//	Mon 12:00 │███▌      1.4
+//	Mon 13:00 │████████  3.2
+//
+// The longest bar is width characters; bars start at zero, or at the smallest value if it is
+// negative. Missing values (NaN) have no bar and are shown as "-".
+func BarChart(labels []string, values []float64, width int) string {
+	width = max(width, 1)
+	lo, hi := 0.0, 0.0
+	labelWidth := 0
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+		if i < len(labels) {
+			labelWidth = max(labelWidth, utf8.RuneCountInString(labels[i]))
+		}
+	}
+
+	var b strings.Builder
+	for i, v := range values {
+		label := ""
+		if i < len(labels) {
+			label = labels[i]
+		}
+		fmt.Fprintf(&b, "%s%s │", label, strings.Repeat(" ", labelWidth-utf8.RuneCountInString(label)))
+
+		bar := ""
+		if !math.IsNaN(v) && hi > lo {
+			eighths := int(math.Round((v - lo) / (hi - lo) * float64(width*8)))
+			bar = strings.Repeat("█", eighths/8)
+			if eighths%8 > 0 {
+				bar += string(barEighths[eighths%8-1])
+			}
+		}
+		b.WriteString(bar)
+		b.WriteString(strings.Repeat(" ", width-utf8.RuneCountInString(bar)))
+
+		if math.IsNaN(v) {
+			b.WriteString(" -\n")
+		} else {
+			fmt.Fprintf(&b, " %.1f\n", v)
+		}
+	}
+	return b.String()
+}
+
+// BarChart renders variable v as a bar chart (see BarChart) labelled with the local time of
+// each step, e.g. precipitation bars. Daily series are labelled with the date.
+//
+// Example:
+//
+//	fmt.Print(f.Hourly.BarChart(openmeteo.HourlyPrecipitation, 20))
+func (s *Series) BarChart(v Variable, width int) string {
+	layout := "Mon 15:04"
+	if s.Interval() >= 24*time.Hour {
+		layout = "Mon 02 Jan"
+	}
+	times := s.TimesInLocal()
+	labels := make([]string, len(times))
+	for i, t := range times {
+		labels[i] = t.Format(layout)
+	}
+	return BarChart(labels, s.Get(v), width)
+}
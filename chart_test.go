@@ -0,0 +1,63 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestSparkline tests scaling, flat lines and missing values
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   string
+	}{
+		{"scaled", []float64{0, 1, 2, 3, 4, 5, 6, 7}, "▁▂▃▄▅▆▇█"},
+		{"missing", []float64{10, math.NaN(), 20}, "▁ █"},
+		{"flat", []float64{3, 3}, "▁▁"},
+		{"empty", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sparkline(tt.values); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	s := hourlySeries(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{HourlyTemperature2m: {-2, 5}})
+	if got := s.Sparkline(HourlyTemperature2m); got != "▁█" {
+		t.Errorf("Expected series sparkline, got %q", got)
+	}
+}
+
+// TestBarChart tests bar lengths, partial blocks and labels
+func TestBarChart(t *testing.T) {
+	got := BarChart([]string{"a", "bcd", "e"}, []float64{4, 1.5, math.NaN()}, 4)
+	want := "a   │████ 4.0\n" +
+		"bcd │█▌   1.5\n" +
+		"e   │     -\n"
+	if got != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, got)
+	}
+
+	negative := BarChart(nil, []float64{-2, 2}, 2)
+	if want := " │   -2.0\n │██ 2.0\n"; negative != want {
+		t.Errorf("Expected %q, got %q", want, negative)
+	}
+}
+
+// TestSeriesBarChart tests time labels for hourly and daily series
+func TestSeriesBarChart(t *testing.T) {
+	start := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+	hourly := hourlySeries(start, map[Variable][]float64{HourlyPrecipitation: {0, 2}})
+	if want := "Mon 12:00 │   0.0\nMon 13:00 │██ 2.0\n"; hourly.BarChart(HourlyPrecipitation, 2) != want {
+		t.Errorf("Unexpected hourly chart %q", hourly.BarChart(HourlyPrecipitation, 2))
+	}
+
+	daily := Series{Time: []time.Time{start, start.AddDate(0, 0, 1)}, Values: map[Variable][]float64{DailyPrecipitationSum: {1, 0}}}
+	if want := "Mon 02 Jun │█ 1.0\nTue 03 Jun │  0.0\n"; daily.BarChart(DailyPrecipitationSum, 1) != want {
+		t.Errorf("Unexpected daily chart %q", daily.BarChart(DailyPrecipitationSum, 1))
+	}
+}
@@ -3,11 +3,14 @@ package openmeteo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,6 +18,12 @@ const (
 	defaultBaseURL = "https://api.open-meteo.com/v1"
 	defaultTimeout = 10 * time.Second
 	maxConcurrent  = 10
+
+	// defaultUserAgent identifies the SDK to the API unless replaced with WithUserAgent
+	defaultUserAgent = "open-meteo-weather-sdk (+https://github.com/gregbalnis/open-meteo-weather-sdk)"
+
+	// currentVariables lists the variables requested for the current weather block
+	currentVariables = "temperature_2m,relative_humidity_2m,apparent_temperature,is_day,precipitation,rain,showers,snowfall,weather_code,cloud_cover,pressure_msl,surface_pressure,wind_speed_10m,wind_direction_10m,wind_gusts_10m,dew_point_2m,visibility,vapour_pressure_deficit,cape"
 )
 
 // Client is the main SDK entry point for making weather data requests.
@@ -24,11 +33,87 @@ type Client struct {
 	// httpClient is the HTTP client used for making API requests
 	httpClient *http.Client
 
-	// baseURL is the base URL for the Open Meteo API
-	baseURL string
+	// endpoints holds the base URLs of the Open Meteo services (see WithEndpoints)
+	endpoints Endpoints
+
+	// header holds the headers sent with every request (see WithUserAgent and WithDefaultHeader)
+	header http.Header
+
+	// apiKey is the commercial API key sent as the apikey parameter (see WithAPIKey)
+	apiKey string
+
+	// legacyCurrentWeather requests the legacy current_weather block instead of current
+	legacyCurrentWeather bool
+
+	// unixTime requests timestamps as Unix seconds (see WithUnixTime)
+	unixTime bool
+
+	// units are the units the API returns values in (see WithUnits)
+	units UnitSystem
+
+	// displayUnits is the display preference attached to returned CurrentWeather values
+	displayUnits DisplayUnits
+
+	// pathPrefix replaces the path of baseURL when set (see WithPathPrefix)
+	pathPrefix *string
+
+	// debug dumps requests and responses when set (see WithDebug)
+	debug *debugDumper
+
+	// debugBodyLimit overrides the debug dump body size when set (see WithDebugBodyLimit)
+	debugBodyLimit *int
+
+	// stats records per-endpoint request statistics (see Stats)
+	stats statsRecorder
+
+	// quota estimates consumed API call units (see WithQuotaPolicy)
+	quota quotaTracker
+
+	// offline holds the last good responses served on network failures (see WithOfflineFallback)
+	offline *offlineCache
+
+	// normals caches computed climatological normals (see GetNormals)
+	normals normalsCache
+
+	// modelFallback is the ordered model preference for forecast calls (see WithModelFallback)
+	modelFallback []Model
+
+	// retry retries transient failures (see WithRetry); nil means no retries
+	retry *retrier
 
-	// semaphore controls concurrent request limits (max 10 simultaneous requests)
+	// conditional remembers validated responses (see WithConditionalRequests)
+	conditional *conditionalCache
+
+	// metrics receives request events (see WithMetrics); nil means no instrumentation
+	metrics Metrics
+
+	// logger receives activity logs (see WithLogger); nil means no logging
+	logger *slog.Logger
+
+	// fallbackBaseURLs are the mirrors tried when a call fails (see WithFallbackBaseURLs)
+	fallbackBaseURLs []string
+
+	// hedgeDelay starts a hedged request after this delay (see WithHedging); 0 disables hedging
+	hedgeDelay time.Duration
+
+	// cache serves repeated requests for cacheTTL (see WithCache); nil means no caching
+	cache    Cache
+	cacheTTL time.Duration
+
+	// limiter paces calls across clients (see WithSharedLimiter); nil means no pacing
+	limiter Limiter
+
+	// semaphore controls concurrent request limits (max 10 simultaneous requests);
+	// nil means unlimited (see WithSelfHosted)
 	semaphore chan struct{}
+
+	// blockingConcurrency makes requests wait for a free slot instead of failing
+	// when the concurrency limit is reached (see WithBlockingConcurrency)
+	blockingConcurrency bool
+
+	// selfHosted disables the public API host switch, date limits and concurrency limit
+	// (see WithSelfHosted)
+	selfHosted bool
 }
 
 // NewClient creates a new Open Meteo API client with default configuration.
@@ -48,7 +133,8 @@ func NewClient(opts ...Option) *Client {
 		httpClient: &http.Client{
 			Timeout: defaultTimeout,
 		},
-		baseURL:   defaultBaseURL,
+		endpoints: DefaultEndpoints(),
+		header:    http.Header{"User-Agent": {defaultUserAgent}},
 		semaphore: make(chan struct{}, maxConcurrent),
 	}
 
@@ -56,17 +142,24 @@ func NewClient(opts ...Option) *Client {
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.apiKey != "" && !c.selfHosted {
+		c.useCustomerHosts()
+	}
+	if c.selfHosted {
+		c.semaphore = nil
+	}
 
 	return c
 }
 
 // GetCurrentWeather fetches current weather data for the specified geographic coordinates.
-// It returns all 15 weather parameters including temperature, humidity, wind, precipitation, etc.
+// It returns all 19 weather parameters including temperature, humidity, wind, precipitation, etc.
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - latitude: Latitude in degrees (-90 to 90)
 //   - longitude: Longitude in degrees (-180 to 180)
+//   - opts: Optional per-request settings (e.g., WithHeader)
 //
 // Returns:
 //   - *CurrentWeather: Complete weather data snapshot
@@ -89,92 +182,320 @@ func NewClient(opts ...Option) *Client {
 //	    }
 //	    return err
 //	}
-func (c *Client) GetCurrentWeather(ctx context.Context, latitude, longitude float64) (*CurrentWeather, error) {
-	// Validate coordinates
+func (c *Client) GetCurrentWeather(ctx context.Context, latitude, longitude float64, opts ...RequestOption) (*CurrentWeather, error) {
+	forecast, err := c.GetForecast(ctx, ForecastRequest{Latitude: latitude, Longitude: longitude, Current: true}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return forecast.Current, nil
+}
+
+// validateCoordinates checks that latitude and longitude are within valid ranges.
+func validateCoordinates(latitude, longitude float64, requestID string) error {
 	if latitude < -90 || latitude > 90 {
-		return nil, &Error{
-			Type:    ErrorTypeValidation,
-			Message: fmt.Sprintf("invalid latitude: %.2f (must be between -90 and 90)", latitude),
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf("invalid latitude: %.2f (must be between -90 and 90)", latitude),
+			RequestID: requestID,
 		}
 	}
 	if longitude < -180 || longitude > 180 {
-		return nil, &Error{
-			Type:    ErrorTypeValidation,
-			Message: fmt.Sprintf("invalid longitude: %.2f (must be between -180 and 180)", longitude),
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf("invalid longitude: %.2f (must be between -180 and 180)", longitude),
+			RequestID: requestID,
 		}
 	}
+	return nil
+}
 
-	// Acquire semaphore (concurrency control)
+// responseMeta describes how a decoded response was obtained.
+type responseMeta struct {
+	// stale reports that the response was served from the offline cache (see WithOfflineFallback)
+	stale bool
+
+	// age is the time since a stale response was fetched
+	age time.Duration
+}
+
+// doRequest executes a GET request against reqURL and decodes the JSON response body into out.
+// Responses cached by WithCache are decoded without a request. Calls fail over to fallback
+// hosts or are hedged when configured (see WithFallbackBaseURLs and WithHedging).
+// Transient failures are retried when a retry policy is configured (see WithRetry).
+// When offline fallback is enabled, network failures are answered from the offline cache
+// and reported through the returned responseMeta.
+func (c *Client) doRequest(ctx context.Context, requestID, reqURL string, cfg *requestConfig, out any) (responseMeta, error) {
+	var key string
+	if c.cache != nil {
+		key = cacheKey(reqURL)
+		cached, hit := c.cache.Get(key)
+		hit = hit && json.Unmarshal(cached, out) == nil
+		if c.metrics != nil {
+			if u, err := url.Parse(reqURL); err == nil {
+				c.metrics.CacheLookup(metricsEndpoint(u.Path), hit)
+			}
+		}
+		if hit {
+			c.logRequest(ctx, slog.LevelDebug, "openmeteo: cache hit", requestID, reqURL)
+			return responseMeta{}, nil
+		}
+		c.logRequest(ctx, slog.LevelDebug, "openmeteo: cache miss", requestID, reqURL)
+	}
+
+	var body []byte
+	var err error
+	if c.retry != nil {
+		err = c.retry.do(ctx, func() (fetchErr error) {
+			body, fetchErr = c.fetchFailover(ctx, requestID, reqURL, cfg, out)
+			return fetchErr
+		}, func(attempt int, wait time.Duration, err error) {
+			c.logRequest(ctx, slog.LevelInfo, "openmeteo: retrying request", requestID, reqURL,
+				slog.Int("attempt", attempt), slog.Duration("wait", wait), slog.Any("error", err))
+		})
+	} else {
+		body, err = c.fetchFailover(ctx, requestID, reqURL, cfg, out)
+	}
+	if cfg.received != nil {
+		cfg.received.Add(int64(len(body)))
+	}
+	if err == nil && c.cache != nil {
+		c.cache.Set(key, body, c.cacheTTL)
+	}
+	if c.offline == nil {
+		return responseMeta{}, err
+	}
+	if err == nil {
+		c.offline.put(reqURL, body)
+		return responseMeta{}, nil
+	}
+
+	var sdkErr *Error
+	if !errors.As(err, &sdkErr) || sdkErr.Type != ErrorTypeNetwork || errors.Is(ctx.Err(), context.Canceled) {
+		return responseMeta{}, err
+	}
+	cached, age, ok := c.offline.get(reqURL)
+	if !ok || json.Unmarshal(cached, out) != nil {
+		return responseMeta{}, err
+	}
+	return responseMeta{stale: true, age: age}, nil
+}
+
+// acquireSlot takes a slot of the concurrency limit. Without blocking concurrency it fails
+// immediately when all slots are taken; otherwise it waits until a slot frees up or ctx ends.
+func (c *Client) acquireSlot(ctx context.Context, requestID string) error {
+	if c.semaphore == nil {
+		return nil
+	}
+	if c.blockingConcurrency {
+		select {
+		case c.semaphore <- struct{}{}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 	select {
 	case c.semaphore <- struct{}{}:
-		defer func() { <-c.semaphore }()
+		return nil
 	case <-ctx.Done():
-		return nil, ctx.Err()
+		return ctx.Err()
 	default:
-		return nil, &Error{
-			Type:    ErrorTypeValidation,
-			Message: fmt.Sprintf("concurrent request limit exceeded (%d)", maxConcurrent),
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf("concurrent request limit exceeded (%d); use WithBlockingConcurrency to wait instead", maxConcurrent),
+			RequestID: requestID,
 		}
 	}
+}
 
-	// Build request URL
-	reqURL, err := c.buildRequestURL(latitude, longitude)
-	if err != nil {
-		return nil, &Error{
-			Type:    ErrorTypeValidation,
-			Message: "failed to build request URL",
-			Cause:   err,
+// parallelism returns the number of workers used to fan out requests: the concurrency
+// limit, or maxConcurrent when the limit is disabled.
+func (c *Client) parallelism() int {
+	if c.semaphore == nil {
+		return maxConcurrent
+	}
+	return cap(c.semaphore)
+}
+
+// fetch executes a GET request against reqURL under the client's concurrency limit,
+// decodes the JSON response body into out and returns the raw body. All failures are
+// returned as *Error (except context cancellation while waiting for the shared limiter or
+// a slot, which returns ctx.Err()).
+func (c *Client) fetch(ctx context.Context, requestID, reqURL string, cfg *requestConfig, out any) (body []byte, err error) {
+	if c.limiter != nil {
+		waitStart := time.Now()
+		err := c.limiter.Wait(ctx)
+		c.logRequest(ctx, slog.LevelDebug, "openmeteo: rate limiter wait", requestID, reqURL, slog.Duration("duration", time.Since(waitStart)))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, &Error{
+				Type:      ErrorTypeValidation,
+				Message:   "rate limiter rejected the request",
+				Cause:     err,
+				RequestID: requestID,
+			}
 		}
 	}
 
+	// Acquire semaphore (concurrency control)
+	if err := c.acquireSlot(ctx, requestID); err != nil {
+		return nil, err
+	}
+	if c.semaphore != nil {
+		defer func() { <-c.semaphore }()
+	}
+
 	// Create HTTP request
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
 		return nil, &Error{
-			Type:    ErrorTypeNetwork,
-			Message: "failed to create HTTP request",
-			Cause:   err,
+			Type:      ErrorTypeNetwork,
+			Message:   "failed to create HTTP request",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	if err := c.quota.acquire(callWeight(req.URL.Query())); err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "API quota limit reached",
+			Cause:     err,
+			RequestID: requestID,
 		}
 	}
 
+	for key, values := range c.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	cfg.applyHeaders(req)
+	req.Header.Set(RequestIDHeader, requestID)
+
+	var remembered []byte
+	var validated bool
+	if c.conditional != nil {
+		remembered, validated = c.conditional.prepare(req)
+	}
+
 	// Execute request
+	if c.debug != nil {
+		c.debug.dumpRequest(req)
+	}
+	c.logRequest(ctx, slog.LevelDebug, "openmeteo: request started", requestID, reqURL)
+	if c.metrics != nil {
+		c.metrics.RequestStarted(metricsEndpoint(req.URL.Path))
+	}
+	start := time.Now()
+	status := 0
+	defer func() {
+		elapsed := time.Since(start)
+		c.stats.record(endpointName(req.URL.Path), elapsed, err != nil)
+		if c.metrics != nil {
+			c.metrics.RequestFinished(metricsEndpoint(req.URL.Path), elapsed, err)
+		}
+		if err != nil {
+			c.logRequest(ctx, slog.LevelWarn, "openmeteo: request failed", requestID, reqURL,
+				slog.Duration("duration", elapsed), slog.Int("status", status), slog.Any("error", err))
+			return
+		}
+		c.logRequest(ctx, slog.LevelInfo, "openmeteo: request completed", requestID, reqURL,
+			slog.Duration("duration", elapsed), slog.Int("status", status), slog.Int("bytes", len(body)))
+	}()
 	resp, err := c.httpClient.Do(req)
+	if c.debug != nil {
+		if err != nil {
+			c.debug.dumpError(req, err, time.Since(start))
+		} else {
+			c.debug.dumpResponse(req, resp, time.Since(start))
+		}
+	}
 	if err != nil {
 		return nil, &Error{
-			Type:    ErrorTypeNetwork,
-			Message: "failed to execute HTTP request",
-			Cause:   err,
+			Type:      ErrorTypeNetwork,
+			Message:   "failed to execute HTTP request",
+			Cause:     c.redactAPIKey(err),
+			RequestID: requestID,
 		}
 	}
 	defer func() { _ = resp.Body.Close() }()
+	status = resp.StatusCode
 
-	// Check HTTP status code
+	// Check HTTP status code; 304 Not Modified reuses the remembered response
+	if resp.StatusCode == http.StatusNotModified && validated {
+		return decodeResponse(requestID, req, remembered, out)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, &Error{
-			Type:    ErrorTypeAPI,
-			Message: fmt.Sprintf("API returned status %d: %s", resp.StatusCode, string(body)),
+			Type:       ErrorTypeAPI,
+			Message:    fmt.Sprintf("API returned status %d: %s", resp.StatusCode, string(body)),
+			RequestID:  requestID,
+			statusCode: resp.StatusCode,
+		}
+	}
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeNetwork,
+			Message:   "failed to read response body",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+	if c.conditional != nil {
+		c.conditional.store(req, resp, body)
+	}
+
+	return decodeResponse(requestID, req, body, out)
+}
+
+// decodeResponse converts a raw response body of req into out, returning the JSON body.
+func decodeResponse(requestID string, req *http.Request, body []byte, out any) ([]byte, error) {
+	var err error
+
+	// CSV responses (see WithFormat) are converted to the equivalent JSON document
+	if query := req.URL.Query(); query.Get("format") == string(FormatCSV) {
+		if body, err = csvToJSON(body, query); err != nil {
+			return nil, &Error{
+				Type:      ErrorTypeAPI,
+				Message:   "failed to parse CSV response",
+				Cause:     err,
+				RequestID: requestID,
+			}
 		}
 	}
 
 	// Parse JSON response
-	var apiResp weatherResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+	if err := json.Unmarshal(body, out); err != nil {
 		return nil, &Error{
-			Type:    ErrorTypeAPI,
-			Message: "failed to parse JSON response",
-			Cause:   err,
+			Type:      ErrorTypeAPI,
+			Message:   "failed to parse JSON response",
+			Cause:     err,
+			RequestID: requestID,
 		}
 	}
 
-	// Convert to CurrentWeather
-	weather := c.convertToCurrentWeather(apiResp)
-	return weather, nil
+	return body, nil
+}
+
+// buildRequestURL constructs the forecast API request URL (or the model endpoint URL, see
+// WithModelEndpoint) for the given coordinates, endpoint-specific query parameters and
+// per-request settings.
+func (c *Client) buildRequestURL(latitude, longitude float64, params url.Values, cfg *requestConfig) (string, error) {
+	path := "/forecast"
+	if cfg.endpoint != "" {
+		path = "/" + string(cfg.endpoint)
+	}
+	return c.buildServiceURL(c.endpoints.Forecast, path, latitude, longitude, params, cfg)
 }
 
-// buildRequestURL constructs the API request URL with query parameters
-func (c *Client) buildRequestURL(latitude, longitude float64) (string, error) {
-	u, err := url.Parse(c.baseURL + "/forecast")
+// buildServiceURL constructs a request URL for the endpoint path of a service base URL
+// with the given coordinates, endpoint-specific query parameters and per-request settings.
+func (c *Client) buildServiceURL(base, path string, latitude, longitude float64, params url.Values, cfg *requestConfig) (string, error) {
+	u, err := c.endpointURL(base, path)
 	if err != nil {
 		return "", err
 	}
@@ -182,73 +503,214 @@ func (c *Client) buildRequestURL(latitude, longitude float64) (string, error) {
 	q := u.Query()
 	q.Set("latitude", strconv.FormatFloat(latitude, 'f', -1, 64))
 	q.Set("longitude", strconv.FormatFloat(longitude, 'f', -1, 64))
-	q.Set("current", "temperature_2m,relative_humidity_2m,apparent_temperature,is_day,precipitation,rain,showers,snowfall,weather_code,cloud_cover,pressure_msl,surface_pressure,wind_speed_10m,wind_direction_10m,wind_gusts_10m")
+	for key, values := range params {
+		q[key] = values
+	}
+	cfg.applyQuery(q)
+	if path != "/air-quality" && path != "/marine" && path != "/flood" {
+		c.unitsFor(cfg).applyQuery(q)
+	}
+	if c.unixTime {
+		q.Set("timeformat", "unixtime")
+	}
+	if c.apiKey != "" {
+		q.Set("apikey", c.apiKey)
+	}
 	u.RawQuery = q.Encode()
 
 	return u.String(), nil
 }
 
+// unitsFor returns the unit system of a request: the per-request units if set, otherwise
+// the client's.
+func (c *Client) unitsFor(cfg *requestConfig) UnitSystem {
+	if cfg.units != nil {
+		return *cfg.units
+	}
+	return c.units
+}
+
+// customerHostPrefix turns a public open-meteo.com host into its commercial counterpart.
+const customerHostPrefix = "customer-"
+
+// useCustomerHosts switches the base URLs of the public API hosts to the customer hosts
+// used with an API key. Custom hosts are left unchanged.
+func (c *Client) useCustomerHosts() {
+	for _, base := range c.endpoints.fields() {
+		u, err := url.Parse(*base)
+		if err != nil {
+			continue
+		}
+		host := u.Hostname()
+		if !isOpenMeteoHost(host) || strings.HasPrefix(host, customerHostPrefix) {
+			continue
+		}
+		u.Host = customerHostPrefix + u.Host
+		*base = u.String()
+	}
+}
+
+// redactAPIKey removes the API key from the URL of a failed HTTP request, so that it does
+// not leak through error messages.
+func (c *Client) redactAPIKey(err error) error {
+	var urlErr *url.Error
+	if c.apiKey == "" || !errors.As(err, &urlErr) {
+		return err
+	}
+	clean := *urlErr
+	clean.URL = strings.ReplaceAll(clean.URL, url.QueryEscape(c.apiKey), "REDACTED")
+	return &clean
+}
+
+// endpointURL joins a service base URL and an endpoint path, applying the path prefix if configured.
+func (c *Client) endpointURL(base, path string) (*url.URL, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+	if c.pathPrefix != nil {
+		u.Path = strings.TrimSuffix(*c.pathPrefix, "/")
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	return u, nil
+}
+
 // convertToCurrentWeather converts the internal API response to the public CurrentWeather type.
-// Null values from the API are converted to zero values.
-func (c *Client) convertToCurrentWeather(apiResp weatherResponse) *CurrentWeather {
+// Null values from the API are converted to zero values and left out of Fields. Responses
+// carrying only the legacy current_weather block are detected automatically.
+func (c *Client) convertToCurrentWeather(apiResp weatherResponse, units UnitSystem) *CurrentWeather {
+	loc := resolveLocation(apiResp.Timezone, apiResp.TimezoneAbbreviation, apiResp.UTCOffsetSeconds)
 	cw := &CurrentWeather{
-		Latitude:  apiResp.Latitude,
-		Longitude: apiResp.Longitude,
+		Latitude:         apiResp.Latitude,
+		Longitude:        apiResp.Longitude,
+		Location:         loc,
+		Timezone:         apiResp.Timezone,
+		UTCOffsetSeconds: apiResp.UTCOffsetSeconds,
+		Units:            units,
+		DisplayUnits:     c.displayUnits,
+	}
+
+	if apiResp.CurrentWeather.Time == nil && apiResp.LegacyCurrentWeather != nil {
+		convertLegacyCurrentWeather(cw, apiResp.LegacyCurrentWeather, loc)
+		return cw
 	}
 
 	// Parse time
 	if apiResp.CurrentWeather.Time != nil {
-		if t, err := time.Parse("2006-01-02T15:04", *apiResp.CurrentWeather.Time); err == nil {
-			cw.Time = t.UTC()
+		if t, err := parseAPITime(string(*apiResp.CurrentWeather.Time), loc); err == nil {
+			cw.Time = t
+			cw.Fields |= FieldTime
 		}
 	}
 
 	// Copy fields with null handling (use zero values for nil pointers)
 	if apiResp.CurrentWeather.Temperature != nil {
 		cw.Temperature = *apiResp.CurrentWeather.Temperature
+		cw.Fields |= FieldTemperature
 	}
 	if apiResp.CurrentWeather.RelativeHumidity != nil {
 		cw.RelativeHumidity = *apiResp.CurrentWeather.RelativeHumidity
+		cw.Fields |= FieldRelativeHumidity
 	}
 	if apiResp.CurrentWeather.ApparentTemperature != nil {
 		cw.ApparentTemperature = *apiResp.CurrentWeather.ApparentTemperature
+		cw.Fields |= FieldApparentTemperature
 	}
 	if apiResp.CurrentWeather.IsDay != nil {
 		cw.IsDay = *apiResp.CurrentWeather.IsDay == 1
+		cw.Fields |= FieldIsDay
 	}
 	if apiResp.CurrentWeather.Precipitation != nil {
 		cw.Precipitation = *apiResp.CurrentWeather.Precipitation
+		cw.Fields |= FieldPrecipitation
 	}
 	if apiResp.CurrentWeather.Rain != nil {
 		cw.Rain = *apiResp.CurrentWeather.Rain
+		cw.Fields |= FieldRain
 	}
 	if apiResp.CurrentWeather.Showers != nil {
 		cw.Showers = *apiResp.CurrentWeather.Showers
+		cw.Fields |= FieldShowers
 	}
 	if apiResp.CurrentWeather.Snowfall != nil {
 		cw.Snowfall = *apiResp.CurrentWeather.Snowfall
+		cw.Fields |= FieldSnowfall
 	}
 	if apiResp.CurrentWeather.Weathercode != nil {
-		cw.WeatherCode = *apiResp.CurrentWeather.Weathercode
+		cw.WeatherCode = WeatherCode(*apiResp.CurrentWeather.Weathercode)
+		cw.Fields |= FieldWeatherCode
 	}
 	if apiResp.CurrentWeather.CloudCover != nil {
 		cw.CloudCover = *apiResp.CurrentWeather.CloudCover
+		cw.Fields |= FieldCloudCover
 	}
 	if apiResp.CurrentWeather.PressureMSL != nil {
 		cw.PressureMSL = *apiResp.CurrentWeather.PressureMSL
+		cw.Fields |= FieldPressureMSL
 	}
 	if apiResp.CurrentWeather.SurfacePressure != nil {
 		cw.SurfacePressure = *apiResp.CurrentWeather.SurfacePressure
+		cw.Fields |= FieldSurfacePressure
 	}
 	if apiResp.CurrentWeather.Windspeed != nil {
 		cw.WindSpeed = *apiResp.CurrentWeather.Windspeed
+		cw.Fields |= FieldWindSpeed
 	}
 	if apiResp.CurrentWeather.Winddirection != nil {
 		cw.WindDirection = *apiResp.CurrentWeather.Winddirection
+		cw.Fields |= FieldWindDirection
 	}
 	if apiResp.CurrentWeather.WindGusts != nil {
 		cw.WindGusts = *apiResp.CurrentWeather.WindGusts
+		cw.Fields |= FieldWindGusts
+	}
+	if apiResp.CurrentWeather.DewPoint != nil {
+		cw.DewPoint = *apiResp.CurrentWeather.DewPoint
+		cw.Fields |= FieldDewPoint
+	}
+	if apiResp.CurrentWeather.Visibility != nil {
+		cw.Visibility = *apiResp.CurrentWeather.Visibility
+		cw.Fields |= FieldVisibility
+	}
+	if apiResp.CurrentWeather.VapourPressureDeficit != nil {
+		cw.VapourPressureDeficit = *apiResp.CurrentWeather.VapourPressureDeficit
+		cw.Fields |= FieldVapourPressureDeficit
+	}
+	if apiResp.CurrentWeather.CAPE != nil {
+		cw.CAPE = *apiResp.CurrentWeather.CAPE
+		cw.Fields |= FieldCAPE
 	}
 
 	return cw
 }
+
+// convertLegacyCurrentWeather copies the fields of a legacy current_weather block into cw.
+// Variables not present in the legacy schema are left at their zero values and out of Fields.
+func convertLegacyCurrentWeather(cw *CurrentWeather, legacy *legacyCurrentWeatherResponse, loc *time.Location) {
+	if legacy.Time != nil {
+		if t, err := parseAPITime(string(*legacy.Time), loc); err == nil {
+			cw.Time = t
+			cw.Fields |= FieldTime
+		}
+	}
+	if legacy.Temperature != nil {
+		cw.Temperature = *legacy.Temperature
+		cw.Fields |= FieldTemperature
+	}
+	if legacy.Windspeed != nil {
+		cw.WindSpeed = *legacy.Windspeed
+		cw.Fields |= FieldWindSpeed
+	}
+	if legacy.Winddirection != nil {
+		cw.WindDirection = *legacy.Winddirection
+		cw.Fields |= FieldWindDirection
+	}
+	if legacy.Weathercode != nil {
+		cw.WeatherCode = WeatherCode(*legacy.Weathercode)
+		cw.Fields |= FieldWeatherCode
+	}
+	if legacy.IsDay != nil {
+		cw.IsDay = *legacy.IsDay == 1
+		cw.Fields |= FieldIsDay
+	}
+}
@@ -21,8 +21,8 @@ func TestGetCurrentWeather_Success(t *testing.T) {
 		if r.URL.Query().Get("longitude") != "13.41" {
 			t.Errorf("Expected longitude 13.41, got %s", r.URL.Query().Get("longitude"))
 		}
-		if r.URL.Query().Get("current") != "temperature_2m,relative_humidity_2m,apparent_temperature,is_day,precipitation,rain,showers,snowfall,weather_code,cloud_cover,pressure_msl,surface_pressure,wind_speed_10m,wind_direction_10m,wind_gusts_10m" {
-			t.Error("Expected current=temperature_2m,relative_humidity_2m,apparent_temperature,is_day,precipitation,rain,showers,snowfall,weather_code,cloud_cover,pressure_msl,surface_pressure,wind_speed_10m,wind_direction_10m,wind_gusts_10m")
+		if r.URL.Query().Get("current") != "temperature_2m,relative_humidity_2m,apparent_temperature,is_day,precipitation,rain,showers,snowfall,weather_code,cloud_cover,pressure_msl,surface_pressure,wind_speed_10m,wind_direction_10m,wind_gusts_10m,dew_point_2m,visibility,vapour_pressure_deficit,cape" {
+			t.Error("Expected current=temperature_2m,relative_humidity_2m,apparent_temperature,is_day,precipitation,rain,showers,snowfall,weather_code,cloud_cover,pressure_msl,surface_pressure,wind_speed_10m,wind_direction_10m,wind_gusts_10m,dew_point_2m,visibility,vapour_pressure_deficit,cape")
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -45,7 +45,11 @@ func TestGetCurrentWeather_Success(t *testing.T) {
 				"cloud_cover": 75.0,
 				"pressure_msl": 1013.25,
 				"surface_pressure": 1010.0,
-				"wind_gusts_10m": 18.0
+				"wind_gusts_10m": 18.0,
+				"dew_point_2m": 8.7,
+				"visibility": 24140.0,
+				"vapour_pressure_deficit": 0.61,
+				"cape": 120.0
 			}
 		}`)
 	}))
@@ -117,6 +121,18 @@ func TestGetCurrentWeather_Success(t *testing.T) {
 	if weather.WindGusts != 18.0 {
 		t.Errorf("Expected wind gusts 18.0, got %.1f", weather.WindGusts)
 	}
+	if weather.DewPoint != 8.7 {
+		t.Errorf("Expected dew point 8.7, got %.1f", weather.DewPoint)
+	}
+	if weather.Visibility != 24140.0 {
+		t.Errorf("Expected visibility 24140.0, got %.1f", weather.Visibility)
+	}
+	if weather.VapourPressureDeficit != 0.61 {
+		t.Errorf("Expected vapour pressure deficit 0.61, got %.2f", weather.VapourPressureDeficit)
+	}
+	if weather.CAPE != 120.0 {
+		t.Errorf("Expected CAPE 120.0, got %.1f", weather.CAPE)
+	}
 }
 
 // TestGetCurrentWeather_BoundaryCoordinates tests valid boundary coordinates
@@ -324,6 +340,47 @@ func TestGetCurrentWeather_ConcurrencyLimitExceeded(t *testing.T) {
 	}
 }
 
+// TestGetCurrentWeather_BlockingConcurrency tests that surplus requests wait for a free slot
+func TestGetCurrentWeather_BlockingConcurrency(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 0.0, "longitude": 0.0, "current": {"time": "2025-12-29T10:00"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithBlockingConcurrency(true))
+
+	var wg sync.WaitGroup
+	results := make(chan error, 15)
+	for i := 0; i < 15; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetCurrentWeather(context.Background(), 0.0, 0.0)
+			results <- err
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	for err := range results {
+		if err != nil {
+			t.Errorf("Expected all requests to succeed, got %v", err)
+		}
+	}
+
+	// A waiting request gives up when its context ends
+	for i := 0; i < maxConcurrent; i++ {
+		client.semaphore <- struct{}{}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetCurrentWeather(ctx, 0.0, 0.0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
 // TestGetCurrentWeather_ContextCancellation tests context cancellation
 func TestGetCurrentWeather_ContextCancellation(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -417,10 +474,106 @@ func TestNewClient_DefaultConfiguration(t *testing.T) {
 	if client.httpClient.Timeout != defaultTimeout {
 		t.Errorf("Expected timeout %v, got %v", defaultTimeout, client.httpClient.Timeout)
 	}
-	if client.baseURL != defaultBaseURL {
-		t.Errorf("Expected base URL %s, got %s", defaultBaseURL, client.baseURL)
+	if client.endpoints.Forecast != defaultBaseURL {
+		t.Errorf("Expected base URL %s, got %s", defaultBaseURL, client.endpoints.Forecast)
 	}
 	if cap(client.semaphore) != maxConcurrent {
 		t.Errorf("Expected semaphore capacity %d, got %d", maxConcurrent, cap(client.semaphore))
 	}
 }
+
+// TestGetCurrentWeather_LegacySchema tests auto-detection of the legacy current_weather block
+func TestGetCurrentWeather_LegacySchema(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("current") == "" {
+			t.Error("Expected modern current parameter by default")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 52.52,
+			"longitude": 13.41,
+			"current_weather": {
+				"time": "2025-12-29T10:00",
+				"temperature": 4.2,
+				"windspeed": 11.0,
+				"winddirection": 250.0,
+				"weathercode": 61,
+				"is_day": 0
+			}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if weather.Temperature != 4.2 {
+		t.Errorf("Expected temperature 4.2, got %.1f", weather.Temperature)
+	}
+	if weather.WindSpeed != 11.0 || weather.WindDirection != 250.0 {
+		t.Errorf("Expected wind 11.0 at 250°, got %.1f at %.0f°", weather.WindSpeed, weather.WindDirection)
+	}
+	if weather.WeatherCode != 61 {
+		t.Errorf("Expected weather code 61, got %d", weather.WeatherCode)
+	}
+	if weather.IsDay {
+		t.Error("Expected IsDay to be false")
+	}
+	expectedTime := time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)
+	if !weather.Time.Equal(expectedTime) {
+		t.Errorf("Expected time %v, got %v", expectedTime, weather.Time)
+	}
+	if !weather.Has(FieldTime|FieldTemperature|FieldWindSpeed|FieldWindDirection|FieldWeatherCode|FieldIsDay) || weather.Has(FieldRain) {
+		t.Errorf("Expected only legacy fields to be present, got %b", weather.Fields)
+	}
+}
+
+// TestGetCurrentWeather_WithLegacyCurrentWeather tests requesting the legacy block explicitly
+func TestGetCurrentWeather_WithLegacyCurrentWeather(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("current_weather") != "true" {
+			t.Errorf("Expected current_weather=true, got %s", r.URL.Query().Get("current_weather"))
+		}
+		if r.URL.Query().Has("current") {
+			t.Error("Expected no current parameter in legacy mode")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 0, "longitude": 0, "current_weather": {"time": "2025-12-29T10:00", "temperature": -3.5}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithLegacyCurrentWeather())
+	weather, err := client.GetCurrentWeather(context.Background(), 0, 0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if weather.Temperature != -3.5 {
+		t.Errorf("Expected temperature -3.5, got %.1f", weather.Temperature)
+	}
+}
+
+// TestGetCurrentWeather_ModernSchemaPreferred tests that the modern block wins when both are present
+func TestGetCurrentWeather_ModernSchemaPreferred(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 0,
+			"longitude": 0,
+			"current": {"time": "2025-12-29T10:00", "temperature_2m": 20.0, "relative_humidity_2m": 40},
+			"current_weather": {"time": "2025-12-29T10:00", "temperature": 19.0}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	weather, err := client.GetCurrentWeather(context.Background(), 0, 0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if weather.Temperature != 20.0 || weather.RelativeHumidity != 40 {
+		t.Errorf("Expected modern block values, got temperature %.1f humidity %.0f", weather.Temperature, weather.RelativeHumidity)
+	}
+}
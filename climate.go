@@ -0,0 +1,172 @@
+package openmeteo
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// defaultClimateBaseURL is the base URL of the Open Meteo climate projection API
+const defaultClimateBaseURL = "https://climate-api.open-meteo.com/v1"
+
+// CMIP6 HighResMIP models served by the climate projection API (see GetClimateProjection).
+const (
+	ClimateCMCCCM2VHR4 Model = "CMCC_CM2_VHR4"
+	ClimateFGOALSf3H   Model = "FGOALS_f3_H"
+	ClimateHiRAMSITHR  Model = "HiRAM_SIT_HR"
+	ClimateMRIAGCM32S  Model = "MRI_AGCM3_2_S"
+	ClimateECEarth3PHR Model = "EC_Earth3P_HR"
+	ClimateMPIESM12XR  Model = "MPI_ESM1_2_XR"
+	ClimateNICAM168S   Model = "NICAM16_8S"
+)
+
+// ClimateProjection holds daily climate model data for a location.
+type ClimateProjection struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (daily values cover UTC days)
+	Location *time.Location `json:"-"`
+
+	// Models lists the requested climate models
+	Models []Model
+
+	// Daily holds the requested daily variables under their API names; with several models
+	// each name ends in the model (e.g., "temperature_2m_max_EC_Earth3P_HR"). Use Get instead
+	// of building the names.
+	Daily Series
+
+	// Stale reports that the projection was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since a stale projection was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// Get returns the daily values of variable v projected by model, aligned with Daily.Time.
+// The model may be empty when a single model was requested. It returns nil if the variable
+// or model was not returned.
+func (p *ClimateProjection) Get(v Variable, model Model) []float64 {
+	if len(p.Models) > 1 {
+		v += Variable("_" + string(model))
+	}
+	return p.Daily.Get(v)
+}
+
+// WithoutBiasCorrection disables the statistical bias correction of climate projections
+// (disable_bias_correction), returning the raw model output. By default GetClimateProjection
+// corrects each model against ERA5 reanalysis, which makes models comparable with each other
+// and with observations. Other calls ignore this option.
+func WithoutBiasCorrection() RequestOption {
+	return func(r *requestConfig) {
+		r.disableBiasCorrection = true
+	}
+}
+
+// GetClimateProjection fetches daily data of CMIP6 climate models from the climate projection
+// API (climate-api.open-meteo.com, see WithClimateBaseURL) for the calendar days from start to
+// end (inclusive), between 1950 and 2050. Climate projections describe long-term trends and
+// statistics (e.g., the number of hot days per decade), not the weather of a particular day.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - latitude: Latitude in degrees (-90 to 90)
+//   - longitude: Longitude in degrees (-180 to 180)
+//   - vars: Daily variables to request (at least one, e.g., DailyTemperature2mMax)
+//   - models: Climate models to request (at least one, e.g., ClimateECEarth3PHR)
+//   - start, end: Calendar days to request (1950-01-01 to 2050-12-31)
+//   - opts: Optional per-request settings (e.g., WithoutBiasCorrection)
+//
+// Example:
+//
+//	projection, err := client.GetClimateProjection(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.DailyTemperature2mMax},
+//	    []openmeteo.Model{openmeteo.ClimateECEarth3PHR, openmeteo.ClimateMRIAGCM32S},
+//	    time.Date(2041, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2050, 12, 31, 0, 0, 0, 0, time.UTC))
+//	if err != nil {
+//	    return err
+//	}
+//	maxima := projection.Get(openmeteo.DailyTemperature2mMax, openmeteo.ClimateECEarth3PHR)
+func (c *Client) GetClimateProjection(ctx context.Context, latitude, longitude float64, vars []Variable, models []Model, start, end time.Time, opts ...RequestOption) (*ClimateProjection, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if len(vars) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one daily climate variable is required",
+			RequestID: requestID,
+		}
+	}
+	if len(models) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one climate model is required",
+			RequestID: requestID,
+		}
+	}
+	if err := validateDateRange(start, end); err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   err.Error(),
+			RequestID: requestID,
+		}
+	}
+	cfg.startDate, cfg.endDate = start, end
+	cfg.startHour, cfg.endHour = time.Time{}, time.Time{}
+	if err := c.checkDateLimits(cfg, ServiceClimate, requestID); err != nil {
+		return nil, err
+	}
+
+	// The climate API serves daily values for UTC days only and takes no timezone, so the
+	// general compatibility rules for daily data do not apply.
+	cfg.timezone = ""
+	q := url.Values{}
+	q.Set("daily", joinVariables(vars))
+	q.Set("models", joinModels(models))
+	reqURL, err := c.buildServiceURL(c.endpoints.Climate, "/climate", latitude, longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp forecastResponse
+	meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := resolveLocation(apiResp.Timezone, apiResp.TimezoneAbbreviation, apiResp.UTCOffsetSeconds)
+	daily, err := parseSeries(apiResp.Daily, apiResp.DailyUnits, loc)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeAPI,
+			Message:   "failed to parse daily data",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	return &ClimateProjection{
+		Latitude:  apiResp.Latitude,
+		Longitude: apiResp.Longitude,
+		Location:  loc,
+		Models:    append([]Model(nil), models...),
+		Daily:     daily,
+		Stale:     meta.stale,
+		Age:       meta.age,
+	}, nil
+}
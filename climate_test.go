@@ -0,0 +1,89 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestGetClimateProjection tests the climate request, per-model access and bias correction
+func TestGetClimateProjection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/climate" || q.Get("daily") != "temperature_2m_max" || q.Get("models") != "EC_Earth3P_HR,MRI_AGCM3_2_S" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		if q.Get("start_date") != "2050-01-01" || q.Get("end_date") != "2050-01-02" || q.Get("disable_bias_correction") != "true" || q.Has("timezone") {
+			t.Errorf("Unexpected parameters %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"latitude": 52.5, "longitude": 13.4, "daily_units": {"temperature_2m_max_EC_Earth3P_HR": "°C"},
+			"daily": {"time": ["2050-01-01", "2050-01-02"],
+				"temperature_2m_max_EC_Earth3P_HR": [3.2, 4.1],
+				"temperature_2m_max_MRI_AGCM3_2_S": [2.8, null]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithClimateBaseURL(server.URL))
+	start := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+	projection, err := client.GetClimateProjection(context.Background(), 52.52, 13.41,
+		[]Variable{DailyTemperature2mMax}, []Model{ClimateECEarth3PHR, ClimateMRIAGCM32S},
+		start, start.AddDate(0, 0, 1), WithoutBiasCorrection(), WithTimezone("auto"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := projection.Get(DailyTemperature2mMax, ClimateECEarth3PHR); len(got) != 2 || got[1] != 4.1 {
+		t.Errorf("Unexpected EC-Earth values %v", got)
+	}
+	if got := projection.Get(DailyTemperature2mMax, ClimateMRIAGCM32S); len(got) != 2 || got[0] != 2.8 {
+		t.Errorf("Unexpected MRI values %v", got)
+	}
+	if got := projection.Get(DailyTemperature2mMax, ClimateNICAM168S); got != nil {
+		t.Errorf("Expected nil for a model not requested, got %v", got)
+	}
+
+	single := &ClimateProjection{Models: []Model{ClimateECEarth3PHR}, Daily: Series{Values: map[Variable][]float64{DailyTemperature2mMax: {1}}}}
+	if got := single.Get(DailyTemperature2mMax, ""); len(got) != 1 {
+		t.Errorf("Expected values without model suffix, got %v", got)
+	}
+}
+
+// TestGetClimateProjection_Validation tests that invalid requests fail before any HTTP call
+func TestGetClimateProjection_Validation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client := NewClient(WithClimateBaseURL(server.URL))
+	vars, models := []Variable{DailyTemperature2mMax}, []Model{ClimateECEarth3PHR}
+	start := time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		vars       []Variable
+		models     []Model
+		start, end time.Time
+	}{
+		{"no variables", nil, models, start, start},
+		{"no models", vars, nil, start, start},
+		{"reversed range", vars, models, start, start.AddDate(0, 0, -1)},
+		{"after 2050", vars, models, start, time.Date(2051, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"before 1950", vars, models, time.Date(1949, 12, 31, 0, 0, 0, 0, time.UTC), start},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetClimateProjection(context.Background(), 52.52, 13.41, tt.vars, tt.models, tt.start, tt.end)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+	if called {
+		t.Error("Expected no HTTP request for invalid input")
+	}
+}
@@ -0,0 +1,42 @@
+// Command openmeteo-proxy serves the Open Meteo API locally, forwarding requests upstream with
+// caching, rate limiting and API-key injection, so that many services share one quota.
+//
+// Usage:
+//
+//	openmeteo-proxy [-listen :8080] [-ttl 5m] [-cache-size 1024] [-rate 0] [-api-key KEY]
+//
+// The API key can also be set with the OPENMETEO_API_KEY environment variable. Clients use
+// the proxy by setting their base URLs, e.g. openmeteo.WithBaseURL("http://localhost:8080/v1").
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gregbalnis/open-meteo-weather-sdk/proxy"
+)
+
+func main() {
+	listen := flag.String("listen", ":8080", "address to listen on")
+	ttl := flag.Duration("ttl", 5*time.Minute, "how long successful responses are cached (0 disables caching)")
+	cacheSize := flag.Int("cache-size", 1024, "maximum number of cached responses")
+	rate := flag.Float64("rate", 0, "maximum upstream requests per second (0 for no limit)")
+	apiKey := flag.String("api-key", os.Getenv("OPENMETEO_API_KEY"), "API key injected into upstream requests")
+	flag.Parse()
+
+	opts := []proxy.Option{proxy.WithCacheTTL(*ttl), proxy.WithCacheSize(*cacheSize), proxy.WithRateLimit(*rate)}
+	if *apiKey != "" {
+		opts = append(opts, proxy.WithAPIKey(*apiKey))
+	}
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           proxy.New(opts...),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("openmeteo-proxy listening on %s", *listen)
+	log.Fatal(server.ListenAndServe())
+}
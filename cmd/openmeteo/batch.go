@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	weather "github.com/gregbalnis/open-meteo-weather-sdk"
+)
+
+// place is one input row: a name and optional coordinates.
+type place struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	geocode   bool
+}
+
+// report is one output row of the batch report.
+type report struct {
+	Name                string               `json:"name"`
+	Latitude            float64              `json:"latitude"`
+	Longitude           float64              `json:"longitude"`
+	Time                string               `json:"time,omitempty"`
+	Temperature         *float64             `json:"temperature_2m,omitempty"`
+	ApparentTemperature *float64             `json:"apparent_temperature,omitempty"`
+	RelativeHumidity    *float64             `json:"relative_humidity_2m,omitempty"`
+	Precipitation       *float64             `json:"precipitation,omitempty"`
+	WeatherCode         *weather.WeatherCode `json:"weather_code,omitempty"`
+	WindSpeed           *float64             `json:"wind_speed_10m,omitempty"`
+	WindDirection       *float64             `json:"wind_direction_10m,omitempty"`
+	WindGusts           *float64             `json:"wind_gusts_10m,omitempty"`
+	Error               string               `json:"error,omitempty"`
+}
+
+// reportColumns is the header of the CSV report.
+var reportColumns = []string{
+	"name", "latitude", "longitude", "time", "temperature_2m", "apparent_temperature", "relative_humidity_2m",
+	"precipitation", "weather_code", "wind_speed_10m", "wind_direction_10m", "wind_gusts_10m", "error",
+}
+
+// batchConfig holds the flags of the batch command.
+type batchConfig struct {
+	in          string
+	out         string
+	format      string
+	concurrency int
+	rate        float64
+	baseURL     string
+	geocodeURL  string
+}
+
+// runBatch implements the batch command.
+func runBatch(args []string, stdout, stderr io.Writer) error {
+	var cfg batchConfig
+	fs := flag.NewFlagSet("batch", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&cfg.in, "in", "", "input CSV with a name column and optional latitude/longitude columns (- for stdin)")
+	fs.StringVar(&cfg.out, "out", "", "output file (default stdout)")
+	fs.StringVar(&cfg.format, "format", "csv", "report format: csv or json")
+	fs.IntVar(&cfg.concurrency, "concurrency", 4, "maximum number of simultaneous requests (1-10)")
+	fs.Float64Var(&cfg.rate, "rate", 5, "maximum requests per second (0 for no limit)")
+	fs.StringVar(&cfg.baseURL, "base-url", "", "custom forecast API base URL")
+	fs.StringVar(&cfg.geocodeURL, "geocoding-url", "", "custom geocoding API base URL")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if cfg.in == "" {
+		return fmt.Errorf("batch: -in is required")
+	}
+	if cfg.format != "csv" && cfg.format != "json" {
+		return fmt.Errorf("batch: unknown format %q (want csv or json)", cfg.format)
+	}
+	if cfg.concurrency < 1 || cfg.concurrency > 10 {
+		return fmt.Errorf("batch: -concurrency must be between 1 and 10")
+	}
+
+	in := io.Reader(os.Stdin)
+	if cfg.in != "-" {
+		f, err := os.Open(cfg.in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	places, err := readPlaces(in)
+	if err != nil {
+		return err
+	}
+
+	var opts []weather.Option
+	if cfg.baseURL != "" {
+		opts = append(opts, weather.WithBaseURL(cfg.baseURL))
+	}
+	if cfg.geocodeURL != "" {
+		opts = append(opts, weather.WithGeocodingBaseURL(cfg.geocodeURL))
+	}
+	reports := fetchAll(context.Background(), weather.NewClient(opts...), places, cfg.concurrency, cfg.rate)
+
+	out := stdout
+	if cfg.out != "" {
+		f, err := os.Create(cfg.out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	if cfg.format == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(reports)
+	}
+	return writeReportCSV(out, reports)
+}
+
+// readPlaces parses the input CSV. The header must contain a "name" column; rows without
+// "latitude" and "longitude" values are geocoded by name.
+func readPlaces(r io.Reader) ([]place, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading input: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("input is empty")
+	}
+
+	columns := make(map[string]int)
+	for i, h := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	nameCol, ok := columns["name"]
+	if !ok {
+		return nil, fmt.Errorf("input has no name column")
+	}
+	latCol, hasLat := columns["latitude"]
+	lonCol, hasLon := columns["longitude"]
+
+	field := func(row []string, i int) string {
+		if i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+	places := make([]place, 0, len(rows)-1)
+	for n, row := range rows[1:] {
+		p := place{Name: field(row, nameCol)}
+		lat, lon := "", ""
+		if hasLat && hasLon {
+			lat, lon = field(row, latCol), field(row, lonCol)
+		}
+		if lat == "" && lon == "" {
+			if p.Name == "" {
+				return nil, fmt.Errorf("line %d: no name or coordinates", n+2)
+			}
+			p.geocode = true
+			places = append(places, p)
+			continue
+		}
+		if p.Latitude, err = strconv.ParseFloat(lat, 64); err != nil {
+			return nil, fmt.Errorf("line %d: invalid latitude %q", n+2, lat)
+		}
+		if p.Longitude, err = strconv.ParseFloat(lon, 64); err != nil {
+			return nil, fmt.Errorf("line %d: invalid longitude %q", n+2, lon)
+		}
+		places = append(places, p)
+	}
+	return places, nil
+}
+
+// fetchAll fetches current weather for every place with at most concurrency calls in
+// flight and at most rate calls started per second. Reports are in input order; a failing
+// place is reported with its error instead of failing the batch.
+func fetchAll(ctx context.Context, client *weather.Client, places []place, concurrency int, rate float64) []report {
+	reports := make([]report, len(places))
+
+	var tick <-chan time.Time
+	if rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(places)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				reports[i] = fetchOne(ctx, client, places[i])
+			}
+		}()
+	}
+	for i := range places {
+		if tick != nil && i > 0 {
+			<-tick
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return reports
+}
+
+// fetchOne geocodes a place if needed and fetches its current weather.
+func fetchOne(ctx context.Context, client *weather.Client, p place) report {
+	r := report{Name: p.Name, Latitude: p.Latitude, Longitude: p.Longitude}
+	if p.geocode {
+		locations, err := client.SearchLocations(ctx, p.Name, weather.WithResultCount(1))
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		if len(locations) == 0 {
+			r.Error = "location not found"
+			return r
+		}
+		r.Latitude, r.Longitude = locations[0].Latitude, locations[0].Longitude
+	}
+
+	w, err := client.GetCurrentWeather(ctx, r.Latitude, r.Longitude)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	r.Time = w.Time.Format(time.RFC3339)
+	r.Temperature, r.ApparentTemperature, r.RelativeHumidity = &w.Temperature, &w.ApparentTemperature, &w.RelativeHumidity
+	r.Precipitation, r.WeatherCode = &w.Precipitation, &w.WeatherCode
+	r.WindSpeed, r.WindDirection, r.WindGusts = &w.WindSpeed, &w.WindDirection, &w.WindGusts
+	return r
+}
+
+// writeReportCSV writes the reports as CSV with a header row.
+func writeReportCSV(w io.Writer, reports []report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(reportColumns); err != nil {
+		return err
+	}
+	number := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	}
+	for _, r := range reports {
+		code := ""
+		if r.WeatherCode != nil {
+			code = strconv.Itoa(int(*r.WeatherCode))
+		}
+		row := []string{
+			r.Name, number(&r.Latitude), number(&r.Longitude), r.Time,
+			number(r.Temperature), number(r.ApparentTemperature), number(r.RelativeHumidity),
+			number(r.Precipitation), code, number(r.WindSpeed), number(r.WindDirection), number(r.WindGusts), r.Error,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newBatchServer serves current weather (failing for latitude 0) and geocoding for "Berlin"
+func newBatchServer(t *testing.T, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/search") {
+			if r.URL.Query().Get("name") != "Berlin" {
+				_, _ = fmt.Fprint(w, `{}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"results": [{"id": 2950159, "name": "Berlin", "latitude": 52.52, "longitude": 13.41}]}`)
+			return
+		}
+		if r.URL.Query().Get("latitude") == "0" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `{"error": true, "reason": "boom"}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"latitude": %s, "longitude": %s, "current": {"time": "2025-12-29T10:00",
+			"temperature_2m": 15.3, "weather_code": 3, "wind_speed_10m": 12.5}}`,
+			r.URL.Query().Get("latitude"), r.URL.Query().Get("longitude"))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestReadPlaces tests coordinates, geocoded names and invalid input
+func TestReadPlaces(t *testing.T) {
+	places, err := readPlaces(strings.NewReader("Name,Latitude,Longitude\nParis, 48.85, 2.35\nBerlin,,\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(places) != 2 || places[0].Latitude != 48.85 || places[0].geocode || !places[1].geocode {
+		t.Errorf("Unexpected places %+v", places)
+	}
+
+	for _, input := range []string{"", "city\nParis\n", "name,latitude,longitude\nX,abc,1\n", "name,latitude,longitude\n,,\n"} {
+		if _, err := readPlaces(strings.NewReader(input)); err == nil {
+			t.Errorf("Expected an error for %q", input)
+		}
+	}
+}
+
+// TestRunBatch_CSV tests a CSV report with a geocoded place and a per-row error
+func TestRunBatch_CSV(t *testing.T) {
+	var calls atomic.Int32
+	server := newBatchServer(t, &calls)
+	in := filepath.Join(t.TempDir(), "places.csv")
+	if err := os.WriteFile(in, []byte("name,latitude,longitude\nParis,48.85,2.35\nBerlin,,\nNowhere,0,0\nAtlantis,,\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err := runBatch([]string{"-in", in, "-rate", "0", "-base-url", server.URL, "-geocoding-url", server.URL}, &out, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 5 || !strings.HasPrefix(lines[0], "name,latitude,longitude,time,temperature_2m") {
+		t.Fatalf("Unexpected report:\n%s", out.String())
+	}
+	if !strings.HasPrefix(lines[1], "Paris,48.85,2.35,2025-12-29T10:00:00Z,15.3,") {
+		t.Errorf("Unexpected Paris row %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "Berlin,52.52,13.41,") {
+		t.Errorf("Expected Berlin to be geocoded, got %q", lines[2])
+	}
+	if !strings.Contains(lines[3], "boom") || !strings.HasSuffix(lines[4], "location not found") {
+		t.Errorf("Expected per-row errors, got %q and %q", lines[3], lines[4])
+	}
+}
+
+// TestRunBatch_JSON tests the JSON report written to a file
+func TestRunBatch_JSON(t *testing.T) {
+	var calls atomic.Int32
+	server := newBatchServer(t, &calls)
+	dir := t.TempDir()
+	in, out := filepath.Join(dir, "places.csv"), filepath.Join(dir, "report.json")
+	_ = os.WriteFile(in, []byte("name,latitude,longitude\nParis,48.85,2.35\n"), 0o600)
+
+	if err := runBatch([]string{"-in", in, "-out", out, "-format", "json", "-base-url", server.URL}, &bytes.Buffer{}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	data, _ := os.ReadFile(out)
+	var reports []report
+	if err := json.Unmarshal(data, &reports); err != nil {
+		t.Fatalf("Expected a JSON array, got %v", err)
+	}
+	if len(reports) != 1 || reports[0].Temperature == nil || *reports[0].Temperature != 15.3 || reports[0].Error != "" {
+		t.Errorf("Unexpected reports %s", data)
+	}
+}
+
+// TestRunBatch_RateLimit tests that calls are spaced by the rate limit
+func TestRunBatch_RateLimit(t *testing.T) {
+	var calls atomic.Int32
+	server := newBatchServer(t, &calls)
+	in := filepath.Join(t.TempDir(), "places.csv")
+	_ = os.WriteFile(in, []byte("name,latitude,longitude\na,1,1\nb,2,2\nc,3,3\n"), 0o600)
+
+	start := time.Now()
+	if err := runBatch([]string{"-in", in, "-rate", "20", "-base-url", server.URL}, &bytes.Buffer{}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected 3 calls at 20/s to take at least 100ms, took %s", elapsed)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls.Load())
+	}
+}
+
+// TestRunBatch_InvalidFlags tests flag validation
+func TestRunBatch_InvalidFlags(t *testing.T) {
+	for _, args := range [][]string{{}, {"-in", "x.csv", "-format", "xml"}, {"-in", "x.csv", "-concurrency", "11"}, {"-in", "missing.csv"}} {
+		if err := runBatch(args, &bytes.Buffer{}, &bytes.Buffer{}); err == nil {
+			t.Errorf("Expected an error for %v", args)
+		}
+	}
+}
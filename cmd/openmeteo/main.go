@@ -0,0 +1,42 @@
+// Command openmeteo is a small command-line client for the Open Meteo API.
+//
+// Usage:
+//
+//	openmeteo batch -in places.csv [-format csv|json] [-out report.csv] [-concurrency 4] [-rate 5]
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `Usage: openmeteo <command> [flags]
+
+Commands:
+  batch    fetch current weather for every place in a CSV file and write a combined report
+
+Run "openmeteo <command> -h" for the flags of a command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "batch":
+		err = runBatch(os.Args[2:], os.Stdout, os.Stderr)
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "openmeteo: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "openmeteo: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,132 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+)
+
+// Color is an opaque RGB color. It implements image/color.Color, so it can be used
+// directly when drawing heat maps.
+type Color struct {
+	R, G, B uint8
+}
+
+// MissingColor is the neutral gray returned for missing values (NaN).
+var MissingColor = Color{R: 0x9e, G: 0x9e, B: 0x9e}
+
+// Hex returns the color in #rrggbb notation.
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// RGBA implements image/color.Color.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	return uint32(c.R) * 0x101, uint32(c.G) * 0x101, uint32(c.B) * 0x101, 0xffff
+}
+
+// colorStop anchors a color at a value of a continuous scale.
+type colorStop struct {
+	value float64
+	color Color
+}
+
+// temperatureScale is a continuous temperature palette in °C, from deep purple for
+// extreme cold through blue, green and yellow to dark red for extreme heat.
+var temperatureScale = []colorStop{
+	{-40, Color{0x4b, 0x00, 0x82}},
+	{-20, Color{0x3f, 0x51, 0xb5}},
+	{-10, Color{0x21, 0x96, 0xf3}},
+	{0, Color{0x81, 0xd4, 0xfa}},
+	{10, Color{0x66, 0xbb, 0x6a}},
+	{20, Color{0xff, 0xeb, 0x3b}},
+	{30, Color{0xff, 0x98, 0x00}},
+	{40, Color{0xe5, 0x39, 0x35}},
+	{50, Color{0x7f, 0x00, 0x00}},
+}
+
+// colorBand assigns a color to values below an upper bound of a discrete scale.
+type colorBand struct {
+	upTo  float64
+	color Color
+}
+
+// europeanAQIBands are the bands of the European Air Quality Index (EEA):
+// good, fair, moderate, poor, very poor and extremely poor.
+var europeanAQIBands = []colorBand{
+	{20, Color{0x50, 0xf0, 0xe6}},
+	{40, Color{0x50, 0xcc, 0xaa}},
+	{60, Color{0xf0, 0xe6, 0x41}},
+	{80, Color{0xff, 0x50, 0x50}},
+	{100, Color{0x96, 0x00, 0x32}},
+	{math.Inf(1), Color{0x7d, 0x21, 0x81}},
+}
+
+// usAQIBands are the bands of the US Air Quality Index (EPA): good, moderate, unhealthy
+// for sensitive groups, unhealthy, very unhealthy and hazardous.
+var usAQIBands = []colorBand{
+	{50, Color{0x00, 0xe4, 0x00}},
+	{100, Color{0xff, 0xff, 0x00}},
+	{150, Color{0xff, 0x7e, 0x00}},
+	{200, Color{0xff, 0x00, 0x00}},
+	{300, Color{0x8f, 0x3f, 0x97}},
+	{math.Inf(1), Color{0x7e, 0x00, 0x23}},
+}
+
+// TemperatureColor maps a temperature in °C onto a continuous color scale from deep purple
+// (-40 °C and below) through blue (freezing), green (mild) and yellow (warm) to dark red
+// (50 °C and above). Missing values (NaN) map to MissingColor.
+//
+// Example:
+//
+//	fill := openmeteo.TemperatureColor(weather.Temperature).Hex() // e.g. "#a5d67d"
+func TemperatureColor(celsius float64) Color {
+	if math.IsNaN(celsius) {
+		return MissingColor
+	}
+	if celsius <= temperatureScale[0].value {
+		return temperatureScale[0].color
+	}
+	for i := 1; i < len(temperatureScale); i++ {
+		hi := temperatureScale[i]
+		if celsius <= hi.value {
+			lo := temperatureScale[i-1]
+			return blend(lo.color, hi.color, (celsius-lo.value)/(hi.value-lo.value))
+		}
+	}
+	return temperatureScale[len(temperatureScale)-1].color
+}
+
+// EuropeanAQIColor returns the official color of the European Air Quality Index band
+// containing aqi (the API's european_aqi variable). Missing values map to MissingColor.
+func EuropeanAQIColor(aqi float64) Color {
+	return bandColor(europeanAQIBands, aqi)
+}
+
+// USAQIColor returns the official color of the US Air Quality Index band containing aqi
+// (the API's us_aqi variable). Missing values map to MissingColor.
+func USAQIColor(aqi float64) Color {
+	return bandColor(usAQIBands, aqi)
+}
+
+// bandColor returns the color of the first band whose upper bound is at least value.
+func bandColor(bands []colorBand, value float64) Color {
+	if math.IsNaN(value) {
+		return MissingColor
+	}
+	for _, b := range bands {
+		if value <= b.upTo {
+			return b.color
+		}
+	}
+	return bands[len(bands)-1].color
+}
+
+// blend interpolates linearly between two colors (f = 0 returns a, f = 1 returns b).
+func blend(a, b Color, f float64) Color {
+	mix := func(x, y uint8) uint8 {
+		return uint8(math.Round(float64(x) + (float64(y)-float64(x))*f))
+	}
+	return Color{R: mix(a.R, b.R), G: mix(a.G, b.G), B: mix(a.B, b.B)}
+}
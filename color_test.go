@@ -0,0 +1,60 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+// TestTemperatureColor tests the continuous temperature scale
+func TestTemperatureColor(t *testing.T) {
+	tests := []struct {
+		celsius float64
+		want    string
+	}{
+		{-60, "#4b0082"},
+		{-40, "#4b0082"},
+		{0, "#81d4fa"},
+		{5, "#74c8b2"},
+		{20, "#ffeb3b"},
+		{60, "#7f0000"},
+		{math.NaN(), "#9e9e9e"},
+	}
+	for _, tt := range tests {
+		if got := TemperatureColor(tt.celsius).Hex(); got != tt.want {
+			t.Errorf("TemperatureColor(%v): expected %s, got %s", tt.celsius, tt.want, got)
+		}
+	}
+}
+
+// TestAQIColors tests the discrete European and US AQI bands
+func TestAQIColors(t *testing.T) {
+	european := map[float64]string{0: "#50f0e6", 20: "#50f0e6", 21: "#50ccaa", 55: "#f0e641", 150: "#7d2181"}
+	for aqi, want := range european {
+		if got := EuropeanAQIColor(aqi).Hex(); got != want {
+			t.Errorf("EuropeanAQIColor(%v): expected %s, got %s", aqi, want, got)
+		}
+	}
+
+	us := map[float64]string{42: "#00e400", 101: "#ff7e00", 250: "#8f3f97", 500: "#7e0023"}
+	for aqi, want := range us {
+		if got := USAQIColor(aqi).Hex(); got != want {
+			t.Errorf("USAQIColor(%v): expected %s, got %s", aqi, want, got)
+		}
+	}
+
+	if USAQIColor(math.NaN()) != MissingColor {
+		t.Error("Expected missing color for NaN")
+	}
+}
+
+// TestColor_RGBA tests the image/color.Color implementation
+func TestColor_RGBA(t *testing.T) {
+	var c color.Color = Color{R: 0xff, G: 0x80, B: 0x00}
+	r, g, b, a := c.RGBA()
+	if r != 0xffff || g != 0x8080 || b != 0 || a != 0xffff {
+		t.Errorf("Unexpected RGBA %x %x %x %x", r, g, b, a)
+	}
+}
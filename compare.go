@@ -0,0 +1,173 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// CompareVariables lists the daily variables used by CompareDays.
+var CompareVariables = []Variable{
+	DailyTemperature2mMax,
+	DailyTemperature2mMin,
+	DailyPrecipitationSum,
+	DailyWindSpeed10mMax,
+}
+
+// DayWeather summarizes one day of a daily series. Values are in the API's default units,
+// converted from other units (see WithUnits), and NaN when the variable was not requested or
+// is missing.
+type DayWeather struct {
+	// Date is the start of the day in the series' Location
+	Date time.Time
+
+	// TemperatureMax and TemperatureMin are the daily temperature extremes in °C
+	TemperatureMax float64
+	TemperatureMin float64
+
+	// Precipitation is the daily precipitation sum in mm
+	Precipitation float64
+
+	// WindSpeedMax is the maximum wind speed in km/h
+	WindSpeedMax float64
+}
+
+// Day returns the summary of step i of a daily series containing CompareVariables.
+func (s *Series) Day(i int) DayWeather {
+	day := DayWeather{
+		TemperatureMax: s.metricValueAt(DailyTemperature2mMax, i),
+		TemperatureMin: s.metricValueAt(DailyTemperature2mMin, i),
+		Precipitation:  s.metricValueAt(DailyPrecipitationSum, i),
+		WindSpeedMax:   s.metricValueAt(DailyWindSpeed10mMax, i),
+	}
+	if i >= 0 && i < len(s.Time) {
+		day.Date = timesIn(s.Time[i:i+1], s.Location)[0]
+	}
+	return day
+}
+
+// DayComparison holds the differences between two days, as returned by CompareDays.
+// Differences are NaN when a value is missing on either day.
+type DayComparison struct {
+	// Today and Yesterday are the compared days
+	Today     DayWeather
+	Yesterday DayWeather
+
+	// TemperatureMax and TemperatureMin are the temperature differences in °C
+	// (positive when today is warmer)
+	TemperatureMax float64
+	TemperatureMin float64
+
+	// Precipitation is the difference in precipitation in mm (positive when today is wetter)
+	Precipitation float64
+
+	// WindRatio is today's maximum wind speed divided by yesterday's
+	// (e.g., 0.5 for half the wind); NaN when yesterday was calm
+	WindRatio float64
+}
+
+// CompareDays computes the differences between today and yesterday (or any two days).
+// The String method formats them for display, e.g. "4°C warmer than yesterday, half the wind".
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude: 52.52, Longitude: 13.41, Daily: openmeteo.CompareVariables,
+//	}, openmeteo.WithTimezone("auto"), openmeteo.WithDateRange(yesterday, today))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(openmeteo.CompareDays(f.Daily.Day(1), f.Daily.Day(0)))
+func CompareDays(today, yesterday DayWeather) DayComparison {
+	c := DayComparison{
+		Today:          today,
+		Yesterday:      yesterday,
+		TemperatureMax: today.TemperatureMax - yesterday.TemperatureMax,
+		TemperatureMin: today.TemperatureMin - yesterday.TemperatureMin,
+		Precipitation:  today.Precipitation - yesterday.Precipitation,
+		WindRatio:      math.NaN(),
+	}
+	if yesterday.WindSpeedMax > 0 {
+		c.WindRatio = today.WindSpeedMax / yesterday.WindSpeedMax
+	}
+	return c
+}
+
+// String describes the comparison in words, e.g. "4°C warmer than yesterday, half the wind,
+// drier". Differences too small to notice are left out; "similar to yesterday" is returned
+// when nothing stands out.
+func (c DayComparison) String() string {
+	var parts []string
+
+	switch delta := math.Round(c.TemperatureMax); {
+	case delta >= 1:
+		parts = append(parts, fmt.Sprintf("%.0f°C warmer than yesterday", delta))
+	case delta <= -1:
+		parts = append(parts, fmt.Sprintf("%.0f°C colder than yesterday", -delta))
+	}
+
+	switch r := c.WindRatio; {
+	case r >= 0.4 && r <= 0.6:
+		parts = append(parts, "half the wind")
+	case r < 0.75:
+		parts = append(parts, "less wind")
+	case r >= 1.75 && r <= 2.25:
+		parts = append(parts, "twice the wind")
+	case r > 1.33:
+		parts = append(parts, "more wind")
+	}
+
+	wetToday := c.Today.Precipitation >= wetDayPrecipitation
+	wetYesterday := c.Yesterday.Precipitation >= wetDayPrecipitation
+	switch {
+	case math.IsNaN(c.Precipitation):
+	case wetToday && !wetYesterday:
+		parts = append(parts, "wetter")
+	case !wetToday && wetYesterday:
+		parts = append(parts, "drier")
+	}
+
+	if len(parts) == 0 {
+		return "similar to yesterday"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// CompareToYesterday fetches yesterday's and today's daily data in a single request (using
+// the API's past_days parameter) and compares them with CompareDays. Days are local to the
+// coordinates unless a timezone is set with WithTimezone; date and hour ranges cannot be used.
+// The data is always requested in metric units, whatever the client's units (see WithUnits).
+//
+// Example:
+//
+//	c, err := client.CompareToYesterday(ctx, 52.52, 13.41)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(c) // e.g. "4°C warmer than yesterday, half the wind"
+func (c *Client) CompareToYesterday(ctx context.Context, latitude, longitude float64, opts ...RequestOption) (*DayComparison, error) {
+	requestID := requestIDFor(ctx)
+	ctx = WithRequestID(ctx, requestID)
+	opts = append([]RequestOption{WithTimezone("auto")}, opts...)
+	opts = append(opts, WithPastDays(1), WithRequestUnits(UnitsMetric))
+
+	forecast, err := c.GetForecast(ctx, ForecastRequest{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Daily:     CompareVariables,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if forecast.Daily.Len() < 2 {
+		return nil, &Error{
+			Type:      ErrorTypeAPI,
+			Message:   fmt.Sprintf("expected at least 2 days of daily data, got %d", forecast.Daily.Len()),
+			RequestID: requestID,
+		}
+	}
+	comparison := CompareDays(forecast.Daily.Day(1), forecast.Daily.Day(0))
+	return &comparison, nil
+}
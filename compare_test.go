@@ -0,0 +1,161 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestCompareDays tests the differences between two days and their description
+func TestCompareDays(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name      string
+		today     DayWeather
+		yesterday DayWeather
+		want      string
+	}{
+		{
+			name:      "warmer and calmer",
+			today:     DayWeather{TemperatureMax: 22.4, TemperatureMin: 12, Precipitation: 0, WindSpeedMax: 10},
+			yesterday: DayWeather{TemperatureMax: 18.3, TemperatureMin: 11, Precipitation: 0, WindSpeedMax: 20},
+			want:      "4°C warmer than yesterday, half the wind",
+		},
+		{
+			name:      "colder, windier and wetter",
+			today:     DayWeather{TemperatureMax: 9, Precipitation: 6, WindSpeedMax: 40},
+			yesterday: DayWeather{TemperatureMax: 12, Precipitation: 0.2, WindSpeedMax: 20},
+			want:      "3°C colder than yesterday, twice the wind, wetter",
+		},
+		{
+			name:      "slightly less wind and drier",
+			today:     DayWeather{TemperatureMax: 15.2, Precipitation: 0, WindSpeedMax: 14},
+			yesterday: DayWeather{TemperatureMax: 15, Precipitation: 3, WindSpeedMax: 20},
+			want:      "less wind, drier",
+		},
+		{
+			name:      "more wind",
+			today:     DayWeather{TemperatureMax: 15, Precipitation: 0, WindSpeedMax: 30},
+			yesterday: DayWeather{TemperatureMax: 15, Precipitation: 0, WindSpeedMax: 20},
+			want:      "more wind",
+		},
+		{
+			name:      "similar",
+			today:     DayWeather{TemperatureMax: 15.3, Precipitation: 2, WindSpeedMax: 21},
+			yesterday: DayWeather{TemperatureMax: 15, Precipitation: 4, WindSpeedMax: 20},
+			want:      "similar to yesterday",
+		},
+		{
+			name:      "missing data",
+			today:     DayWeather{TemperatureMax: nan, Precipitation: nan, WindSpeedMax: 5},
+			yesterday: DayWeather{TemperatureMax: 15, Precipitation: 4, WindSpeedMax: 0},
+			want:      "similar to yesterday",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareDays(tt.today, tt.yesterday).String(); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	c := CompareDays(tests[0].today, tests[0].yesterday)
+	if math.Abs(c.TemperatureMax-4.1) > 1e-9 || c.TemperatureMin != 1 || c.WindRatio != 0.5 || c.Precipitation != 0 {
+		t.Errorf("Unexpected deltas %+v", c)
+	}
+	if c = CompareDays(tests[5].today, tests[5].yesterday); !math.IsNaN(c.WindRatio) || !math.IsNaN(c.TemperatureMax) {
+		t.Errorf("Expected NaN deltas for missing data, got %+v", c)
+	}
+}
+
+// TestCompareToYesterday tests the single-request comparison using past_days
+func TestCompareToYesterday(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("past_days") != "1" || q.Get("timezone") != "auto" || q.Get("daily") != joinVariables(CompareVariables) {
+			t.Errorf("Unexpected query %v", q)
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.5, "longitude": 13.4, "utc_offset_seconds": 7200, "timezone": "Europe/Berlin", "timezone_abbreviation": "CEST",
+			"daily": {"time": ["2025-06-09", "2025-06-10", "2025-06-11"],
+				"temperature_2m_max": [18, 22, 25], "temperature_2m_min": [10, 12, 14],
+				"precipitation_sum": [5, 0, 0], "wind_speed_10m_max": [30, 15, 10]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	c, err := client.CompareToYesterday(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := c.String(); got != "4°C warmer than yesterday, half the wind, drier" {
+		t.Errorf("Unexpected comparison %q", got)
+	}
+	if c.Today.Date.Day() != 10 || c.Today.Date.Hour() != 0 {
+		t.Errorf("Expected today to start at local midnight of June 10, got %v", c.Today.Date)
+	}
+}
+
+// TestCompareToYesterday_Imperial tests that the comparison requests metric data from a client
+// with imperial units and that Day converts series in other units
+func TestCompareToYesterday_Imperial(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Has("temperature_unit") || q.Has("wind_speed_unit") || q.Has("precipitation_unit") {
+			t.Errorf("Expected metric units, got %v", q)
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.5, "longitude": 13.4,
+			"daily": {"time": ["2025-06-09", "2025-06-10"],
+				"temperature_2m_max": [18, 22], "precipitation_sum": [0, 1.5], "wind_speed_10m_max": [15, 15]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithUnits(UnitsImperial))
+	c, err := client.CompareToYesterday(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := c.String(); got != "4°C warmer than yesterday, wetter" {
+		t.Errorf("Unexpected comparison %q", got)
+	}
+
+	daily := Series{
+		Time: []time.Time{time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)},
+		Values: map[Variable][]float64{
+			DailyTemperature2mMax: {71.6}, DailyPrecipitationSum: {0.1}, DailyWindSpeed10mMax: {10},
+		},
+		Units: map[Variable]string{
+			DailyTemperature2mMax: "°F", DailyPrecipitationSum: "inch", DailyWindSpeed10mMax: "mp/h",
+		},
+	}
+	day := daily.Day(0)
+	if math.Abs(day.TemperatureMax-22) > 1e-9 || math.Abs(day.Precipitation-2.54) > 1e-9 || math.Abs(day.WindSpeedMax-16.09344) > 1e-9 {
+		t.Errorf("Expected metric values, got %+v", day)
+	}
+}
+
+// TestCompareToYesterday_Errors tests invalid options and short responses
+func TestCompareToYesterday_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.5, "longitude": 13.4, "daily": {"time": ["2025-06-10"], "temperature_2m_max": [22]}}`)
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	var apiErr *Error
+	_, err := client.CompareToYesterday(WithRequestID(context.Background(), "cmp-1"), 52.52, 13.41)
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeAPI || apiErr.RequestID != "cmp-1" {
+		t.Errorf("Expected API error with request ID for a single day, got %v", err)
+	}
+
+	now := time.Now()
+	_, err = client.CompareToYesterday(context.Background(), 52.52, 13.41, WithDateRange(now, now))
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error with a date range, got %v", err)
+	}
+}
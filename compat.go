@@ -0,0 +1,105 @@
+package openmeteo
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// region is a latitude/longitude bounding box in degrees.
+type region struct {
+	name           string
+	minLat, maxLat float64
+	minLon, maxLon float64
+}
+
+// contains reports whether the coordinates lie within the region.
+func (r region) contains(latitude, longitude float64) bool {
+	return latitude >= r.minLat && latitude <= r.maxLat && longitude >= r.minLon && longitude <= r.maxLon
+}
+
+// minutely15Regions are the areas where 15-minutely data comes from native high-resolution
+// models (ICON-D2/AROME in Central Europe, HRRR in North America). Elsewhere the API only
+// interpolates hourly data.
+var minutely15Regions = []region{
+	{name: "Central Europe", minLat: 43, maxLat: 58, minLon: -4, maxLon: 20},
+	{name: "North America", minLat: 21, maxLat: 53, minLon: -134, maxLon: -60},
+}
+
+// checkCompatibility detects parameter combinations the API rejects or silently ignores,
+// mirroring the API's rules, and returns an actionable ErrorTypeValidation error.
+// params holds the endpoint-specific query parameters (variable blocks).
+func checkCompatibility(params url.Values, cfg *requestConfig, latitude, longitude float64, requestID string) error {
+	invalid := func(format string, args ...any) error {
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf(format, args...),
+			RequestID: requestID,
+		}
+	}
+
+	if params.Get("daily") != "" && cfg.timezone == "" {
+		return invalid("daily variables require a timezone to define day boundaries (use WithTimezone, e.g. \"auto\")")
+	}
+
+	if len(cfg.models) > 1 && (params.Get("current") != "" || params.Has("current_weather")) {
+		return invalid("current conditions can only be requested for a single model; use GetCurrentWeatherMultiModel to compare %d models", len(cfg.models))
+	}
+
+	if cfg.pastDays > 0 && (!cfg.startDate.IsZero() || !cfg.startHour.IsZero()) {
+		return invalid("past days cannot be combined with a date or hour range; extend the range instead")
+	}
+
+	if cfg.pastHours > 0 && (!cfg.startDate.IsZero() || !cfg.startHour.IsZero()) {
+		return invalid("past hours cannot be combined with a date or hour range; extend the range instead")
+	}
+
+	if cfg.forecastDays > 0 && (!cfg.startDate.IsZero() || !cfg.startHour.IsZero()) {
+		return invalid("forecast days cannot be combined with a date or hour range; extend the range instead")
+	}
+
+	if params.Get("minutely_15") != "" && !inAnyRegion(minutely15Regions, latitude, longitude) {
+		return invalid("15-minutely data is only available in Central Europe and North America (%.2f, %.2f is outside); request hourly data instead", latitude, longitude)
+	}
+
+	if cfg.tilt != nil && !requestsVariable(params, "global_tilted_irradiance") {
+		return invalid("panel orientation only affects global_tilted_irradiance; request HourlyGlobalTiltedIrradiance or remove WithPanelOrientation")
+	}
+
+	if params.Get("hourly") == "" && params.Get("minutely_15") == "" {
+		if cfg.temporalResolution != "" {
+			return invalid("temporal resolution only applies to hourly data; request hourly variables or remove WithTemporalResolution")
+		}
+		if !cfg.startHour.IsZero() {
+			return invalid("hour ranges only apply to hourly data; use WithDateRange or request hourly variables")
+		}
+		if cfg.pastHours > 0 {
+			return invalid("past hours only apply to hourly data; use WithPastDays or request hourly variables")
+		}
+	}
+
+	return nil
+}
+
+// inAnyRegion reports whether the coordinates lie within one of the regions.
+func inAnyRegion(regions []region, latitude, longitude float64) bool {
+	for _, r := range regions {
+		if r.contains(latitude, longitude) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestsVariable reports whether any variable block of params requests a variable
+// starting with prefix (e.g., "global_tilted_irradiance" also matches its _instant variant).
+func requestsVariable(params url.Values, prefix string) bool {
+	for _, block := range []string{"current", "minutely_15", "hourly", "daily"} {
+		for _, v := range strings.Split(params.Get(block), ",") {
+			if strings.HasPrefix(v, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
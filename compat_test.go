@@ -0,0 +1,71 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCheckCompatibility tests detection of invalid parameter combinations
+func TestCheckCompatibility(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   url.Values
+		opts     []RequestOption
+		lat, lon float64
+		wantErr  string
+	}{
+		{"plain hourly", url.Values{"hourly": {"temperature_2m"}}, nil, 52.52, 13.41, ""},
+		{"daily without timezone", url.Values{"daily": {"temperature_2m_max"}}, nil, 52.52, 13.41, "daily variables require a timezone"},
+		{"daily with timezone", url.Values{"daily": {"temperature_2m_max"}}, []RequestOption{WithTimezone("auto")}, 52.52, 13.41, ""},
+		{"minutely_15 in Europe", url.Values{"minutely_15": {"temperature_2m"}}, nil, 52.52, 13.41, ""},
+		{"minutely_15 in North America", url.Values{"minutely_15": {"temperature_2m"}}, nil, 40.71, -74.01, ""},
+		{"minutely_15 in Asia", url.Values{"minutely_15": {"temperature_2m"}}, nil, 35.68, 139.69, "only available in Central Europe and North America"},
+		{"tilt without GTI", url.Values{"hourly": {"temperature_2m"}}, []RequestOption{WithPanelOrientation(30, 0)}, 52.52, 13.41, "panel orientation only affects global_tilted_irradiance"},
+		{"tilt with GTI", url.Values{"hourly": {"global_tilted_irradiance_instant"}}, []RequestOption{WithPanelOrientation(30, 0)}, 52.52, 13.41, ""},
+		{"resolution on current", url.Values{"current": {"temperature_2m"}}, []RequestOption{WithTemporalResolution(TemporalResolutionHourly3)}, 52.52, 13.41, "temporal resolution only applies to hourly data"},
+		{"forecast days with date range", url.Values{"hourly": {"temperature_2m"}}, []RequestOption{WithForecastDays(3), WithDateRange(time.Now(), time.Now())}, 52.52, 13.41, "forecast days cannot be combined"},
+		{"past days with hour range", url.Values{"hourly": {"temperature_2m"}}, []RequestOption{WithPastDays(1), WithHourRange(time.Now(), time.Now())}, 52.52, 13.41, "past days cannot be combined"},
+		{"past hours with date range", url.Values{"hourly": {"temperature_2m"}}, []RequestOption{WithPastHours(6), WithDateRange(time.Now(), time.Now())}, 52.52, 13.41, "past hours cannot be combined"},
+		{"past hours on daily", url.Values{"daily": {"temperature_2m_max"}}, []RequestOption{WithTimezone("auto"), WithPastHours(6)}, 52.52, 13.41, "past hours only apply to hourly data"},
+		{"past hours on hourly", url.Values{"hourly": {"temperature_2m"}}, []RequestOption{WithPastHours(6)}, 52.52, 13.41, ""},
+		{"hour range on current", url.Values{"current": {"temperature_2m"}}, []RequestOption{WithHourRange(time.Now(), time.Now())}, 52.52, 13.41, "hour ranges only apply to hourly data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCompatibility(tt.params, newRequestConfig(tt.opts), tt.lat, tt.lon, "id")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected validation error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestGetHourlyForecast_Compatibility tests that incompatible options fail before any HTTP call
+func TestGetHourlyForecast_Compatibility(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request to be sent")
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	_, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m}, WithPanelOrientation(35, 0))
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package openmeteo
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxConditionalEntries bounds the number of responses kept for conditional requests
+const maxConditionalEntries = 256
+
+// validatedEntry is a raw response body with the validators the server sent for it.
+type validatedEntry struct {
+	etag         string
+	lastModified string
+	body         []byte
+	stored       time.Time
+}
+
+// conditionalCache keeps the last response with validators per request URL. It is safe for
+// concurrent use.
+type conditionalCache struct {
+	mu      sync.Mutex
+	entries map[string]validatedEntry
+}
+
+// WithConditionalRequests makes the client remember the ETag and Last-Modified headers of
+// responses and send If-None-Match / If-Modified-Since when the same request is made again.
+// A 304 Not Modified answer is served from the remembered response, which cuts bandwidth for
+// high-frequency pollers against servers that support validators (e.g., self-hosted instances
+// or caching proxies). Responses without validators are not kept.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithConditionalRequests())
+//	for range time.Tick(time.Minute) {
+//	    weather, err := client.GetCurrentWeather(ctx, 52.52, 13.41) // 304s reuse the last body
+//	    ...
+//	}
+func WithConditionalRequests() Option {
+	return func(c *Client) {
+		c.conditional = &conditionalCache{entries: make(map[string]validatedEntry)}
+	}
+}
+
+// prepare adds the validators known for the request URL to req and returns the remembered
+// body, if any.
+func (cc *conditionalCache) prepare(req *http.Request) ([]byte, bool) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	e, ok := cc.entries[req.URL.String()]
+	if !ok {
+		return nil, false
+	}
+	if e.etag != "" {
+		req.Header.Set("If-None-Match", e.etag)
+	}
+	if e.lastModified != "" {
+		req.Header.Set("If-Modified-Since", e.lastModified)
+	}
+	return e.body, true
+}
+
+// store remembers body with the validators of resp, evicting the oldest entry when full.
+func (cc *conditionalCache) store(req *http.Request, resp *http.Response, body []byte) {
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	key := req.URL.String()
+	if _, ok := cc.entries[key]; !ok && len(cc.entries) >= maxConditionalEntries {
+		var oldestKey string
+		var oldest time.Time
+		for k, e := range cc.entries {
+			if oldestKey == "" || e.stored.Before(oldest) {
+				oldestKey, oldest = k, e.stored
+			}
+		}
+		delete(cc.entries, oldestKey)
+	}
+	cc.entries[key] = validatedEntry{etag: etag, lastModified: lastModified, body: body, stored: time.Now()}
+}
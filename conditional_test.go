@@ -0,0 +1,93 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestWithConditionalRequests tests validators, 304 handling and changed responses
+func TestWithConditionalRequests(t *testing.T) {
+	var calls, notModified atomic.Int32
+	temperature := 15.3
+	etag := `"v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("If-None-Match") == etag {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", "Mon, 29 Dec 2025 10:00:00 GMT")
+		_, _ = fmt.Fprintf(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": %v}}`, temperature)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithConditionalRequests())
+	for range 3 {
+		w, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+		if err != nil || w.Temperature != 15.3 {
+			t.Fatalf("Expected 15.3°C, got %v, %v", w, err)
+		}
+	}
+	if calls.Load() != 3 || notModified.Load() != 2 {
+		t.Errorf("Expected 2 of 3 calls to be answered with 304, got %d of %d", notModified.Load(), calls.Load())
+	}
+
+	temperature, etag = 16.1, `"v2"`
+	if w, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41); err != nil || w.Temperature != 16.1 {
+		t.Errorf("Expected the changed response, got %v, %v", w, err)
+	}
+}
+
+// TestWithConditionalRequests_Unvalidated tests that 304 without a remembered body is an error
+// and responses without validators are not kept
+func TestWithConditionalRequests_Unvalidated(t *testing.T) {
+	var sawValidator atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+			sawValidator.Store(true)
+		}
+		if r.URL.Query().Get("latitude") == "1" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 1}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithConditionalRequests())
+	for range 2 {
+		if _, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if sawValidator.Load() {
+		t.Error("Expected no conditional headers without validators")
+	}
+	if _, err := client.GetCurrentWeather(context.Background(), 1, 1); err == nil {
+		t.Error("Expected an error for an unexpected 304")
+	}
+}
+
+// TestConditionalCache_Eviction tests that the oldest entry is evicted when full
+func TestConditionalCache_Eviction(t *testing.T) {
+	cc := &conditionalCache{entries: make(map[string]validatedEntry)}
+	resp := &http.Response{Header: http.Header{"Etag": {`"x"`}}}
+	for i := range maxConditionalEntries + 1 {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/?i=%d", i), nil)
+		cc.store(req, resp, []byte("{}"))
+		cc.entries[req.URL.String()] = validatedEntry{etag: `"x"`, stored: time.Unix(int64(i), 0)}
+	}
+	if len(cc.entries) != maxConditionalEntries {
+		t.Errorf("Expected %d entries, got %d", maxConditionalEntries, len(cc.entries))
+	}
+	if _, ok := cc.entries["http://example.com/?i=0"]; ok {
+		t.Error("Expected the oldest entry to be evicted")
+	}
+}
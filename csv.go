@@ -0,0 +1,148 @@
+package openmeteo
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Format is the response format requested from the API.
+type Format string
+
+const (
+	// FormatJSON is the default JSON format
+	FormatJSON Format = "json"
+
+	// FormatCSV is the API's native CSV format
+	FormatCSV Format = "csv"
+)
+
+// WithFormat selects the response format of forecast, historical and air quality calls.
+// With FormatCSV the API answers in its native CSV format, which is decoded into the same
+// results as JSON responses. Geocoding calls always use JSON.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyTemperature2m},
+//	    openmeteo.WithFormat(openmeteo.FormatCSV),
+//	)
+func WithFormat(format Format) RequestOption {
+	return func(r *requestConfig) {
+		switch format {
+		case FormatJSON, FormatCSV:
+			r.format = format
+		default:
+			r.invalid("invalid format %q (must be %q or %q)", format, FormatJSON, FormatCSV)
+		}
+	}
+}
+
+// csvBlocks are the data blocks of a CSV response, in the order the API writes them.
+var csvBlocks = []string{"current", "minutely_15", "hourly", "daily"}
+
+// csvToJSON converts a CSV response into the equivalent JSON document, so that it decodes into
+// the same response structures. A CSV response consists of sections separated by blank lines:
+// the location metadata, followed by one section per requested block (query) in the order of
+// csvBlocks. Column headers carry the unit in parentheses (e.g., "temperature_2m (°C)").
+func csvToJSON(body []byte, query url.Values) ([]byte, error) {
+	var sections [][][]string
+	for _, chunk := range strings.Split(strings.ReplaceAll(string(body), "\r\n", "\n"), "\n\n") {
+		if strings.TrimSpace(chunk) == "" {
+			continue
+		}
+		r := csv.NewReader(strings.NewReader(chunk))
+		r.FieldsPerRecord = -1
+		rows, err := r.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) < 2 {
+			return nil, fmt.Errorf("CSV section without data rows")
+		}
+		sections = append(sections, rows)
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("empty CSV response")
+	}
+
+	doc := make(map[string]any)
+	meta := sections[0]
+	if len(meta) != 2 {
+		return nil, fmt.Errorf("CSV responses for several locations are not supported")
+	}
+	for i, name := range meta[0] {
+		if i < len(meta[1]) {
+			doc[name] = csvValue(meta[1][i], name == "timezone" || name == "timezone_abbreviation")
+		}
+	}
+
+	var blocks []string
+	for _, block := range csvBlocks {
+		if query.Get(block) != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	if len(sections)-1 != len(blocks) {
+		return nil, fmt.Errorf("CSV response has %d data sections, expected %d", len(sections)-1, len(blocks))
+	}
+
+	for n, block := range blocks {
+		rows := sections[n+1]
+		units := make(map[string]string)
+		columns := make([]string, len(rows[0]))
+		for i, header := range rows[0] {
+			name, unit, ok := strings.Cut(header, " (")
+			columns[i] = name
+			if ok {
+				units[name] = strings.TrimSuffix(unit, ")")
+			}
+		}
+
+		if block == "current" {
+			values := make(map[string]any)
+			for i, name := range columns {
+				if i < len(rows[1]) {
+					values[name] = csvValue(rows[1][i], name == "time" || units[name] == "iso8601")
+				}
+			}
+			doc[block] = values
+		} else {
+			values := make(map[string][]any)
+			for _, row := range rows[1:] {
+				for i, name := range columns {
+					cell := ""
+					if i < len(row) {
+						cell = row[i]
+					}
+					values[name] = append(values[name], csvValue(cell, name == "time" || units[name] == "iso8601"))
+				}
+			}
+			doc[block] = values
+		}
+		doc[block+"_units"] = units
+	}
+
+	var out bytes.Buffer
+	if err := json.NewEncoder(&out).Encode(doc); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// csvValue converts a CSV cell to its JSON value: a number, null for an empty or NaN cell,
+// or a string for text columns such as time and sunrise (times are kept as numbers when
+// requested as unixtime).
+func csvValue(cell string, text bool) any {
+	if _, err := strconv.ParseFloat(cell, 64); err == nil && !strings.EqualFold(cell, "nan") {
+		return json.Number(cell)
+	}
+	if text && cell != "" {
+		return cell
+	}
+	return nil
+}
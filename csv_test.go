@@ -0,0 +1,104 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// csvServer serves body as a CSV response and checks that CSV was requested
+func csvServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") != "csv" {
+			t.Errorf("Expected format=csv, got %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestWithFormat_CSVHourly tests decoding an hourly CSV response
+func TestWithFormat_CSVHourly(t *testing.T) {
+	server := csvServer(t, "latitude,longitude,elevation,utc_offset_seconds,timezone,timezone_abbreviation\r\n"+
+		"52.52,13.419998,38.0,0,GMT,GMT\r\n\r\n"+
+		"time,temperature_2m (°C),precipitation (mm)\r\n"+
+		"2025-01-01T00:00,1.2,0.00\r\n"+
+		"2025-01-01T01:00,,0.10\r\n")
+	client := NewClient(WithBaseURL(server.URL))
+
+	f, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m, HourlyPrecipitation}, WithFormat(FormatCSV))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if f.Latitude != 52.52 || f.Hourly.Len() != 2 || !f.Hourly.Time[1].Equal(time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected forecast %+v", f)
+	}
+	temperature := f.Hourly.Get(HourlyTemperature2m)
+	if temperature[0] != 1.2 || !math.IsNaN(temperature[1]) || f.Hourly.Get(HourlyPrecipitation)[1] != 0.1 {
+		t.Errorf("Unexpected values %v", f.Hourly.Values)
+	}
+	if f.Hourly.Unit(HourlyTemperature2m) != "°C" {
+		t.Errorf("Expected unit °C, got %q", f.Hourly.Unit(HourlyTemperature2m))
+	}
+}
+
+// TestWithFormat_CSVCombined tests a CSV response with current and daily sections
+func TestWithFormat_CSVCombined(t *testing.T) {
+	server := csvServer(t, "latitude,longitude,elevation,utc_offset_seconds,timezone,timezone_abbreviation\n"+
+		"52.52,13.42,38.0,3600,Europe/Berlin,GMT+1\n\n"+
+		"time,temperature_2m (°C),weather_code (wmo code),is_day ()\n"+
+		"2025-01-01T10:00,3.5,61,1\n\n"+
+		"time,temperature_2m_max (°C),sunrise (iso8601)\n"+
+		"2025-01-01,4.1,2025-01-01T08:17\n"+
+		"2025-01-02,5.0,2025-01-02T08:17\n")
+	client := NewClient(WithBaseURL(server.URL))
+
+	f, err := client.GetForecast(context.Background(), ForecastRequest{
+		Latitude: 52.52, Longitude: 13.41, Current: true, Daily: []Variable{DailyTemperature2mMax, DailySunrise},
+	}, WithTimezone("Europe/Berlin"), WithFormat(FormatCSV))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if f.Current == nil || f.Current.Temperature != 3.5 || f.Current.WeatherCode != 61 || !f.Current.IsDay {
+		t.Errorf("Unexpected current weather %+v", f.Current)
+	}
+	if f.Daily.Len() != 2 || f.Daily.Get(DailyTemperature2mMax)[1] != 5 {
+		t.Errorf("Unexpected daily series %+v", f.Daily)
+	}
+	if sunrise, ok := f.Daily.TimeAt(DailySunrise, 1); !ok || sunrise.Format("2006-01-02T15:04") != "2025-01-02T08:17" {
+		t.Errorf("Unexpected sunrise %v (ok=%v)", sunrise, ok)
+	}
+}
+
+// TestWithFormat_Invalid tests invalid formats and malformed CSV responses
+func TestWithFormat_Invalid(t *testing.T) {
+	client := NewClient(WithBaseURL("http://127.0.0.1:1"))
+	_, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41, WithFormat("xml"))
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+
+	server := csvServer(t, "latitude,longitude\n52.52,13.42\n")
+	client = NewClient(WithBaseURL(server.URL))
+	_, err = client.GetHourlyForecast(context.Background(), 52.52, 13.41, []Variable{HourlyTemperature2m}, WithFormat(FormatCSV))
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeAPI {
+		t.Errorf("Expected API error for a missing section, got %v", err)
+	}
+
+	for _, body := range []string{"", "location_id,latitude\n0,1\n1,2\n", "a,b\n\"unterminated\n"} {
+		if _, err := csvToJSON([]byte(body), url.Values{}); err == nil {
+			t.Errorf("Expected an error for %q", body)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+// Duration returns the value of a duration variable (e.g., DailySunshineDuration) at step i
+// as a time.Duration. Values are interpreted in the unit reported by the API: hours for "h",
+// seconds otherwise. ok is false if the value is missing.
+//
+// Example:
+//
+//	for i, day := range f.Daily.TimesInLocal() {
+//	    if d, ok := f.Daily.Duration(openmeteo.DailySunshineDuration, i); ok {
+//	        fmt.Printf("%s: %s of sunshine\n", day.Format("Mon"), d.Round(time.Minute))
+//	    }
+//	}
+func (s *Series) Duration(v Variable, i int) (time.Duration, bool) {
+	value := s.valueAt(v, i)
+	if math.IsNaN(value) {
+		return 0, false
+	}
+	unit := time.Second
+	if s.Units[v] == "h" {
+		unit = time.Hour
+	}
+	return time.Duration(value * float64(unit)), true
+}
+
+// SunshinePercent returns the percentage of possible sunshine for each day of a daily series
+// containing DailySunshineDuration and DailyDaylightDuration: the sunshine duration divided
+// by the daylight duration, from 0 to 100. Days with missing data or without daylight
+// (polar night) are NaN.
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude:  52.52,
+//	    Longitude: 13.41,
+//	    Daily:     []openmeteo.Variable{openmeteo.DailySunshineDuration, openmeteo.DailyDaylightDuration},
+//	}, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(openmeteo.SunshinePercent(&f.Daily))
+func SunshinePercent(daily *Series) []float64 {
+	percent := make([]float64, daily.Len())
+	for i := range percent {
+		sunshine, okSunshine := daily.Duration(DailySunshineDuration, i)
+		daylight, okDaylight := daily.Duration(DailyDaylightDuration, i)
+		if !okSunshine || !okDaylight || daylight <= 0 {
+			percent[i] = math.NaN()
+			continue
+		}
+		percent[i] = math.Min(100, 100*sunshine.Seconds()/daylight.Seconds())
+	}
+	return percent
+}
+
+// SunVariables are the daily variables used by SunTimes.
+var SunVariables = []Variable{DailySunrise, DailySunset, DailyDaylightDuration, DailySunshineDuration}
+
+// SunDay holds the sun times of one day returned by SunTimes. Missing values are zero.
+type SunDay struct {
+	// Date is the start of the day in the series' Location
+	Date time.Time
+
+	// Sunrise is the time of sunrise in the series' Location
+	Sunrise time.Time
+
+	// Sunset is the time of sunset in the series' Location
+	Sunset time.Time
+
+	// Daylight is the time between sunrise and sunset
+	Daylight time.Duration
+
+	// Sunshine is the time with direct sunshine
+	Sunshine time.Duration
+}
+
+// SunTimes returns sunrise, sunset, daylight and sunshine duration for each day of a daily
+// series containing SunVariables. Times are in the series' Location, so request the
+// location's own timezone to get local clock times.
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude:  52.52,
+//	    Longitude: 13.41,
+//	    Daily:     openmeteo.SunVariables,
+//	}, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	for _, day := range openmeteo.SunTimes(&f.Daily) {
+//	    fmt.Printf("%s: %s-%s\n", day.Date.Format("Mon"), day.Sunrise.Format("15:04"), day.Sunset.Format("15:04"))
+//	}
+func SunTimes(daily *Series) []SunDay {
+	days := make([]SunDay, daily.Len())
+	for i, t := range daily.TimesInLocal() {
+		days[i].Date = t
+		days[i].Sunrise, _ = daily.TimeAt(DailySunrise, i)
+		days[i].Sunset, _ = daily.TimeAt(DailySunset, i)
+		days[i].Daylight, _ = daily.Duration(DailyDaylightDuration, i)
+		days[i].Sunshine, _ = daily.Duration(DailySunshineDuration, i)
+	}
+	return days
+}
+
+// Wet day thresholds used by ClassifyDays
+const (
+	// wetDayPrecipitation is the daily precipitation (mm) from which a day is wet (WMO definition)
+	wetDayPrecipitation = 1.0
+
+	// wetDayHours is the precipitation duration from which a day is wet regardless of the amount
+	wetDayHours = 3 * time.Hour
+)
+
+// DayCondition classifies a day as dry or wet for trip planning.
+type DayCondition int
+
+const (
+	// DayUnknown indicates that precipitation data is missing for the day
+	DayUnknown DayCondition = iota
+
+	// DayDry indicates less than 1 mm of precipitation and under 3 hours of precipitation
+	DayDry
+
+	// DayWet indicates at least 1 mm of precipitation or 3 hours of precipitation
+	DayWet
+)
+
+// String returns the name of the condition ("unknown", "dry" or "wet").
+func (c DayCondition) String() string {
+	switch c {
+	case DayDry:
+		return "dry"
+	case DayWet:
+		return "wet"
+	default:
+		return "unknown"
+	}
+}
+
+// DayClass is the classification of one day returned by ClassifyDays.
+type DayClass struct {
+	// Date is the start of the day in the series' Location
+	Date time.Time
+
+	// Condition is the dry/wet classification
+	Condition DayCondition
+
+	// Precipitation is the daily precipitation sum in mm (NaN if missing)
+	Precipitation float64
+
+	// PrecipitationHours is the time with precipitation (0 if missing)
+	PrecipitationHours time.Duration
+}
+
+// ClassifyDays classifies each day of a daily series between from and to (inclusive,
+// compared by calendar day) as dry or wet, from DailyPrecipitationSum (in mm, converted from
+// inches, see WithUnits) and DailyPrecipitationHours. A day is wet with at least 1 mm of
+// precipitation or at least 3 hours of precipitation; either variable alone is enough to
+// classify a day.
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude:  43.30,
+//	    Longitude: 5.37,
+//	    Daily:     []openmeteo.Variable{openmeteo.DailyPrecipitationSum, openmeteo.DailyPrecipitationHours},
+//	}, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	for _, day := range openmeteo.ClassifyDays(&f.Daily, tripStart, tripEnd) {
+//	    fmt.Println(day.Date.Format("Mon 2 Jan"), day.Condition)
+//	}
+func ClassifyDays(daily *Series, from, to time.Time) []DayClass {
+	daily = daily.metric()
+	first, last := calendarDate(from), calendarDate(to)
+	var days []DayClass
+	for i, t := range daily.TimesInLocal() {
+		if date := calendarDate(t); date.Before(first) || date.After(last) {
+			continue
+		}
+		day := DayClass{Date: t, Precipitation: daily.valueAt(DailyPrecipitationSum, i)}
+		hours, okHours := daily.Duration(DailyPrecipitationHours, i)
+		day.PrecipitationHours = hours
+
+		switch {
+		case day.Precipitation >= wetDayPrecipitation || (okHours && hours >= wetDayHours):
+			day.Condition = DayWet
+		case !math.IsNaN(day.Precipitation) || okHours:
+			day.Condition = DayDry
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+// FeelsLikeMax returns the daily "feels like" maximum of a daily series: DailyApparentTemperatureMax,
+// falling back to DailyTemperature2mMax on days where the apparent temperature is missing.
+// Heat warnings should be based on these values, since humidity and wind can make a day
+// feel considerably hotter than the air temperature suggests.
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude:  41.39,
+//	    Longitude: 2.17,
+//	    Daily:     []openmeteo.Variable{openmeteo.DailyApparentTemperatureMax, openmeteo.DailyTemperature2mMax},
+//	}, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	for i, temp := range openmeteo.FeelsLikeMax(&f.Daily) {
+//	    if temp >= 35 {
+//	        fmt.Printf("heat warning on %s\n", f.Daily.TimesInLocal()[i].Format("Mon"))
+//	    }
+//	}
+func FeelsLikeMax(daily *Series) []float64 {
+	return withFallback(daily, DailyApparentTemperatureMax, DailyTemperature2mMax)
+}
+
+// FeelsLikeMin returns the daily "feels like" minimum of a daily series: DailyApparentTemperatureMin,
+// falling back to DailyTemperature2mMin on days where the apparent temperature is missing.
+func FeelsLikeMin(daily *Series) []float64 {
+	return withFallback(daily, DailyApparentTemperatureMin, DailyTemperature2mMin)
+}
+
+// withFallback returns the values of v, replacing missing steps with the values of fallback.
+// Steps where both are missing are NaN.
+func withFallback(s *Series, v, fallback Variable) []float64 {
+	values := make([]float64, s.Len())
+	for i := range values {
+		values[i] = s.valueAt(v, i)
+		if math.IsNaN(values[i]) {
+			values[i] = s.valueAt(fallback, i)
+		}
+	}
+	return values
+}
@@ -0,0 +1,184 @@
+package openmeteo
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+// dailySeries builds a daily series starting at start with the given values and units
+func dailySeries(start time.Time, values map[Variable][]float64, units map[Variable]string) Series {
+	s := Series{Values: values, Units: units, Location: time.UTC}
+	for _, v := range values {
+		for i := range v {
+			s.Time = append(s.Time, start.AddDate(0, 0, i))
+		}
+		break
+	}
+	return s
+}
+
+// TestSeries_Duration tests conversion of duration variables using the reported unit
+func TestSeries_Duration(t *testing.T) {
+	s := dailySeries(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailySunshineDuration:   {36000, math.NaN()},
+		DailyPrecipitationHours: {2.5, 0},
+	}, map[Variable]string{DailySunshineDuration: "s", DailyPrecipitationHours: "h"})
+
+	if d, ok := s.Duration(DailySunshineDuration, 0); !ok || d != 10*time.Hour {
+		t.Errorf("Expected 10h of sunshine, got %v (ok=%v)", d, ok)
+	}
+	if _, ok := s.Duration(DailySunshineDuration, 1); ok {
+		t.Error("Expected missing value to report ok=false")
+	}
+	if d, ok := s.Duration(DailyPrecipitationHours, 0); !ok || d != 150*time.Minute {
+		t.Errorf("Expected 2h30m, got %v (ok=%v)", d, ok)
+	}
+	if _, ok := s.Duration(DailyDaylightDuration, 0); ok {
+		t.Error("Expected absent variable to report ok=false")
+	}
+}
+
+// TestSunshinePercent tests the percentage of possible sunshine per day
+func TestSunshinePercent(t *testing.T) {
+	s := dailySeries(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailySunshineDuration: {28800, 0, math.NaN(), 0, 57700},
+		DailyDaylightDuration: {57600, 57600, 57600, 0, 57600},
+	}, map[Variable]string{DailySunshineDuration: "s", DailyDaylightDuration: "s"})
+
+	got := SunshinePercent(&s)
+	if len(got) != 5 {
+		t.Fatalf("Expected 5 days, got %d", len(got))
+	}
+	if got[0] != 50 || got[1] != 0 {
+		t.Errorf("Expected 50%% and 0%%, got %v and %v", got[0], got[1])
+	}
+	if !math.IsNaN(got[2]) || !math.IsNaN(got[3]) {
+		t.Errorf("Expected NaN for missing data and polar night, got %v and %v", got[2], got[3])
+	}
+	if got[4] != 100 {
+		t.Errorf("Expected percentage to be capped at 100, got %v", got[4])
+	}
+}
+
+// TestClassifyDays tests the dry/wet classification and date range filtering
+func TestClassifyDays(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, berlin)
+	nan := math.NaN()
+	s := dailySeries(start.UTC(), map[Variable][]float64{
+		DailyPrecipitationSum:   {0, 0.4, 5, 0.8, nan, nan, 2},
+		DailyPrecipitationHours: {0, 1, 4, 3, 0, nan, 1},
+	}, map[Variable]string{DailyPrecipitationSum: "mm", DailyPrecipitationHours: "h"})
+	s.Location = berlin
+
+	days := ClassifyDays(&s, start, start.AddDate(0, 0, 5))
+	want := []DayCondition{DayDry, DayDry, DayWet, DayWet, DayDry, DayUnknown}
+	if len(days) != len(want) {
+		t.Fatalf("Expected %d days, got %d", len(want), len(days))
+	}
+	for i, day := range days {
+		if day.Condition != want[i] {
+			t.Errorf("Day %d: expected %s, got %s", i, want[i], day.Condition)
+		}
+	}
+	if !days[0].Date.Equal(start) || days[0].Date.Location() != berlin {
+		t.Errorf("Expected local midnight of the first day, got %v", days[0].Date)
+	}
+	if days[2].Precipitation != 5 || days[2].PrecipitationHours != 4*time.Hour {
+		t.Errorf("Unexpected values for day 2: %+v", days[2])
+	}
+
+	// A range given in another location is compared by calendar day
+	days = ClassifyDays(&s, time.Date(2025, 7, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 3, 0, 0, 0, 0, time.UTC))
+	if len(days) != 1 || days[0].Condition != DayWet {
+		t.Errorf("Expected a single wet day, got %+v", days)
+	}
+
+	if DayUnknown.String() != "unknown" || DayDry.String() != "dry" || DayWet.String() != "wet" {
+		t.Error("Unexpected DayCondition names")
+	}
+}
+
+// TestClassifyDays_Imperial tests that precipitation sums in inches are classified in mm
+func TestClassifyDays_Imperial(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	s := dailySeries(start, map[Variable][]float64{DailyPrecipitationSum: {0.02, 0.1}},
+		map[Variable]string{DailyPrecipitationSum: "inch"})
+
+	days := ClassifyDays(&s, start, start.AddDate(0, 0, 1))
+	if len(days) != 2 || days[0].Condition != DayDry || days[1].Condition != DayWet {
+		t.Fatalf("Expected a dry and a wet day, got %+v", days)
+	}
+	if math.Abs(days[1].Precipitation-2.54) > 1e-9 {
+		t.Errorf("Expected 2.54 mm, got %v", days[1].Precipitation)
+	}
+}
+
+// TestFeelsLike tests apparent temperature extremes with fallback to air temperature
+func TestFeelsLike(t *testing.T) {
+	nan := math.NaN()
+	s := dailySeries(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailyApparentTemperatureMax: {38.5, nan, nan},
+		DailyApparentTemperatureMin: {22, 19, nan},
+		DailyTemperature2mMax:       {34, 31, nan},
+		DailyTemperature2mMin:       {21, 18, 17},
+	}, map[Variable]string{})
+
+	maxTemps := FeelsLikeMax(&s)
+	if maxTemps[0] != 38.5 || maxTemps[1] != 31 || !math.IsNaN(maxTemps[2]) {
+		t.Errorf("Unexpected feels like maxima %v", maxTemps)
+	}
+	minTemps := FeelsLikeMin(&s)
+	if minTemps[0] != 22 || minTemps[1] != 19 || minTemps[2] != 17 {
+		t.Errorf("Unexpected feels like minima %v", minTemps)
+	}
+}
+
+// TestSunTimes tests parsing of sunrise and sunset strings in the requested time zone
+func TestSunTimes(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+	var block map[string]json.RawMessage
+	err = json.Unmarshal([]byte(`{
+		"time": ["2025-06-21", "2025-12-21"],
+		"sunrise": ["2025-06-21T04:43", null],
+		"sunset": ["2025-06-21T21:33", "2025-12-21T15:54"],
+		"daylight_duration": [60613.2, 27700.5],
+		"sunshine_duration": [50400.0, null]
+	}`), &block)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal block: %v", err)
+	}
+	units := map[string]string{"time": "iso8601", "sunrise": "iso8601", "sunset": "iso8601", "daylight_duration": "s", "sunshine_duration": "s"}
+	s, err := parseSeries(block, units, berlin)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	days := SunTimes(&s)
+	if len(days) != 2 {
+		t.Fatalf("Expected 2 days, got %d", len(days))
+	}
+	if want := time.Date(2025, 6, 21, 4, 43, 0, 0, berlin); !days[0].Sunrise.Equal(want) || days[0].Sunrise.Location() != berlin {
+		t.Errorf("Expected sunrise %v, got %v", want, days[0].Sunrise)
+	}
+	if want := time.Date(2025, 6, 21, 19, 33, 0, 0, time.UTC); !days[0].Sunset.Equal(want) {
+		t.Errorf("Expected sunset %v, got %v", want, days[0].Sunset.UTC())
+	}
+	if days[0].Daylight != 60613200*time.Millisecond || days[0].Sunshine != 14*time.Hour {
+		t.Errorf("Unexpected durations %v and %v", days[0].Daylight, days[0].Sunshine)
+	}
+	if !days[1].Sunrise.IsZero() || days[1].Sunshine != 0 {
+		t.Errorf("Expected missing values to be zero, got %+v", days[1])
+	}
+	if days[1].Date.Format("2006-01-02 15:04") != "2025-12-21 00:00" {
+		t.Errorf("Unexpected date %v", days[1].Date)
+	}
+	if _, ok := s.TimeAt(DailyDaylightDuration, 0); ok {
+		t.Error("Expected TimeAt to reject a non-time variable")
+	}
+}
@@ -0,0 +1,56 @@
+package openmeteo
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// apiDateLayout is the ISO 8601 date layout used by the start_date/end_date parameters
+	apiDateLayout = "2006-01-02"
+
+	// apiHourLayout is the ISO 8601 layout used by the start_hour/end_hour parameters
+	apiHourLayout = "2006-01-02T15:04"
+)
+
+// earliestDate is the first day for which the Open Meteo APIs provide data (ERA5 reanalysis).
+var earliestDate = time.Date(1940, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// formatDate formats the calendar date of t (in t's own location) for the API.
+func formatDate(t time.Time) string {
+	return t.Format(apiDateLayout)
+}
+
+// formatHour formats t, truncated to the hour, for the API. The timestamp is converted
+// to loc first (UTC when loc is nil), since the API interprets it in the requested timezone.
+// The wall clock hour is kept, which Truncate would shift in half-hour zones.
+func formatHour(t time.Time, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc).Format(apiHourLayout)
+}
+
+// calendarDate returns midnight UTC of t's calendar date (in t's own location),
+// so that dates given in different locations can be compared by calendar day.
+func calendarDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// validateDateRange checks that start and end are set, ordered, and not before the
+// earliest date served by the API. Dates are compared by calendar day.
+func validateDateRange(start, end time.Time) error {
+	if start.IsZero() || end.IsZero() {
+		return fmt.Errorf("start and end dates must be set")
+	}
+	s, e := calendarDate(start), calendarDate(end)
+	if e.Before(s) {
+		return fmt.Errorf("invalid date range: end date %s is before start date %s", formatDate(end), formatDate(start))
+	}
+	if s.Before(earliestDate) {
+		return fmt.Errorf("invalid start date %s (data is available from %s)", formatDate(start), formatDate(earliestDate))
+	}
+	return nil
+}
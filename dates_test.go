@@ -0,0 +1,118 @@
+package openmeteo
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestValidateDateRange tests date range validation
+func TestValidateDateRange(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name    string
+		start   time.Time
+		end     time.Time
+		wantErr bool
+	}{
+		{"Valid range", day(2025, 6, 1), day(2025, 6, 7), false},
+		{"Single day", day(2025, 6, 1), day(2025, 6, 1), false},
+		{"Same day different times", day(2025, 6, 1).Add(20 * time.Hour), day(2025, 6, 1).Add(time.Hour), false},
+		{"Earliest date", day(1940, 1, 1), day(1940, 1, 2), false},
+		{"Zero start", time.Time{}, day(2025, 6, 1), true},
+		{"Zero end", day(2025, 6, 1), time.Time{}, true},
+		{"End before start", day(2025, 6, 7), day(2025, 6, 1), true},
+		{"Before 1940", day(1939, 12, 31), day(1940, 1, 5), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDateRange(tc.start, tc.end)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Expected error=%v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestFormatDate tests that calendar dates are taken in the time's own location
+func TestFormatDate(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	ts := time.Date(2025, 6, 2, 1, 0, 0, 0, tokyo) // 2025-06-01 16:00 UTC
+
+	if got := formatDate(ts); got != "2025-06-02" {
+		t.Errorf("Expected 2025-06-02, got %s", got)
+	}
+	if got := formatHour(ts, nil); got != "2025-06-01T16:00" {
+		t.Errorf("Expected 2025-06-01T16:00, got %s", got)
+	}
+	if got := formatHour(ts.Add(59*time.Minute), tokyo); got != "2025-06-02T01:00" {
+		t.Errorf("Expected 2025-06-02T01:00, got %s", got)
+	}
+	india := time.FixedZone("IST", 5*3600+1800)
+	if got := formatHour(ts.Add(15*time.Minute), india); got != "2025-06-01T21:00" {
+		t.Errorf("Expected 2025-06-01T21:00 in a half-hour zone, got %s", got)
+	}
+}
+
+// TestWithDateRange tests the start_date and end_date query parameters
+func TestWithDateRange(t *testing.T) {
+	start := time.Date(2025, 6, 1, 15, 30, 0, 0, time.UTC)
+	cfg := newRequestConfig([]RequestOption{WithDateRange(start, start.AddDate(0, 0, 6))})
+	if err := cfg.check("id"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	q := url.Values{}
+	cfg.applyQuery(q)
+	if q.Get("start_date") != "2025-06-01" || q.Get("end_date") != "2025-06-07" {
+		t.Errorf("Unexpected date parameters %s", q.Encode())
+	}
+
+	cfg = newRequestConfig([]RequestOption{WithDateRange(start, start.AddDate(0, 0, -1))})
+	var apiErr *Error
+	if !errors.As(cfg.check("id"), &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error for reversed range, got %v", cfg.check("id"))
+	}
+}
+
+// TestWithHourRange tests the start_hour and end_hour query parameters
+func TestWithHourRange(t *testing.T) {
+	start := time.Date(2025, 6, 1, 6, 45, 0, 0, time.UTC)
+	end := start.Add(6 * time.Hour)
+
+	cfg := newRequestConfig([]RequestOption{WithHourRange(start, end)})
+	q := url.Values{}
+	cfg.applyQuery(q)
+	if q.Get("start_hour") != "2025-06-01T06:00" || q.Get("end_hour") != "2025-06-01T12:00" {
+		t.Errorf("Unexpected hour parameters %s", q.Encode())
+	}
+
+	cfg = newRequestConfig([]RequestOption{WithTimezone("Etc/GMT-2"), WithHourRange(start, end)})
+	q = url.Values{}
+	cfg.applyQuery(q)
+	if q.Get("start_hour") != "2025-06-01T08:00" {
+		t.Errorf("Expected start hour in requested timezone, got %s", q.Get("start_hour"))
+	}
+
+	invalid := []struct {
+		name       string
+		start, end time.Time
+	}{
+		{"Zero", time.Time{}, end},
+		{"Reversed", end, start},
+		{"Before 1940", time.Date(1939, 1, 1, 0, 0, 0, 0, time.UTC), end},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newRequestConfig([]RequestOption{WithHourRange(tc.start, tc.end)})
+			if cfg.check("id") == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
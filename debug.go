@@ -0,0 +1,182 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithDebug writes a dump of every HTTP request and response to w for troubleshooting.
+// Dumps include the request URL, headers and up to 2 KB of the response body (see
+// WithDebugBodyLimit); credentials (Authorization and Cookie headers, apikey query
+// parameters) are redacted. Output from concurrent requests is not interleaved. Passing nil
+// disables debug output.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithDebug(os.Stderr))
+func WithDebug(w io.Writer) Option {
+	return func(c *Client) {
+		if w == nil {
+			c.debug = nil
+			return
+		}
+		limit := maxDebugBodySize
+		if c.debugBodyLimit != nil {
+			limit = *c.debugBodyLimit
+		}
+		c.debug = &debugDumper{w: w, limit: limit}
+	}
+}
+
+// WithDebugBodyLimit sets how many bytes of each response body are included in debug dumps
+// (see WithDebug); longer bodies are truncated. The default is 2 KB. A limit of zero omits
+// bodies, a negative limit includes them in full.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(
+//	    openmeteo.WithDebug(os.Stderr),
+//	    openmeteo.WithDebugBodyLimit(-1),
+//	)
+func WithDebugBodyLimit(limit int) Option {
+	return func(c *Client) {
+		c.debugBodyLimit = &limit
+		if c.debug != nil {
+			c.debug.limit = limit
+		}
+	}
+}
+
+// maxDebugBodySize is the default number of response body bytes included in a debug dump
+const maxDebugBodySize = 2048
+
+// redacted replaces sensitive header and query values in debug dumps
+const redacted = "REDACTED"
+
+// sensitiveHeaders lists headers whose values are never written to debug output
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+}
+
+// sensitiveQueryParams lists query parameters whose values are never written to debug output
+var sensitiveQueryParams = map[string]bool{
+	"apikey": true,
+}
+
+// debugDumper writes sanitized request/response dumps to a writer.
+// Writes are serialized so concurrent requests do not interleave their output.
+type debugDumper struct {
+	mu    sync.Mutex
+	w     io.Writer
+	limit int // response body bytes to include; negative means all
+}
+
+// dumpRequest writes the request line and sanitized headers of req.
+func (d *debugDumper) dumpRequest(req *http.Request) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--> %s %s\n", req.Method, sanitizeURL(req.URL))
+	writeHeaders(&buf, req.Header)
+	d.write(buf.Bytes())
+}
+
+// dumpResponse writes the status, sanitized headers and (truncated) body of resp.
+// The body is buffered and replaced so it can still be read by the caller.
+func (d *debugDumper) dumpResponse(req *http.Request, resp *http.Response, elapsed time.Duration) {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	var replay io.Reader = bytes.NewReader(body)
+	if err != nil {
+		// Hand the read error on to the caller after the buffered bytes, so that enabling
+		// debug output does not turn a failed read into a truncated body
+		replay = io.MultiReader(replay, errReader{err})
+	}
+	resp.Body = io.NopCloser(replay)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<-- %s %s (%s)\n", resp.Status, sanitizeURL(req.URL), elapsed.Round(time.Millisecond))
+	writeHeaders(&buf, resp.Header)
+	buf.WriteString("\n")
+	if d.limit >= 0 && len(body) > d.limit {
+		buf.Write(body[:d.limit])
+		fmt.Fprintf(&buf, "\n... (%d bytes truncated)", len(body)-d.limit)
+	} else {
+		buf.Write(body)
+	}
+	if err != nil {
+		fmt.Fprintf(&buf, "\n... (body read error: %v)", err)
+	}
+	buf.WriteString("\n")
+	d.write(buf.Bytes())
+}
+
+// errReader is an io.Reader that always fails with err.
+type errReader struct {
+	err error
+}
+
+// Read returns the reader's error.
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// dumpError writes a transport failure for req.
+func (d *debugDumper) dumpError(req *http.Request, err error, elapsed time.Duration) {
+	d.write(fmt.Appendf(nil, "<-- ERROR %s (%s): %v\n", sanitizeURL(req.URL), elapsed.Round(time.Millisecond), err))
+}
+
+// write writes p to the underlying writer under the dumper's lock.
+func (d *debugDumper) write(p []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = d.w.Write(p)
+}
+
+// writeHeaders writes headers in sorted order, redacting sensitive values.
+func writeHeaders(buf *bytes.Buffer, header http.Header) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := strings.Join(header[key], ", ")
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			value = redacted
+		}
+		fmt.Fprintf(buf, "%s: %s\n", key, value)
+	}
+}
+
+// sanitizeURL returns u as a string with sensitive query parameters and user info redacted.
+func sanitizeURL(u *url.URL) string {
+	clean := *u
+	if clean.User != nil {
+		clean.User = url.User(redacted)
+	}
+	q := clean.Query()
+	changed := false
+	for key := range q {
+		if sensitiveQueryParams[strings.ToLower(key)] {
+			q.Set(key, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		clean.RawQuery = q.Encode()
+	}
+	return clean.String()
+}
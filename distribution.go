@@ -0,0 +1,99 @@
+package openmeteo
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// HistogramBin counts the values of a series falling into [Lower, Upper).
+type HistogramBin struct {
+	// Lower is the inclusive lower bound of the bin
+	Lower float64
+
+	// Upper is the exclusive upper bound of the bin
+	Upper float64
+
+	// Count is the number of values in the bin
+	Count int
+}
+
+// Exceedance describes how often a variable was above a threshold.
+type Exceedance struct {
+	// Count is the number of time steps above the threshold
+	Count int
+
+	// Frequency is Count as a fraction (0-1) of the time steps with data
+	Frequency float64
+
+	// Duration is Count multiplied by the series interval (e.g., hours above 30°C)
+	Duration time.Duration
+}
+
+// Percentile returns the p-th percentile (0-100) of variable v, interpolating linearly between
+// the closest ranks; Percentile(v, 50) is the median. Missing values are skipped, and the result
+// is NaN if the series has no data for v or p is outside 0-100.
+//
+// Example:
+//
+//	p90 := archive.Hourly.Percentile(openmeteo.HourlyTemperature2m, 90)
+func (s *Series) Percentile(v Variable, p float64) float64 {
+	values := nonMissing(s.Get(v))
+	if len(values) == 0 || math.IsNaN(p) || p < 0 || p > 100 {
+		return math.NaN()
+	}
+	slices.Sort(values)
+	return percentileOf(values, p)
+}
+
+// Histogram counts the values of variable v in consecutive bins of the given width, aligned to
+// multiples of width (e.g., 5°C bins start at 10, 15, 20). Bins between the lowest and highest
+// value are included even when empty. Missing values are skipped; it returns nil if there is
+// no data or width is not positive.
+//
+// Example:
+//
+//	for _, b := range archive.Hourly.Histogram(openmeteo.HourlyTemperature2m, 5) {
+//	    fmt.Printf("%3.0f-%3.0f°C %s\n", b.Lower, b.Upper, strings.Repeat("#", b.Count/10))
+//	}
+func (s *Series) Histogram(v Variable, width float64) []HistogramBin {
+	values := nonMissing(s.Get(v))
+	if len(values) == 0 || !(width > 0) {
+		return nil
+	}
+
+	lowest := math.Floor(slices.Min(values) / width)
+	n := int(math.Floor(slices.Max(values)/width)-lowest) + 1
+	bins := make([]HistogramBin, n)
+	for i := range bins {
+		bins[i].Lower = (lowest + float64(i)) * width
+		bins[i].Upper = (lowest + float64(i+1)) * width
+	}
+	for _, value := range values {
+		i := min(int(math.Floor(value/width)-lowest), n-1)
+		bins[i].Count++
+	}
+	return bins
+}
+
+// Exceedance reports how often variable v was strictly above threshold, for questions such as
+// "how many hours above 30°C were there last summer". Missing values are skipped.
+//
+// Example:
+//
+//	hot := archive.Hourly.Exceedance(openmeteo.HourlyTemperature2m, 30)
+//	fmt.Printf("%.0f hours above 30°C (%.1f%% of the time)\n", hot.Duration.Hours(), hot.Frequency*100)
+func (s *Series) Exceedance(v Variable, threshold float64) Exceedance {
+	values := nonMissing(s.Get(v))
+	var e Exceedance
+	for _, value := range values {
+		if value > threshold {
+			e.Count++
+		}
+	}
+	if len(values) > 0 {
+		e.Frequency = float64(e.Count) / float64(len(values))
+	}
+	e.Duration = time.Duration(e.Count) * s.Interval()
+	return e
+}
@@ -0,0 +1,65 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestSeriesPercentile tests percentiles with interpolation and missing values
+func TestSeriesPercentile(t *testing.T) {
+	s := hourlySeries(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		HourlyTemperature2m: {30, 10, math.NaN(), 20, 40},
+	})
+
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 10}, {50, 25}, {100, 40}, {90, 37},
+	}
+	for _, tt := range tests {
+		if got := s.Percentile(HourlyTemperature2m, tt.p); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Percentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if !math.IsNaN(s.Percentile(HourlyTemperature2m, 101)) || !math.IsNaN(s.Percentile(HourlyPrecipitation, 50)) {
+		t.Error("Expected NaN for an invalid percentile or missing variable")
+	}
+}
+
+// TestSeriesHistogram tests aligned bins including empty and negative ones
+func TestSeriesHistogram(t *testing.T) {
+	s := hourlySeries(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		HourlyTemperature2m: {-3, -0.5, 0, 4.9, 12, math.NaN(), 14.99},
+	})
+
+	bins := s.Histogram(HourlyTemperature2m, 5)
+	want := []HistogramBin{{-5, 0, 2}, {0, 5, 2}, {5, 10, 0}, {10, 15, 2}}
+	if len(bins) != len(want) {
+		t.Fatalf("Expected %d bins, got %+v", len(want), bins)
+	}
+	for i := range want {
+		if bins[i] != want[i] {
+			t.Errorf("Bin %d: expected %+v, got %+v", i, want[i], bins[i])
+		}
+	}
+	if s.Histogram(HourlyTemperature2m, 0) != nil || s.Histogram(HourlyPrecipitation, 1) != nil {
+		t.Error("Expected nil histogram for zero width or missing variable")
+	}
+}
+
+// TestSeriesExceedance tests counts, frequency and duration above a threshold
+func TestSeriesExceedance(t *testing.T) {
+	s := hourlySeries(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		HourlyTemperature2m: {28, 30, 31, math.NaN(), 33},
+	})
+
+	e := s.Exceedance(HourlyTemperature2m, 30)
+	if e.Count != 2 || e.Frequency != 0.5 || e.Duration != 2*time.Hour {
+		t.Errorf("Unexpected exceedance %+v", e)
+	}
+	if e := s.Exceedance(HourlyPrecipitation, 0); e != (Exceedance{}) {
+		t.Errorf("Expected zero exceedance for a missing variable, got %+v", e)
+	}
+}
@@ -0,0 +1,122 @@
+package openmeteo
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Endpoints holds the base URLs of the Open Meteo services, which the public API serves
+// from separate hosts. Each base URL is joined with the endpoint path of its service,
+// e.g., Forecast with "/forecast" and Geocoding with "/search".
+type Endpoints struct {
+	// Forecast serves the forecast API and the model-specific endpoints (see WithModelEndpoint).
+	Forecast string
+
+	// Geocoding serves location search.
+	Geocoding string
+
+	// Archive serves the historical weather (reanalysis) API.
+	Archive string
+
+	// AirQuality serves the air quality API.
+	AirQuality string
+
+	// Marine serves the marine weather API.
+	Marine string
+
+	// Flood serves the flood (GloFAS) API.
+	Flood string
+
+	// Climate serves the climate projection API.
+	Climate string
+
+	// Ensemble serves the ensemble API.
+	Ensemble string
+
+	// HistoricalForecast serves the historical forecast API.
+	HistoricalForecast string
+}
+
+// DefaultEndpoints returns the base URLs of the public Open Meteo API.
+func DefaultEndpoints() Endpoints {
+	return Endpoints{
+		Forecast:           defaultBaseURL,
+		Geocoding:          defaultGeocodingBaseURL,
+		Archive:            defaultArchiveBaseURL,
+		AirQuality:         defaultAirQualityBaseURL,
+		Marine:             defaultMarineBaseURL,
+		Flood:              defaultFloodBaseURL,
+		Climate:            defaultClimateBaseURL,
+		Ensemble:           defaultEnsembleBaseURL,
+		HistoricalForecast: defaultHistoricalForecastBaseURL,
+	}
+}
+
+// fields returns pointers to all base URLs of e.
+func (e *Endpoints) fields() []*string {
+	return []*string{
+		&e.Forecast, &e.Geocoding, &e.Archive, &e.AirQuality, &e.Marine,
+		&e.Flood, &e.Climate, &e.Ensemble, &e.HistoricalForecast,
+	}
+}
+
+// WithEndpoints sets the base URLs of several services at once, e.g., to address a
+// self-hosted deployment or a mix of self-hosted and public services from one client.
+// Empty fields keep their current value, so only the services to override need to be set.
+// The single-service options (WithBaseURL, WithGeocodingBaseURL, ...) set one field each.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithEndpoints(openmeteo.Endpoints{
+//	    Forecast:   "https://weather.internal/v1",
+//	    Archive:    "https://archive.weather.internal/v1",
+//	    AirQuality: "https://weather.internal/v1",
+//	}))
+func WithEndpoints(endpoints Endpoints) Option {
+	return func(c *Client) {
+		current := c.endpoints.fields()
+		for i, base := range endpoints.fields() {
+			if *base != "" {
+				*current[i] = *base
+			}
+		}
+	}
+}
+
+// WithSelfHosted points all services at a single self-hosted Open Meteo instance (e.g., the
+// open-meteo Docker image), which serves every API below one host: forecast calls go to
+// <baseHost>/v1/forecast, archive calls to <baseHost>/v1/archive and so on. A scheme-less
+// host uses http, and "/v1" is appended unless baseHost has a path. Since the instance is
+// not the public API, the client also drops the public API's assumptions: it neither
+// switches to the customer hosts with an API key (see WithAPIKey), nor checks date ranges
+// against the public retention limits, nor caps concurrent requests at 10. The Docker
+// image does not include geocoding; use WithGeocodingBaseURL afterwards to keep the public
+// geocoding API.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(
+//	    openmeteo.WithSelfHosted("localhost:8080"),
+//	    openmeteo.WithGeocodingBaseURL("https://geocoding-api.open-meteo.com/v1"),
+//	)
+func WithSelfHosted(baseHost string) Option {
+	return func(c *Client) {
+		base := strings.TrimSuffix(baseHost, "/")
+		if !strings.Contains(base, "://") {
+			base = "http://" + base
+		}
+		if u, err := url.Parse(base); err == nil && u.Path == "" {
+			base += "/v1"
+		}
+		for _, field := range c.endpoints.fields() {
+			*field = base
+		}
+		c.selfHosted = true
+	}
+}
+
+// Endpoints returns the base URLs the client sends requests to, after applying WithAPIKey's
+// switch to the customer hosts.
+func (c *Client) Endpoints() Endpoints {
+	return c.endpoints
+}
@@ -0,0 +1,181 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// defaultEnsembleBaseURL is the base URL of the Open Meteo ensemble API
+const defaultEnsembleBaseURL = "https://ensemble-api.open-meteo.com/v1"
+
+// Ensemble models served by the ensemble API (see GetEnsembleForecast and Models(ServiceEnsemble)).
+const (
+	// EnsembleICONSeamless combines the DWD ICON-EPS ensembles (40 members)
+	EnsembleICONSeamless Model = "icon_seamless"
+
+	// EnsembleGFSSeamless combines the NOAA GEFS ensembles (31 members)
+	EnsembleGFSSeamless Model = "gfs_seamless"
+
+	// EnsembleECMWFIFS04 is the ECMWF IFS ensemble at 0.4° (51 members)
+	EnsembleECMWFIFS04 Model = "ecmwf_ifs04"
+)
+
+// EnsembleForecast holds the hourly member forecasts of one or more ensemble models.
+type EnsembleForecast struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds
+	UTCOffsetSeconds int
+
+	// Models lists the requested ensemble models
+	Models []Model
+
+	// Hourly holds all member series under their API names, e.g., "temperature_2m" for the
+	// control run and "temperature_2m_member01" for the first member; with several models each
+	// name ends in the model (e.g., "temperature_2m_member01_gfs_seamless"). Use Members instead
+	// of building the names.
+	Hourly Series
+
+	// Stale reports that the forecast was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since a stale forecast was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// Members returns the hourly values of variable v for every member of model: the control run
+// first, followed by members 1 to N, each aligned with Hourly.Time. The model may be empty when
+// a single model was requested. It returns nil if the variable or model was not returned.
+//
+// Example:
+//
+//	for i, member := range ensemble.Members(openmeteo.HourlyTemperature2m, openmeteo.EnsembleGFSSeamless) {
+//	    fmt.Printf("member %d: %.1f°C\n", i, member[24])
+//	}
+func (f *EnsembleForecast) Members(v Variable, model Model) [][]float64 {
+	suffix := ""
+	if len(f.Models) > 1 {
+		suffix = "_" + string(model)
+	}
+
+	var members [][]float64
+	if control, ok := f.Hourly.Values[v+Variable(suffix)]; ok {
+		members = append(members, control)
+	}
+	for i := 1; ; i++ {
+		values, ok := f.Hourly.Values[Variable(fmt.Sprintf("%s_member%02d%s", v, i, suffix))]
+		if !ok {
+			break
+		}
+		members = append(members, values)
+	}
+	return members
+}
+
+// GetEnsembleForecast fetches the hourly member forecasts of ensemble models from the
+// ensemble API (ensemble-api.open-meteo.com, see WithEnsembleBaseURL). Each model contributes
+// its control run and all members (e.g., 31 for EnsembleGFSSeamless); the spread between
+// members indicates the forecast uncertainty.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - latitude: Latitude in degrees (-90 to 90)
+//   - longitude: Longitude in degrees (-180 to 180)
+//   - vars: Hourly variables to request (at least one)
+//   - models: Ensemble models to request (at least one, e.g., EnsembleICONSeamless)
+//   - opts: Optional per-request settings (e.g., WithTimezone, WithForecastDays up to 16)
+//
+// Example:
+//
+//	ensemble, err := client.GetEnsembleForecast(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyTemperature2m},
+//	    []openmeteo.Model{openmeteo.EnsembleICONSeamless})
+//	if err != nil {
+//	    return err
+//	}
+//	members := ensemble.Members(openmeteo.HourlyTemperature2m, openmeteo.EnsembleICONSeamless)
+func (c *Client) GetEnsembleForecast(ctx context.Context, latitude, longitude float64, vars []Variable, models []Model, opts ...RequestOption) (*EnsembleForecast, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if err := c.checkDateLimits(cfg, ServiceEnsemble, requestID); err != nil {
+		return nil, err
+	}
+	if len(vars) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one ensemble variable is required",
+			RequestID: requestID,
+		}
+	}
+	if len(models) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one ensemble model is required",
+			RequestID: requestID,
+		}
+	}
+
+	q := url.Values{}
+	q.Set("hourly", joinVariables(vars))
+	q.Set("models", joinModels(models))
+	if err := checkCompatibility(q, cfg, latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	reqURL, err := c.buildServiceURL(c.endpoints.Ensemble, "/ensemble", latitude, longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp forecastResponse
+	meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := resolveLocation(apiResp.Timezone, apiResp.TimezoneAbbreviation, apiResp.UTCOffsetSeconds)
+	hourly, err := parseSeries(apiResp.Hourly, apiResp.HourlyUnits, loc)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeAPI,
+			Message:   "failed to parse hourly data",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	return &EnsembleForecast{
+		Latitude:         apiResp.Latitude,
+		Longitude:        apiResp.Longitude,
+		Location:         loc,
+		Timezone:         apiResp.Timezone,
+		UTCOffsetSeconds: apiResp.UTCOffsetSeconds,
+		Models:           append([]Model(nil), models...),
+		Hourly:           hourly,
+		Stale:            meta.stale,
+		Age:              meta.age,
+	}, nil
+}
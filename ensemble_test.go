@@ -0,0 +1,94 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetEnsembleForecast tests the ensemble request and member access for several models
+func TestGetEnsembleForecast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/ensemble" || q.Get("hourly") != "temperature_2m" || q.Get("models") != "icon_seamless,gfs_seamless" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"latitude": 52.5, "longitude": 13.4, "hourly_units": {"temperature_2m_icon_seamless": "°C"},
+			"hourly": {"time": ["2025-12-29T00:00", "2025-12-29T01:00"],
+				"temperature_2m_icon_seamless": [1, 2],
+				"temperature_2m_member01_icon_seamless": [1.5, 2.5],
+				"temperature_2m_member02_icon_seamless": [0.5, 1.5],
+				"temperature_2m_gfs_seamless": [3, 4],
+				"temperature_2m_member01_gfs_seamless": [3.5, null]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEnsembleBaseURL(server.URL))
+	ensemble, err := client.GetEnsembleForecast(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m}, []Model{EnsembleICONSeamless, EnsembleGFSSeamless})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	icon := ensemble.Members(HourlyTemperature2m, EnsembleICONSeamless)
+	if len(icon) != 3 || icon[0][1] != 2 || icon[2][0] != 0.5 {
+		t.Errorf("Unexpected ICON members %v", icon)
+	}
+	if gfs := ensemble.Members(HourlyTemperature2m, EnsembleGFSSeamless); len(gfs) != 2 || gfs[1][0] != 3.5 {
+		t.Errorf("Unexpected GFS members %v", gfs)
+	}
+	if members := ensemble.Members(HourlyPrecipitation, EnsembleGFSSeamless); members != nil {
+		t.Errorf("Expected no members for missing variable, got %v", members)
+	}
+}
+
+// TestEnsembleForecast_MembersSingleModel tests member names without a model suffix
+func TestEnsembleForecast_MembersSingleModel(t *testing.T) {
+	f := &EnsembleForecast{
+		Models: []Model{EnsembleECMWFIFS04},
+		Hourly: Series{Values: map[Variable][]float64{
+			"temperature_2m":          {1},
+			"temperature_2m_member01": {2},
+			"temperature_2m_member03": {4},
+		}},
+	}
+	for _, model := range []Model{"", EnsembleECMWFIFS04} {
+		if members := f.Members(HourlyTemperature2m, model); len(members) != 2 || members[1][0] != 2 {
+			t.Errorf("Model %q: expected control and first member, got %v", model, members)
+		}
+	}
+}
+
+// TestGetEnsembleForecast_Validation tests that invalid requests fail before any HTTP call
+func TestGetEnsembleForecast_Validation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEnsembleBaseURL(server.URL))
+	vars, models := []Variable{HourlyTemperature2m}, []Model{EnsembleGFSSeamless}
+	tests := []struct {
+		name   string
+		vars   []Variable
+		models []Model
+	}{
+		{"no variables", nil, models},
+		{"no models", vars, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetEnsembleForecast(context.Background(), 52.52, 13.41, tt.vars, tt.models)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+	if called {
+		t.Error("Expected no HTTP request for invalid input")
+	}
+}
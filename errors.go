@@ -1,6 +1,9 @@
 package openmeteo
 
-import "fmt"
+import (
+	"fmt"
+	"log/slog"
+)
 
 // ErrorType classifies the category of error that occurred during SDK operations.
 type ErrorType int
@@ -31,18 +34,60 @@ type Error struct {
 
 	// Cause is the underlying error that caused this error (may be nil)
 	Cause error
+
+	// RequestID identifies the API call that failed (sent as the X-Request-ID header).
+	// It is empty for errors not associated with a call.
+	RequestID string
+
+	// statusCode is the HTTP status of an API error (0 otherwise), used to decide on retries
+	statusCode int
 }
 
 // Error returns a formatted error message implementing the error interface.
 // If a Cause is present, it is included in the message.
+// If a RequestID is present, it is appended for log correlation.
 func (e *Error) Error() string {
+	msg := e.Message
 	if e.Cause != nil {
-		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+		msg = fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s (request_id=%s)", msg, e.RequestID)
 	}
-	return e.Message
+	return msg
 }
 
 // Unwrap returns the underlying cause error, enabling error chain inspection with errors.Is() and errors.As().
 func (e *Error) Unwrap() error {
 	return e.Cause
 }
+
+// String returns the name of the error type.
+func (t ErrorType) String() string {
+	switch t {
+	case ErrorTypeValidation:
+		return "validation"
+	case ErrorTypeNetwork:
+		return "network"
+	case ErrorTypeAPI:
+		return "api"
+	default:
+		return fmt.Sprintf("ErrorType(%d)", int(t))
+	}
+}
+
+// LogValue implements slog.LogValuer so that logging an *Error emits structured
+// attributes (type, message, request_id, cause) instead of a flat string.
+func (e *Error) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("type", e.Type.String()),
+		slog.String("message", e.Message),
+	}
+	if e.RequestID != "" {
+		attrs = append(attrs, slog.String("request_id", e.RequestID))
+	}
+	if e.Cause != nil {
+		attrs = append(attrs, slog.String("cause", e.Cause.Error()))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -212,3 +212,61 @@ func TestError_TypeSwitch(t *testing.T) {
 		})
 	}
 }
+
+// TestError_RequestIDInMessage tests that the request ID is appended to the error message
+func TestError_RequestIDInMessage(t *testing.T) {
+	err := &Error{
+		Type:      ErrorTypeAPI,
+		Message:   "API returned status 500",
+		Cause:     fmt.Errorf("boom"),
+		RequestID: "abc",
+	}
+
+	expected := "API returned status 500: boom (request_id=abc)"
+	if err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+}
+
+// TestErrorType_String tests ErrorType string names
+func TestErrorType_String(t *testing.T) {
+	testCases := map[ErrorType]string{
+		ErrorTypeValidation: "validation",
+		ErrorTypeNetwork:    "network",
+		ErrorTypeAPI:        "api",
+		ErrorType(99):       "ErrorType(99)",
+	}
+
+	for typ, expected := range testCases {
+		if typ.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, typ.String())
+		}
+	}
+}
+
+// TestError_LogValue tests structured logging attributes of Error
+func TestError_LogValue(t *testing.T) {
+	err := &Error{
+		Type:      ErrorTypeNetwork,
+		Message:   "failed to execute HTTP request",
+		Cause:     fmt.Errorf("connection refused"),
+		RequestID: "req-1",
+	}
+
+	attrs := map[string]string{}
+	for _, a := range err.LogValue().Group() {
+		attrs[a.Key] = a.Value.String()
+	}
+
+	expected := map[string]string{
+		"type":       "network",
+		"message":    "failed to execute HTTP request",
+		"request_id": "req-1",
+		"cause":      "connection refused",
+	}
+	for k, v := range expected {
+		if attrs[k] != v {
+			t.Errorf("Expected attribute %s=%q, got %q", k, v, attrs[k])
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+// EventDefinition defines a temperature extreme event such as a heatwave: at least MinDays
+// consecutive days on which Variable is above (or below) a threshold.
+type EventDefinition struct {
+	// Variable is the daily variable to test (e.g., DailyTemperature2mMax)
+	Variable Variable
+
+	// Percentile sets a threshold relative to the climate: the given percentile (0-100) of the
+	// variable's normals for the calendar day (see Normals.Percentile). When zero, Threshold is used.
+	Percentile float64
+
+	// Threshold is an absolute threshold in the variable's unit, used when Percentile is zero
+	Threshold float64
+
+	// Below selects days below the threshold (cold spells) instead of above it
+	Below bool
+
+	// MinDays is the minimum number of consecutive days of an event
+	MinDays int
+}
+
+// Common event definitions based on percentiles of normals.
+var (
+	// HeatwaveDefinition is at least 3 consecutive days with a maximum temperature above the
+	// 90th percentile of normals
+	HeatwaveDefinition = EventDefinition{Variable: DailyTemperature2mMax, Percentile: 90, MinDays: 3}
+
+	// ColdSpellDefinition is at least 3 consecutive days with a minimum temperature below the
+	// 10th percentile of normals
+	ColdSpellDefinition = EventDefinition{Variable: DailyTemperature2mMin, Percentile: 10, Below: true, MinDays: 3}
+)
+
+// ExtremeEvent is a period of consecutive days meeting an EventDefinition.
+type ExtremeEvent struct {
+	// Start is the first day of the event (start of day in the series' Location)
+	Start time.Time
+
+	// End is the last day of the event (inclusive)
+	End time.Time
+
+	// Days is the number of days of the event
+	Days int
+
+	// Peak is the day with the most extreme value
+	Peak time.Time
+
+	// PeakValue is the most extreme value of the event (highest for heat, lowest for cold)
+	PeakValue float64
+
+	// PeakExcess is how far PeakValue exceeds the day's threshold (always positive)
+	PeakExcess float64
+}
+
+// DetectEvents finds the periods of a daily series meeting def, e.g. HeatwaveDefinition or
+// ColdSpellDefinition. Percentile-based definitions need normals computed for def.Variable
+// (see GetNormals); absolute thresholds work without (normals may be nil). Days with
+// missing values end an event. Events still in progress at the end of the series are
+// included if they already last MinDays.
+//
+// Example:
+//
+//	normals, err := client.GetNormals(ctx, 48.86, 2.35, []openmeteo.Variable{openmeteo.DailyTemperature2mMax}, openmeteo.StandardNormalsPeriod)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, e := range openmeteo.DetectEvents(&forecast.Daily, normals, openmeteo.HeatwaveDefinition) {
+//	    fmt.Printf("heatwave %s to %s, peak %.1f °C on %s\n",
+//	        e.Start.Format("Jan 2"), e.End.Format("Jan 2"), e.PeakValue, e.Peak.Format("Jan 2"))
+//	}
+func DetectEvents(daily *Series, normals *Normals, def EventDefinition) []ExtremeEvent {
+	minDays := max(def.MinDays, 1)
+	days := daily.TimesInLocal()
+
+	var events []ExtremeEvent
+	var current *ExtremeEvent
+	flush := func() {
+		if current != nil && current.Days >= minDays {
+			events = append(events, *current)
+		}
+		current = nil
+	}
+
+	for i, day := range days {
+		value := daily.valueAt(def.Variable, i)
+		threshold := def.Threshold
+		if def.Percentile != 0 {
+			threshold = math.NaN()
+			if normals != nil {
+				threshold = normals.Percentile(def.Variable, day, def.Percentile)
+			}
+		}
+		excess := value - threshold
+		if def.Below {
+			excess = threshold - value
+		}
+		if math.IsNaN(excess) || excess <= 0 {
+			flush()
+			continue
+		}
+
+		if current == nil {
+			current = &ExtremeEvent{Start: day, Peak: day, PeakValue: value, PeakExcess: excess}
+		}
+		current.End = day
+		current.Days++
+		if (!def.Below && value > current.PeakValue) || (def.Below && value < current.PeakValue) {
+			current.Peak, current.PeakValue, current.PeakExcess = day, value, excess
+		}
+	}
+	flush()
+	return events
+}
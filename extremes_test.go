@@ -0,0 +1,66 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// constantNormals computes normals of v from three years of a constant daily value
+func constantNormals(v Variable, value float64) *Normals {
+	values := make([]float64, 3*365+1)
+	for i := range values {
+		values[i] = value
+	}
+	s := dailySeries(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{v: values}, map[Variable]string{})
+	return computeNormals(&s, []Variable{v})
+}
+
+// TestDetectEvents_Heatwave tests percentile-based heatwave detection
+func TestDetectEvents_Heatwave(t *testing.T) {
+	normals := constantNormals(DailyTemperature2mMax, 25)
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	s := dailySeries(start, map[Variable][]float64{
+		DailyTemperature2mMax: {26, 29, 28, 24, 26, 26, math.NaN(), 30, 31, 32},
+	}, map[Variable]string{})
+
+	events := DetectEvents(&s, normals, HeatwaveDefinition)
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 heatwaves, got %+v", events)
+	}
+	first := events[0]
+	if !first.Start.Equal(start) || !first.End.Equal(start.AddDate(0, 0, 2)) || first.Days != 3 {
+		t.Errorf("Unexpected first heatwave %+v", first)
+	}
+	if !first.Peak.Equal(start.AddDate(0, 0, 1)) || first.PeakValue != 29 || first.PeakExcess != 4 {
+		t.Errorf("Unexpected peak of first heatwave %+v", first)
+	}
+	// The event in progress at the end of the series is included
+	if last := events[1]; last.Days != 3 || last.PeakValue != 32 || !last.End.Equal(start.AddDate(0, 0, 9)) {
+		t.Errorf("Unexpected last heatwave %+v", last)
+	}
+
+	if events := DetectEvents(&s, nil, HeatwaveDefinition); events != nil {
+		t.Errorf("Expected no events without normals, got %+v", events)
+	}
+}
+
+// TestDetectEvents_ColdSpell tests cold spells and absolute thresholds
+func TestDetectEvents_ColdSpell(t *testing.T) {
+	normals := constantNormals(DailyTemperature2mMin, -2)
+	start := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	s := dailySeries(start, map[Variable][]float64{
+		DailyTemperature2mMin: {-1, -5, -12, -8, -3, 0},
+	}, map[Variable]string{})
+
+	events := DetectEvents(&s, normals, ColdSpellDefinition)
+	if len(events) != 1 || events[0].Days != 4 || events[0].PeakValue != -12 || events[0].PeakExcess != 10 {
+		t.Errorf("Expected a 4-day cold spell peaking at -12, got %+v", events)
+	}
+
+	frost := EventDefinition{Variable: DailyTemperature2mMin, Threshold: -6, Below: true, MinDays: 2}
+	events = DetectEvents(&s, nil, frost)
+	if len(events) != 1 || !events[0].Start.Equal(start.AddDate(0, 0, 2)) || events[0].Days != 2 {
+		t.Errorf("Expected a 2-day frost period, got %+v", events)
+	}
+}
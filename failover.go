@@ -0,0 +1,164 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// WithFallbackBaseURLs configures mirrors that are tried in order when a call fails with a
+// network error, HTTP 429 or a 5xx status, e.g., a self-hosted instance backing up the
+// official API. Each mirror serves all endpoints below its base URL, like a self-hosted
+// instance: with "https://weather.internal/v1", forecast calls fail over to
+// https://weather.internal/v1/forecast, archive calls to https://weather.internal/v1/archive
+// and geocoding calls to https://weather.internal/v1/search. The API key (see WithAPIKey) is
+// only sent to open-meteo.com hosts.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(
+//	    openmeteo.WithFallbackBaseURLs("https://weather.internal/v1"),
+//	)
+func WithFallbackBaseURLs(baseURLs ...string) Option {
+	return func(c *Client) {
+		c.fallbackBaseURLs = append([]string(nil), baseURLs...)
+	}
+}
+
+// WithHedging sends a hedged request when a call has not completed after delay: the next
+// fallback host (see WithFallbackBaseURLs) is called in parallel, or the primary host again
+// when no fallback is configured. The first successful response is used and the other
+// request is cancelled. A failing request also starts the next host right away. Hedging
+// trades extra requests for lower tail latency; hedged requests count against the
+// concurrency limit and quota like any other request. A delay of zero or less disables
+// hedging.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(
+//	    openmeteo.WithFallbackBaseURLs("https://weather.internal/v1"),
+//	    openmeteo.WithHedging(800*time.Millisecond),
+//	)
+func WithHedging(delay time.Duration) Option {
+	return func(c *Client) {
+		c.hedgeDelay = delay
+	}
+}
+
+// isOpenMeteoHost reports whether host belongs to the official Open Meteo API.
+func isOpenMeteoHost(host string) bool {
+	return strings.HasSuffix(host, ".open-meteo.com")
+}
+
+// fallbackURL rewrites reqURL to the same endpoint and query below base.
+func fallbackURL(base, reqURL string) (string, error) {
+	u, err := url.Parse(reqURL)
+	if err != nil {
+		return "", err
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	b.Path = strings.TrimSuffix(b.Path, "/") + "/" + path.Base(u.Path)
+	q := u.Query()
+	if !isOpenMeteoHost(b.Hostname()) {
+		q.Del("apikey")
+	}
+	b.RawQuery = q.Encode()
+	return b.String(), nil
+}
+
+// fetchFailover fetches reqURL, failing over to the fallback hosts or hedging according to
+// the client configuration. Without either it is fetch.
+func (c *Client) fetchFailover(ctx context.Context, requestID, reqURL string, cfg *requestConfig, out any) ([]byte, error) {
+	if len(c.fallbackBaseURLs) == 0 && c.hedgeDelay <= 0 {
+		return c.fetch(ctx, requestID, reqURL, cfg, out)
+	}
+
+	targets := []string{reqURL}
+	for _, base := range c.fallbackBaseURLs {
+		if target, err := fallbackURL(base, reqURL); err == nil {
+			targets = append(targets, target)
+		}
+	}
+	if c.hedgeDelay > 0 {
+		if len(targets) == 1 {
+			targets = append(targets, reqURL)
+		}
+		return c.fetchHedged(ctx, requestID, targets, cfg, out)
+	}
+
+	var body []byte
+	var err error
+	for _, target := range targets {
+		body, err = c.fetch(ctx, requestID, target, cfg, out)
+		if err == nil || !retryable(ctx, err) {
+			return body, err
+		}
+	}
+	return body, err
+}
+
+// fetchHedged fetches the first target and starts the next one whenever hedgeDelay passes
+// or a request fails with a transient error, until one succeeds or all have failed.
+// Responses are decoded into out only for the winning request.
+func (c *Client) fetchHedged(ctx context.Context, requestID string, targets []string, cfg *requestConfig, out any) ([]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		body []byte
+		err  error
+	}
+	results := make(chan result, len(targets))
+	next := 0
+	launch := func() {
+		target := targets[next]
+		next++
+		go func() {
+			var raw json.RawMessage
+			body, err := c.fetch(ctx, requestID, target, cfg, &raw)
+			results <- result{body, err}
+		}()
+	}
+
+	launch()
+	pending := 1
+	timer := time.NewTimer(c.hedgeDelay)
+	defer timer.Stop()
+
+	var err error
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if next < len(targets) {
+				launch()
+				pending++
+				timer.Reset(c.hedgeDelay)
+			}
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				if err := json.Unmarshal(r.body, out); err != nil {
+					return nil, &Error{
+						Type:      ErrorTypeAPI,
+						Message:   "failed to parse JSON response",
+						Cause:     err,
+						RequestID: requestID,
+					}
+				}
+				return r.body, nil
+			}
+			err = r.err
+			if retryable(ctx, r.err) && next < len(targets) {
+				launch()
+				pending++
+			}
+		}
+	}
+	return nil, err
+}
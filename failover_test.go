@@ -0,0 +1,101 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// weatherHandler answers current weather requests with temperature after delay
+func weatherHandler(temperature float64, delay time.Duration, calls *atomic.Int32, status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": %v}}`, temperature)
+	}
+}
+
+// TestWithFallbackBaseURLs tests failover to mirrors in order
+func TestWithFallbackBaseURLs(t *testing.T) {
+	var primaryCalls, brokenCalls, mirrorCalls atomic.Int32
+	primary := httptest.NewServer(weatherHandler(0, 0, &primaryCalls, http.StatusServiceUnavailable))
+	defer primary.Close()
+	broken := httptest.NewServer(weatherHandler(0, 0, &brokenCalls, http.StatusBadGateway))
+	defer broken.Close()
+	var mirrorQuery string
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openmeteo/forecast" {
+			t.Errorf("Expected the endpoint below the mirror base URL, got %s", r.URL.Path)
+		}
+		mirrorQuery = r.URL.RawQuery
+		weatherHandler(21.5, 0, &mirrorCalls, http.StatusOK)(w, r)
+	}))
+	defer mirror.Close()
+
+	client := NewClient(WithBaseURL(primary.URL), WithAPIKey("secret"),
+		WithFallbackBaseURLs(broken.URL+"/v1", mirror.URL+"/openmeteo/"))
+	weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if weather.Temperature != 21.5 {
+		t.Errorf("Expected the mirror's response, got %v", weather.Temperature)
+	}
+	if primaryCalls.Load() != 1 || brokenCalls.Load() != 1 || mirrorCalls.Load() != 1 {
+		t.Errorf("Expected one call per host, got %d, %d, %d", primaryCalls.Load(), brokenCalls.Load(), mirrorCalls.Load())
+	}
+	if q, _ := url.ParseQuery(mirrorQuery); q.Has("apikey") || q.Get("latitude") != "52.52" {
+		t.Errorf("Expected the query without the API key on the mirror, got %s", mirrorQuery)
+	}
+
+	// Client errors are not failed over
+	_, err = client.GetCurrentWeather(context.Background(), 52.52, 13.41, WithCellSelection("ocean"))
+	if err == nil || mirrorCalls.Load() != 1 {
+		t.Errorf("Expected a validation error without failover, got %v", err)
+	}
+}
+
+// TestWithHedging tests that a slow primary is hedged by the fallback host
+func TestWithHedging(t *testing.T) {
+	var slowCalls, fastCalls atomic.Int32
+	slow := httptest.NewServer(weatherHandler(10, time.Second, &slowCalls, http.StatusOK))
+	defer slow.Close()
+	fast := httptest.NewServer(weatherHandler(20, 0, &fastCalls, http.StatusOK))
+	defer fast.Close()
+
+	client := NewClient(WithBaseURL(slow.URL), WithFallbackBaseURLs(fast.URL), WithHedging(50*time.Millisecond))
+	start := time.Now()
+	weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if weather.Temperature != 20 {
+		t.Errorf("Expected the hedged response, got %v", weather.Temperature)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the hedged request to win, took %s", elapsed)
+	}
+
+	// A fast primary needs no hedge
+	client = NewClient(WithBaseURL(fast.URL), WithFallbackBaseURLs(slow.URL), WithHedging(time.Second))
+	if weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41); err != nil || weather.Temperature != 20 {
+		t.Fatalf("Expected the primary response, got %v, %v", weather, err)
+	}
+	if slowCalls.Load() != 1 || fastCalls.Load() != 2 {
+		t.Errorf("Expected no hedge for a fast primary, got %d slow and %d fast calls", slowCalls.Load(), fastCalls.Load())
+	}
+}
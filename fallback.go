@@ -0,0 +1,107 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/url"
+)
+
+// WithModelFallback configures an ordered model preference for forecast calls.
+// Each call first requests the preferred model; if that model fails (API or network
+// error) or has no data for the requested point, the call is transparently retried
+// with the next model. The model that produced the result is recorded in the Model
+// field of the result. If no model has data, the result of the first model that
+// answered is returned; if every model fails, the last error is returned.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithModelFallback("ecmwf_ifs025", "icon_seamless", "gfs_global"))
+//	weather, err := client.GetCurrentWeather(ctx, 52.52, 13.41)
+//	fmt.Println("served by", weather.Model)
+func WithModelFallback(models ...Model) Option {
+	return func(c *Client) {
+		c.modelFallback = append([]Model(nil), models...)
+	}
+}
+
+// fallbackAttempt performs a call with the given query and reports whether the result has data.
+type fallbackAttempt[T any] func(q url.Values) (result T, covered bool, err error)
+
+// withModelFallback runs attempt for each model of the fallback chain (setting the models
+// query parameter) until one succeeds with data. Without a chain, attempt runs once
+// with the query unchanged and the returned model is empty.
+func withModelFallback[T any](ctx context.Context, models []Model, q url.Values, attempt fallbackAttempt[T]) (T, Model, error) {
+	var zero T
+	if len(models) == 0 {
+		result, _, err := attempt(q)
+		return result, "", err
+	}
+
+	var (
+		uncovered      T
+		uncoveredModel Model
+		lastErr        error
+	)
+	for _, model := range models {
+		q.Set("models", string(model))
+		result, covered, err := attempt(q)
+		if err != nil {
+			if !retryableWithFallback(ctx, err) {
+				return zero, "", err
+			}
+			lastErr = err
+			continue
+		}
+		if covered {
+			return result, model, nil
+		}
+		if uncoveredModel == "" {
+			uncovered, uncoveredModel = result, model
+		}
+	}
+
+	if uncoveredModel != "" {
+		return uncovered, uncoveredModel, nil
+	}
+	return zero, "", lastErr
+}
+
+// retryableWithFallback reports whether err warrants trying the next model.
+// Validation errors (which would fail for every model) and canceled calls are final.
+func retryableWithFallback(ctx context.Context, err error) bool {
+	var sdkErr *Error
+	if !errors.As(err, &sdkErr) || ctx.Err() != nil {
+		return false
+	}
+	return sdkErr.Type == ErrorTypeAPI || sdkErr.Type == ErrorTypeNetwork
+}
+
+// hasCurrentData reports whether a current weather response contains data.
+// Models without coverage for a point return null values.
+func hasCurrentData(resp weatherResponse) bool {
+	if resp.LegacyCurrentWeather != nil && resp.LegacyCurrentWeather.Temperature != nil {
+		return true
+	}
+	return resp.CurrentWeather.Temperature != nil || resp.CurrentWeather.Weathercode != nil
+}
+
+// hasSeriesData reports whether a series contains at least one non-missing value.
+func hasSeriesData(s Series) bool {
+	for _, values := range s.Values {
+		if hasData(values) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasData reports whether values contains at least one non-missing value.
+func hasData(values []float64) bool {
+	for _, value := range values {
+		if !math.IsNaN(value) {
+			return true
+		}
+	}
+	return false
+}
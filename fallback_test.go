@@ -0,0 +1,85 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestWithModelFallback_CurrentWeather tests retrying with the next model on errors and missing coverage
+func TestWithModelFallback_CurrentWeather(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		model := r.URL.Query().Get("models")
+		requested = append(requested, model)
+		switch model {
+		case "ecmwf_ifs025":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintln(w, `{"error": true, "reason": "Model unavailable"}`)
+		case "icon_d2":
+			_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": null}}`)
+		default:
+			_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 4.5}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithModelFallback("ecmwf_ifs025", "icon_d2", "gfs_global"))
+	weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if weather.Model != "gfs_global" || weather.Temperature != 4.5 {
+		t.Errorf("Expected gfs_global result, got model %q temperature %v", weather.Model, weather.Temperature)
+	}
+	if fmt.Sprint(requested) != "[ecmwf_ifs025 icon_d2 gfs_global]" {
+		t.Errorf("Unexpected model order %v", requested)
+	}
+
+	// Without a chain no models parameter is sent
+	requested = nil
+	weather, err = NewClient(WithBaseURL(server.URL)).GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err != nil || weather.Model != "" || requested[0] != "" {
+		t.Errorf("Expected plain request, got model %q, requested %v, err %v", weather.Model, requested, err)
+	}
+}
+
+// TestWithModelFallback_HourlyForecast tests fallback results when no model has full coverage
+func TestWithModelFallback_HourlyForecast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("models") {
+		case "icon_d2", "meteofrance_arome_france":
+			_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "hourly": {"time": ["2025-12-29T10:00"], "temperature_2m": [null]}}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	vars := []Variable{HourlyTemperature2m}
+
+	client := NewClient(WithBaseURL(server.URL), WithModelFallback("ecmwf_ifs025", "icon_d2", "meteofrance_arome_france"))
+	forecast, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41, vars)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if forecast.Model != "icon_d2" {
+		t.Errorf("Expected first answering model icon_d2, got %q", forecast.Model)
+	}
+
+	client = NewClient(WithBaseURL(server.URL), WithModelFallback("ecmwf_ifs025", "gfs_global"))
+	_, err = client.GetHourlyForecast(context.Background(), 52.52, 13.41, vars)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeAPI {
+		t.Errorf("Expected API error when all models fail, got %v", err)
+	}
+
+	// Validation errors are not retried
+	_, err = client.GetHourlyForecast(context.Background(), 52.52, 13.41, vars, WithTimezone(""))
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
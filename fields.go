@@ -0,0 +1,50 @@
+package openmeteo
+
+// Fields is a bitmask of CurrentWeather fields that carry data from the API. The API reports
+// missing values as null, which the SDK converts to zero values; Fields tells "0 mm rain" from
+// "no rain data", e.g., for models or mirrors that do not provide a variable, or for the
+// legacy current_weather block, which only carries a few variables.
+type Fields uint32
+
+// Field flags of the CurrentWeather measurement fields.
+const (
+	FieldTime Fields = 1 << iota
+	FieldTemperature
+	FieldRelativeHumidity
+	FieldApparentTemperature
+	FieldIsDay
+	FieldPrecipitation
+	FieldRain
+	FieldShowers
+	FieldSnowfall
+	FieldWeatherCode
+	FieldCloudCover
+	FieldPressureMSL
+	FieldSurfacePressure
+	FieldWindSpeed
+	FieldWindDirection
+	FieldWindGusts
+	FieldDewPoint
+	FieldVisibility
+	FieldVapourPressureDeficit
+	FieldCAPE
+)
+
+// Has reports whether all fields in mask are set in f.
+func (f Fields) Has(mask Fields) bool {
+	return f&mask == mask
+}
+
+// Has reports whether the API returned values for all fields in mask. A field without a value
+// holds its zero value.
+//
+// Example:
+//
+//	if weather.Has(openmeteo.FieldRain) {
+//	    fmt.Printf("Rain: %.1f mm\n", weather.Rain)
+//	} else {
+//	    fmt.Println("Rain: no data")
+//	}
+func (w *CurrentWeather) Has(mask Fields) bool {
+	return w.Fields.Has(mask)
+}
@@ -0,0 +1,59 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestCurrentWeather_Has tests that null and missing values are told apart from zero values
+func TestCurrentWeather_Has(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 52.52,
+			"longitude": 13.41,
+			"current": {
+				"time": "2025-12-29T10:00",
+				"temperature_2m": 0.0,
+				"rain": 0.0,
+				"snowfall": null,
+				"is_day": 0
+			}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		field Fields
+		want  bool
+	}{
+		{"time", FieldTime, true},
+		{"zero temperature", FieldTemperature, true},
+		{"zero rain", FieldRain, true},
+		{"false is_day", FieldIsDay, true},
+		{"null snowfall", FieldSnowfall, false},
+		{"missing visibility", FieldVisibility, false},
+		{"all present", FieldTemperature | FieldRain, true},
+		{"one missing", FieldTemperature | FieldSnowfall, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := weather.Has(tt.field); got != tt.want {
+				t.Errorf("Has(%b) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+	if weather.Fields != FieldTime|FieldTemperature|FieldRain|FieldIsDay {
+		t.Errorf("Expected time, temperature, rain and is_day, got %b", weather.Fields)
+	}
+}
@@ -0,0 +1,199 @@
+package openmeteo
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// fileCacheExt is the file name extension of FileCache entries
+	fileCacheExt = ".cache"
+
+	// fileCacheTempPrefix is the file name prefix of entries being written by Set
+	fileCacheTempPrefix = "tmp-"
+
+	// fileCacheTempGrace is the age from which Prune removes temporary files, which are left
+	// behind when a process is killed while writing an entry
+	fileCacheTempGrace = time.Hour
+)
+
+// FileCache is a Cache that keeps one file per request in a directory, so cached responses
+// survive restarts of CLI tools and desktop apps. File names are SHA-256 hashes of the keys;
+// each file starts with its expiry time (Unix nanoseconds) on the first line, followed by the
+// response body. Expired files are removed when read or by Prune, which also cleans up
+// temporary files of interrupted writes. Write and read failures are treated as cache misses.
+// It is safe for concurrent use, also by several processes sharing the directory.
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache creates a file cache in dir, creating the directory if needed.
+//
+// Example:
+//
+//	dir, _ := os.UserCacheDir()
+//	cache, err := openmeteo.NewFileCache(filepath.Join(dir, "openmeteo"))
+//	if err != nil {
+//	    return err
+//	}
+//	client := openmeteo.NewClient(openmeteo.WithCache(cache, time.Hour))
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+// clock returns the current time.
+func (f *FileCache) clock() time.Time {
+	if f.now != nil {
+		return f.now()
+	}
+	return time.Now()
+}
+
+// path returns the file of key.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+fileCacheExt)
+}
+
+// Get returns the value stored for key unless it is missing, unreadable or expired.
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	path := f.path(key)
+	data, info, err := readFileCacheEntry(path)
+	if err != nil {
+		return nil, false
+	}
+	expires, body, ok := parseFileCacheEntry(data)
+	if !ok || !f.clock().Before(expires) {
+		_ = removeFileCacheEntry(path, info)
+		return nil, false
+	}
+	return body, true
+}
+
+// Set stores value for key until ttl has passed. The file is written to a temporary file
+// first and renamed, so readers never see a partial entry.
+func (f *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	tmp, err := os.CreateTemp(f.dir, fileCacheTempPrefix+"*")
+	if err != nil {
+		return
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	w := bufio.NewWriter(tmp)
+	_, _ = w.WriteString(strconv.FormatInt(f.clock().Add(ttl).UnixNano(), 10) + "\n")
+	_, _ = w.Write(value)
+	if w.Flush() != nil || tmp.Close() != nil {
+		_ = tmp.Close()
+		return
+	}
+	_ = os.Rename(tmp.Name(), f.path(key))
+}
+
+// Prune removes all expired and unreadable entries from the directory, and temporary files
+// older than an hour that interrupted writes left behind.
+func (f *FileCache) Prune() error {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return err
+	}
+	now := f.clock()
+	for _, e := range entries {
+		path := filepath.Join(f.dir, e.Name())
+		switch {
+		case e.IsDir():
+		case strings.HasPrefix(e.Name(), fileCacheTempPrefix):
+			info, err := e.Info()
+			if err != nil || now.Sub(info.ModTime()) < fileCacheTempGrace {
+				continue
+			}
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		case strings.HasSuffix(e.Name(), fileCacheExt):
+			expires, info, ok := readFileCacheExpiry(path)
+			if info == nil || ok && now.Before(expires) {
+				continue
+			}
+			if err := removeFileCacheEntry(path, info); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeFileCacheEntry removes the stale entry at path unless the file was replaced since
+// info was taken, e.g. by another process renaming a fresh entry into place.
+func removeFileCacheEntry(path string, info os.FileInfo) error {
+	current, err := os.Stat(path)
+	if err != nil || !os.SameFile(info, current) {
+		return nil
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// readFileCacheEntry reads a cache file and its info, closing it before returning so that it
+// can be removed on all platforms.
+func readFileCacheEntry(path string) ([]byte, os.FileInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := io.ReadAll(file)
+	return data, info, err
+}
+
+// parseFileCacheEntry splits a cache file into its expiry time and body.
+func parseFileCacheEntry(data []byte) (time.Time, []byte, bool) {
+	header, body, ok := bytes.Cut(data, []byte("\n"))
+	if !ok {
+		return time.Time{}, nil, false
+	}
+	nanos, err := strconv.ParseInt(string(header), 10, 64)
+	if err != nil {
+		return time.Time{}, nil, false
+	}
+	return time.Unix(0, nanos), body, true
+}
+
+// readFileCacheExpiry reads the expiry time of a cache file without reading its body. It
+// also returns the file's info, which is nil if the file cannot be opened.
+func readFileCacheExpiry(path string) (time.Time, os.FileInfo, bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		return time.Time{}, nil, false
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return time.Time{}, nil, false
+	}
+	header, err := bufio.NewReader(file).ReadString('\n')
+	if err != nil {
+		return time.Time{}, info, false
+	}
+	expires, _, ok := parseFileCacheEntry([]byte(header))
+	return expires, info, ok
+}
@@ -0,0 +1,123 @@
+package openmeteo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestFileCache tests persistence, expiry and pruning
+func TestFileCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "openmeteo")
+	now := time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)
+	cache, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	cache.now = func() time.Time { return now }
+
+	cache.Set("https://api.open-meteo.com/v1/forecast?latitude=52.52", []byte(`{"a":1}`), time.Hour)
+	cache.Set("https://api.open-meteo.com/v1/forecast?latitude=48.14", []byte(`{"b":2}`), time.Minute)
+
+	// A second cache on the same directory sees the entries, e.g., in the next run of a CLI
+	reopened, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	reopened.now = cache.now
+	if v, ok := reopened.Get("https://api.open-meteo.com/v1/forecast?latitude=52.52"); !ok || string(v) != `{"a":1}` {
+		t.Errorf("Expected the cached body, got %q, %v", v, ok)
+	}
+	if _, ok := reopened.Get("https://api.open-meteo.com/v1/forecast?latitude=0"); ok {
+		t.Error("Expected a miss for an unknown key")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := os.WriteFile(filepath.Join(dir, "corrupt"+fileCacheExt), []byte("garbage"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Prune(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	files, _ := os.ReadDir(dir)
+	if len(files) != 1 {
+		t.Errorf("Expected the expired and corrupt entries to be pruned, got %d files", len(files))
+	}
+	if _, ok := cache.Get("https://api.open-meteo.com/v1/forecast?latitude=52.52"); !ok {
+		t.Error("Expected the unexpired entry to survive pruning")
+	}
+	now = now.Add(time.Hour)
+	if _, ok := cache.Get("https://api.open-meteo.com/v1/forecast?latitude=52.52"); ok {
+		t.Error("Expected the entry to expire")
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("Expected expired entries to be removed on read, got %d files", len(files))
+	}
+}
+
+// TestFileCache_PruneTempFiles tests that Prune removes temporary files of interrupted writes
+// once they are older than the grace period
+func TestFileCache_PruneTempFiles(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	stale, fresh := filepath.Join(dir, fileCacheTempPrefix+"1"), filepath.Join(dir, fileCacheTempPrefix+"2")
+	for _, path := range []string{stale, fresh} {
+		if err := os.WriteFile(path, []byte("partial"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-2 * fileCacheTempGrace)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cache.Prune(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("Expected the stale temporary file to be removed, got %v", err)
+	}
+	if _, err := os.Stat(fresh); err != nil {
+		t.Errorf("Expected the recent temporary file to be kept, got %v", err)
+	}
+}
+
+// TestRemoveFileCacheEntry tests that an expired entry is kept when another writer has
+// replaced it since it was read
+func TestRemoveFileCacheEntry(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "entry"+fileCacheExt)
+	if err := os.WriteFile(path, []byte("0\nexpired"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, info, err := readFileCacheEntry(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	replacement := filepath.Join(dir, fileCacheTempPrefix+"1")
+	if err := os.WriteFile(replacement, []byte("9000000000000000000\nfresh"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(replacement, path); err != nil {
+		t.Fatal(err)
+	}
+	if err := removeFileCacheEntry(path, info); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "9000000000000000000\nfresh" {
+		t.Errorf("Expected the replacement entry to be kept, got %q", data)
+	}
+
+	_, info, _ = readFileCacheEntry(path)
+	if err := removeFileCacheEntry(path, info); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the unchanged entry to be removed, got %v", err)
+	}
+}
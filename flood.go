@@ -0,0 +1,161 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// defaultFloodBaseURL is the base URL of the Open Meteo flood (GloFAS) API
+const defaultFloodBaseURL = "https://flood-api.open-meteo.com/v1"
+
+// Daily river discharge variables of the flood API in m³/s. The statistics are computed over
+// the 50 members of the GloFAS ensemble.
+const (
+	DailyRiverDischarge       Variable = "river_discharge"
+	DailyRiverDischargeMean   Variable = "river_discharge_mean"
+	DailyRiverDischargeMedian Variable = "river_discharge_median"
+	DailyRiverDischargeMax    Variable = "river_discharge_max"
+	DailyRiverDischargeMin    Variable = "river_discharge_min"
+	DailyRiverDischargeP25    Variable = "river_discharge_p25"
+	DailyRiverDischargeP75    Variable = "river_discharge_p75"
+)
+
+// FloodVariables lists the river discharge and its ensemble statistics, for use with GetFloodForecast.
+var FloodVariables = []Variable{
+	DailyRiverDischarge,
+	DailyRiverDischargeMean,
+	DailyRiverDischargeMedian,
+	DailyRiverDischargeMax,
+	DailyRiverDischargeMin,
+	DailyRiverDischargeP25,
+	DailyRiverDischargeP75,
+}
+
+// FloodForecast holds daily river discharge data for a location (see GetFloodForecast).
+type FloodForecast struct {
+	// Latitude of the river grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the river grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (daily values cover UTC days)
+	Location *time.Location `json:"-"`
+
+	// Daily holds the requested daily variables and, with WithFloodEnsemble, the river
+	// discharge of each ensemble member (see Members)
+	Daily Series
+
+	// Stale reports that the forecast was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since a stale forecast was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// Members returns the daily river discharge of every ensemble member, each aligned with
+// Daily.Time. It returns nil unless the forecast was requested with WithFloodEnsemble.
+func (f *FloodForecast) Members() [][]float64 {
+	var members [][]float64
+	for i := 1; ; i++ {
+		values, ok := f.Daily.Values[Variable(fmt.Sprintf("%s_member%02d", DailyRiverDischarge, i))]
+		if !ok {
+			return members
+		}
+		members = append(members, values)
+	}
+}
+
+// WithFloodEnsemble requests the river discharge of all 50 GloFAS ensemble members in
+// addition to the selected variables (see FloodForecast.Members). Other calls ignore this option.
+func WithFloodEnsemble() RequestOption {
+	return func(r *requestConfig) {
+		r.ensemble = true
+	}
+}
+
+// GetFloodForecast fetches daily river discharge from the flood API (flood-api.open-meteo.com,
+// see WithFloodBaseURL), based on the GloFAS hydrological model. The data describes the river
+// nearest to the coordinates within a 5 km grid, so small streams may not be represented.
+// Without a date range the API returns the past 3 months and the 3 months ahead; WithDateRange
+// selects any period from 1984 to 210 days ahead.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - latitude: Latitude in degrees (-90 to 90)
+//   - longitude: Longitude in degrees (-180 to 180)
+//   - vars: Daily variables to request (at least one, e.g., FloodVariables)
+//   - opts: Optional per-request settings (e.g., WithDateRange, WithFloodEnsemble)
+//
+// Example:
+//
+//	flood, err := client.GetFloodForecast(ctx, 50.94, 6.96, openmeteo.FloodVariables)
+//	if err != nil {
+//	    return err
+//	}
+//	median := flood.Daily.Get(openmeteo.DailyRiverDischargeMedian)
+//	upper := flood.Daily.Get(openmeteo.DailyRiverDischargeP75)
+func (c *Client) GetFloodForecast(ctx context.Context, latitude, longitude float64, vars []Variable, opts ...RequestOption) (*FloodForecast, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if err := c.checkDateLimits(cfg, ServiceFlood, requestID); err != nil {
+		return nil, err
+	}
+	if len(vars) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one river discharge variable is required",
+			RequestID: requestID,
+		}
+	}
+
+	// Like the climate API, the flood API serves daily values for UTC days only and takes
+	// no timezone, so the general compatibility rules for daily data do not apply.
+	cfg.timezone = ""
+	q := url.Values{}
+	q.Set("daily", joinVariables(vars))
+	reqURL, err := c.buildServiceURL(c.endpoints.Flood, "/flood", latitude, longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp forecastResponse
+	meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := resolveLocation(apiResp.Timezone, apiResp.TimezoneAbbreviation, apiResp.UTCOffsetSeconds)
+	daily, err := parseSeries(apiResp.Daily, apiResp.DailyUnits, loc)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeAPI,
+			Message:   "failed to parse daily data",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	return &FloodForecast{
+		Latitude:  apiResp.Latitude,
+		Longitude: apiResp.Longitude,
+		Location:  loc,
+		Daily:     daily,
+		Stale:     meta.stale,
+		Age:       meta.age,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetFloodForecast tests the flood request, statistics and ensemble members
+func TestGetFloodForecast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/flood" || q.Get("daily") != "river_discharge,river_discharge_p75" || q.Has("timezone") {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		if q.Get("ensemble") != "true" {
+			_, _ = w.Write([]byte(`{"latitude": 50.9, "longitude": 7.0, "daily_units": {"river_discharge": "m³/s"},
+				"daily": {"time": ["2025-12-29", "2025-12-30"], "river_discharge": [410.5, 398.2], "river_discharge_p75": [430, 420]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"latitude": 50.9, "longitude": 7.0,
+			"daily": {"time": ["2025-12-29", "2025-12-30"], "river_discharge": [410.5, 398.2], "river_discharge_p75": [430, 420],
+				"river_discharge_member01": [400, 390], "river_discharge_member02": [420, 410]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithFloodBaseURL(server.URL))
+	vars := []Variable{DailyRiverDischarge, DailyRiverDischargeP75}
+	flood, err := client.GetFloodForecast(context.Background(), 50.94, 6.96, vars, WithTimezone("auto"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if flood.Daily.Len() != 2 || flood.Daily.Get(DailyRiverDischargeP75)[1] != 420 || flood.Daily.Unit(DailyRiverDischarge) != "m³/s" {
+		t.Errorf("Unexpected daily data %+v", flood.Daily)
+	}
+	if members := flood.Members(); members != nil {
+		t.Errorf("Expected no members without ensemble, got %v", members)
+	}
+
+	flood, err = client.GetFloodForecast(context.Background(), 50.94, 6.96, vars, WithFloodEnsemble())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if members := flood.Members(); len(members) != 2 || members[1][0] != 420 {
+		t.Errorf("Unexpected members %v", members)
+	}
+
+	var apiErr *Error
+	if _, err := client.GetFloodForecast(context.Background(), 50.94, 6.96, nil); !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error without variables, got %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// HourlyForecast holds hourly forecast data for a location.
+type HourlyForecast struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds at the time of the request.
+	// Offsets of individual timestamps may differ across DST transitions; use Location for those.
+	UTCOffsetSeconds int
+
+	// Units are the units the API returned the data in (see WithUnits)
+	Units UnitSystem
+
+	// Hourly holds the requested hourly variables
+	Hourly Series
+
+	// Model is the model that produced the forecast when a fallback chain is configured
+	// (see WithModelFallback) or a single model is pinned (see WithModels); empty otherwise
+	Model Model
+
+	// Stale reports that the forecast was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since a stale forecast was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// Forecast holds the blocks returned by a combined forecast call (see GetForecast).
+type Forecast struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds at the time of the request.
+	// Offsets of individual timestamps may differ across DST transitions; use Location for those.
+	UTCOffsetSeconds int
+
+	// Units are the units the API returned the data in (see WithUnits)
+	Units UnitSystem
+
+	// Current holds the current conditions (nil unless requested)
+	Current *CurrentWeather `json:",omitempty"`
+
+	// Hourly holds the requested hourly variables (empty unless requested)
+	Hourly Series
+
+	// Daily holds the requested daily variables, one step per local day (empty unless requested)
+	Daily Series
+
+	// Model is the model that produced the forecast when a fallback chain is configured
+	// (see WithModelFallback) or a single model is pinned (see WithModels); empty otherwise
+	Model Model
+
+	// Stale reports that the forecast was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since a stale forecast was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// ForecastRequest selects the location and the blocks fetched by GetForecast.
+// At least one block must be requested.
+type ForecastRequest struct {
+	// Latitude in degrees (-90 to 90)
+	Latitude float64
+
+	// Longitude in degrees (-180 to 180)
+	Longitude float64
+
+	// Current requests the current conditions (the same variables as GetCurrentWeather)
+	Current bool
+
+	// Hourly lists the hourly variables to request
+	Hourly []Variable
+
+	// Daily lists the daily variables to request (requires WithTimezone)
+	Daily []Variable
+}
+
+// forecastResponse is an internal structure for unmarshaling forecast API responses
+// containing current conditions and time series blocks.
+type forecastResponse struct {
+	weatherResponse
+	Hourly      map[string]json.RawMessage `json:"hourly"`
+	HourlyUnits map[string]string          `json:"hourly_units"`
+	Daily       map[string]json.RawMessage `json:"daily"`
+	DailyUnits  map[string]string          `json:"daily_units"`
+}
+
+// GetForecast fetches current conditions, hourly and daily data in a single HTTP round trip.
+// Only the blocks selected in req are requested; the others are left empty in the result.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - req: Coordinates and blocks to request
+//   - opts: Optional per-request settings (e.g., WithTimezone, required for daily data)
+//
+// Example:
+//
+//	forecast, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude:  52.52,
+//	    Longitude: 13.41,
+//	    Current:   true,
+//	    Hourly:    []openmeteo.Variable{openmeteo.HourlyTemperature2m},
+//	    Daily:     []openmeteo.Variable{openmeteo.DailyTemperature2mMax, openmeteo.DailyTemperature2mMin},
+//	}, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(forecast.Current.Temperature, forecast.Daily.Get(openmeteo.DailyTemperature2mMax))
+func (c *Client) GetForecast(ctx context.Context, req ForecastRequest, opts ...RequestOption) (*Forecast, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(req.Latitude, req.Longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if err := c.checkDateLimits(cfg, ServiceForecast, requestID); err != nil {
+		return nil, err
+	}
+	if !req.Current && len(req.Hourly) == 0 && len(req.Daily) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one of current, hourly or daily data must be requested",
+			RequestID: requestID,
+		}
+	}
+
+	q := url.Values{}
+	if req.Current {
+		if c.legacyCurrentWeather {
+			q.Set("current_weather", "true")
+		} else {
+			q.Set("current", currentVariables)
+		}
+	}
+	if len(req.Hourly) > 0 {
+		q.Set("hourly", joinVariables(req.Hourly))
+	}
+	if len(req.Daily) > 0 {
+		q.Set("daily", joinVariables(req.Daily))
+	}
+	if err := checkCompatibility(q, cfg, req.Latitude, req.Longitude, requestID); err != nil {
+		return nil, err
+	}
+
+	chain := c.modelFallback
+	if len(cfg.models) > 0 {
+		q.Set("models", joinModels(cfg.models))
+		chain = nil
+	}
+
+	forecast, model, err := withModelFallback(ctx, chain, q, func(q url.Values) (*Forecast, bool, error) {
+		reqURL, err := c.buildRequestURL(req.Latitude, req.Longitude, q, cfg)
+		if err != nil {
+			return nil, false, &Error{
+				Type:      ErrorTypeValidation,
+				Message:   "failed to build request URL",
+				Cause:     err,
+				RequestID: requestID,
+			}
+		}
+
+		var apiResp forecastResponse
+		meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+		if err != nil {
+			return nil, false, err
+		}
+
+		forecast, covered, err := c.convertToForecast(apiResp, req.Current, c.unitsFor(cfg), requestID)
+		if err != nil {
+			return nil, false, err
+		}
+		forecast.Stale, forecast.Age = meta.stale, meta.age
+		return forecast, covered, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.models) == 1 {
+		model = cfg.models[0]
+	}
+	forecast.Model = model
+	if forecast.Current != nil {
+		forecast.Current.Model = model
+		forecast.Current.Stale, forecast.Current.Age = forecast.Stale, forecast.Age
+	}
+	return forecast, nil
+}
+
+// convertToForecast converts the internal API response to the public Forecast type and
+// reports whether any block contains data. Malformed series are returned as ErrorTypeAPI errors.
+func (c *Client) convertToForecast(apiResp forecastResponse, current bool, units UnitSystem, requestID string) (*Forecast, bool, error) {
+	loc := resolveLocation(apiResp.Timezone, apiResp.TimezoneAbbreviation, apiResp.UTCOffsetSeconds)
+	forecast := &Forecast{
+		Latitude:         apiResp.Latitude,
+		Longitude:        apiResp.Longitude,
+		Location:         loc,
+		Timezone:         apiResp.Timezone,
+		UTCOffsetSeconds: apiResp.UTCOffsetSeconds,
+		Units:            units,
+	}
+
+	blocks := []struct {
+		name   string
+		data   map[string]json.RawMessage
+		units  map[string]string
+		series *Series
+	}{
+		{"hourly", apiResp.Hourly, apiResp.HourlyUnits, &forecast.Hourly},
+		{"daily", apiResp.Daily, apiResp.DailyUnits, &forecast.Daily},
+	}
+	for _, b := range blocks {
+		series, err := parseSeries(b.data, b.units, loc)
+		if err != nil {
+			return nil, false, &Error{
+				Type:      ErrorTypeAPI,
+				Message:   "failed to parse " + b.name + " data",
+				Cause:     err,
+				RequestID: requestID,
+			}
+		}
+		*b.series = series
+	}
+
+	covered := hasSeriesData(forecast.Hourly) || hasSeriesData(forecast.Daily)
+	if current {
+		forecast.Current = c.convertToCurrentWeather(apiResp.weatherResponse, units)
+		covered = covered || hasCurrentData(apiResp.weatherResponse)
+	}
+	return forecast, covered, nil
+}
+
+// GetHourlyForecast fetches hourly forecast data for the given variables at the specified coordinates.
+// It is a shorthand for GetForecast requesting only the hourly block.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - latitude: Latitude in degrees (-90 to 90)
+//   - longitude: Longitude in degrees (-180 to 180)
+//   - vars: Hourly variables to request (at least one)
+//   - opts: Optional per-request settings (e.g., WithTemporalResolution)
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyTemperature2m, openmeteo.HourlyPrecipitation},
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	temps := forecast.Hourly.Get(openmeteo.HourlyTemperature2m)
+func (c *Client) GetHourlyForecast(ctx context.Context, latitude, longitude float64, vars []Variable, opts ...RequestOption) (*HourlyForecast, error) {
+	requestID := requestIDFor(ctx)
+	ctx = WithRequestID(ctx, requestID)
+
+	if len(vars) == 0 {
+		if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+			return nil, err
+		}
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one hourly variable is required",
+			RequestID: requestID,
+		}
+	}
+
+	forecast, err := c.GetForecast(ctx, ForecastRequest{Latitude: latitude, Longitude: longitude, Hourly: vars}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &HourlyForecast{
+		Latitude:         forecast.Latitude,
+		Longitude:        forecast.Longitude,
+		Location:         forecast.Location,
+		Timezone:         forecast.Timezone,
+		UTCOffsetSeconds: forecast.UTCOffsetSeconds,
+		Units:            forecast.Units,
+		Hourly:           forecast.Hourly,
+		Model:            forecast.Model,
+		Stale:            forecast.Stale,
+		Age:              forecast.Age,
+	}, nil
+}
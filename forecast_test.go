@@ -0,0 +1,341 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestGetHourlyForecast_Success tests successful hourly forecast fetching
+func TestGetHourlyForecast_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/forecast" {
+			t.Errorf("Expected path /forecast, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("hourly") != "temperature_2m,precipitation" {
+			t.Errorf("Expected hourly=temperature_2m,precipitation, got %s", r.URL.Query().Get("hourly"))
+		}
+		if r.URL.Query().Has("temporal_resolution") {
+			t.Error("Expected no temporal_resolution by default")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 52.52,
+			"longitude": 13.42,
+			"hourly_units": {"time": "iso8601", "temperature_2m": "°C", "precipitation": "mm"},
+			"hourly": {
+				"time": ["2025-12-29T00:00", "2025-12-29T01:00"],
+				"temperature_2m": [1.2, 1.0],
+				"precipitation": [0.0, 0.4]
+			}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	forecast, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m, HourlyPrecipitation})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if forecast.Latitude != 52.52 || forecast.Longitude != 13.42 {
+		t.Errorf("Unexpected coordinates %.2f, %.2f", forecast.Latitude, forecast.Longitude)
+	}
+	if forecast.Hourly.Len() != 2 {
+		t.Fatalf("Expected 2 hourly steps, got %d", forecast.Hourly.Len())
+	}
+	if forecast.Hourly.Interval() != time.Hour {
+		t.Errorf("Expected 1h interval, got %v", forecast.Hourly.Interval())
+	}
+	if got := forecast.Hourly.Get(HourlyPrecipitation)[1]; got != 0.4 {
+		t.Errorf("Expected precipitation 0.4, got %.1f", got)
+	}
+	if forecast.Hourly.Unit(HourlyPrecipitation) != "mm" {
+		t.Errorf("Expected unit mm, got %q", forecast.Hourly.Unit(HourlyPrecipitation))
+	}
+}
+
+// TestGetHourlyForecast_TemporalResolution tests the temporal_resolution parameter
+func TestGetHourlyForecast_TemporalResolution(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("temporal_resolution") != "hourly_6" {
+			t.Errorf("Expected temporal_resolution=hourly_6, got %s", r.URL.Query().Get("temporal_resolution"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 52.52,
+			"longitude": 13.41,
+			"hourly": {
+				"time": ["2025-12-29T00:00", "2025-12-29T06:00", "2025-12-29T12:00"],
+				"temperature_2m": [1.2, 2.0, 5.5]
+			}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	forecast, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m},
+		WithTemporalResolution(TemporalResolutionHourly6))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if forecast.Hourly.Interval() != 6*time.Hour {
+		t.Errorf("Expected 6h interval, got %v", forecast.Hourly.Interval())
+	}
+}
+
+// TestGetHourlyForecast_ValidationErrors tests input validation
+func TestGetHourlyForecast_ValidationErrors(t *testing.T) {
+	client := NewClient()
+
+	testCases := []struct {
+		name string
+		lat  float64
+		lon  float64
+		vars []Variable
+	}{
+		{"Invalid latitude", 91, 0, []Variable{HourlyTemperature2m}},
+		{"Invalid longitude", 0, 181, []Variable{HourlyTemperature2m}},
+		{"No variables", 0, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.GetHourlyForecast(context.Background(), tc.lat, tc.lon, tc.vars)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected *Error, got %T", err)
+			}
+			if apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected ErrorTypeValidation, got %v", apiErr.Type)
+			}
+		})
+	}
+}
+
+// TestGetHourlyForecast_MalformedSeries tests API errors for inconsistent hourly blocks
+func TestGetHourlyForecast_MalformedSeries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"hourly": {"time": ["2025-12-29T00:00"], "temperature_2m": [1, 2]}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	_, err := client.GetHourlyForecast(context.Background(), 0, 0, []Variable{HourlyTemperature2m})
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if apiErr.Type != ErrorTypeAPI {
+		t.Errorf("Expected ErrorTypeAPI, got %v", apiErr.Type)
+	}
+}
+
+// TestGetHourlyForecast_HTTPError tests HTTP error responses
+func TestGetHourlyForecast_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, `{"error": true, "reason": "Cannot initialize WeatherVariable from invalid String value foo"}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	_, err := client.GetHourlyForecast(context.Background(), 0, 0, []Variable{"foo"})
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if apiErr.Type != ErrorTypeAPI {
+		t.Errorf("Expected ErrorTypeAPI, got %v", apiErr.Type)
+	}
+}
+
+// TestGetForecast tests requesting current, hourly and daily blocks in one round trip
+func TestGetForecast(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if q.Get("current") != currentVariables || q.Get("hourly") != "temperature_2m" || q.Get("daily") != "temperature_2m_max,temperature_2m_min" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 52.52, "longitude": 13.41, "utc_offset_seconds": 3600, "timezone": "Europe/Berlin", "timezone_abbreviation": "CET",
+			"current": {"time": "2025-12-29T10:00", "temperature_2m": 4.5},
+			"hourly_units": {"temperature_2m": "°C"},
+			"hourly": {"time": ["2025-12-29T00:00", "2025-12-29T01:00"], "temperature_2m": [3.1, 2.9]},
+			"daily_units": {"temperature_2m_max": "°C", "temperature_2m_min": "°C"},
+			"daily": {"time": ["2025-12-29", "2025-12-30"], "temperature_2m_max": [6.2, null], "temperature_2m_min": [1.0, 0.5]}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	forecast, err := client.GetForecast(context.Background(), ForecastRequest{
+		Latitude:  52.52,
+		Longitude: 13.41,
+		Current:   true,
+		Hourly:    []Variable{HourlyTemperature2m},
+		Daily:     []Variable{DailyTemperature2mMax, DailyTemperature2mMin},
+	}, WithTimezone("Europe/Berlin"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected a single request, got %d", requests)
+	}
+	if forecast.Current == nil || forecast.Current.Temperature != 4.5 {
+		t.Errorf("Unexpected current block %+v", forecast.Current)
+	}
+	if forecast.Hourly.Len() != 2 || forecast.Hourly.Get(HourlyTemperature2m)[1] != 2.9 {
+		t.Errorf("Unexpected hourly block %+v", forecast.Hourly)
+	}
+	maxTemps := forecast.Daily.Get(DailyTemperature2mMax)
+	if forecast.Daily.Len() != 2 || maxTemps[0] != 6.2 || !math.IsNaN(maxTemps[1]) {
+		t.Errorf("Unexpected daily block %+v", forecast.Daily)
+	}
+	if forecast.Daily.Unit(DailyTemperature2mMin) != "°C" {
+		t.Errorf("Expected daily units, got %v", forecast.Daily.Units)
+	}
+	if got := forecast.Daily.Time[0]; !got.Equal(time.Date(2025, 12, 28, 23, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected daily step at local midnight, got %s", got)
+	}
+}
+
+// TestGetForecast_Validation tests block selection and parse errors
+func TestGetForecast_Validation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "daily": {"time": ["2025-12-29"], "temperature_2m_max": [1, 2]}}`)
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	var apiErr *Error
+	_, err := client.GetForecast(context.Background(), ForecastRequest{Latitude: 52.52, Longitude: 13.41})
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error without blocks, got %v", err)
+	}
+
+	_, err = client.GetForecast(context.Background(), ForecastRequest{Latitude: 52.52, Longitude: 13.41, Daily: []Variable{DailyTemperature2mMax}})
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error for daily data without timezone, got %v", err)
+	}
+
+	_, err = client.GetForecast(context.Background(), ForecastRequest{Latitude: 52.52, Longitude: 13.41, Daily: []Variable{DailyTemperature2mMax}}, WithTimezone("UTC"))
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeAPI || apiErr.Message != "failed to parse daily data" {
+		t.Errorf("Expected daily parse error, got %v", err)
+	}
+}
+
+// TestGetForecast_WithModels tests pinned models and model-suffixed variables
+func TestGetForecast_WithModels(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Query().Get("models"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("models") == "gfs_seamless,icon_seamless" {
+			_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41,
+				"hourly_units": {"time": "iso8601", "temperature_2m_gfs_seamless": "°C", "temperature_2m_icon_seamless": "°C"},
+				"hourly": {"time": ["2025-12-29T10:00"], "temperature_2m_gfs_seamless": [1.5], "temperature_2m_icon_seamless": [2.5]}}`)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "hourly": {"time": ["2025-12-29T10:00"], "temperature_2m": [3.5]}}`)
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL), WithModelFallback("ecmwf_ifs025", "gfs_global"))
+	ctx := context.Background()
+	vars := []Variable{HourlyTemperature2m}
+
+	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars, WithModels(ModelGFSSeamless, ModelICONSeamless))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	gfs := forecast.Hourly.ForModel(ModelGFSSeamless)
+	icon := forecast.Hourly.ForModel(ModelICONSeamless)
+	if gfs.Get(HourlyTemperature2m)[0] != 1.5 || icon.Get(HourlyTemperature2m)[0] != 2.5 || len(gfs.Values) != 1 {
+		t.Errorf("Unexpected per-model values %v and %v", gfs.Values, icon.Values)
+	}
+	if gfs.Unit(HourlyTemperature2m) != "°C" || gfs.Len() != 1 {
+		t.Errorf("Expected units and time steps to be kept, got %+v", gfs)
+	}
+	if forecast.Model != "" {
+		t.Errorf("Expected no single model for a comparison, got %q", forecast.Model)
+	}
+
+	forecast, err = client.GetHourlyForecast(ctx, 52.52, 13.41, vars, WithModels(ModelICOND2))
+	if err != nil || forecast.Model != ModelICOND2 || forecast.Hourly.Get(HourlyTemperature2m)[0] != 3.5 {
+		t.Errorf("Expected pinned icon_d2 forecast, got %+v (err %v)", forecast, err)
+	}
+	if len(requested) != 2 || requested[1] != "icon_d2" {
+		t.Errorf("Expected pinned models to bypass the fallback chain, got %v", requested)
+	}
+
+	var apiErr *Error
+	invalid := [][]RequestOption{
+		{WithModels()},
+		{WithModels("")},
+	}
+	for _, opts := range invalid {
+		if _, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars, opts...); !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	}
+	_, err = client.GetCurrentWeather(ctx, 52.52, 13.41, WithModels(ModelGFSSeamless, ModelICONSeamless))
+	if !errors.As(err, &apiErr) || !strings.Contains(apiErr.Message, "single model") {
+		t.Errorf("Expected validation error for current data of several models, got %v", err)
+	}
+	if len(requested) != 2 {
+		t.Errorf("Expected invalid calls not to be sent, got %v", requested)
+	}
+}
+
+// TestGetHourlyForecast_ModelEndpoint tests routing forecast calls to a model-specific endpoint
+func TestGetHourlyForecast_ModelEndpoint(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "hourly": {"time": ["2025-12-29T10:00"], "lightning_potential": [120]}}`)
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL + "/v1"))
+	ctx := context.Background()
+	vars := []Variable{HourlyLightningPotential}
+
+	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars, WithModelEndpoint(EndpointDWDICON), WithModels(ModelICOND2))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if forecast.Hourly.Get(HourlyLightningPotential)[0] != 120 {
+		t.Errorf("Unexpected values %v", forecast.Hourly.Values)
+	}
+	if _, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "/v1/dwd-icon" || paths[1] != "/v1/forecast" {
+		t.Errorf("Expected /v1/dwd-icon then /v1/forecast, got %v", paths)
+	}
+
+	var apiErr *Error
+	for _, endpoint := range []ModelEndpoint{"", "../archive", "dwd-icon?x=1", "GFS"} {
+		_, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars, WithModelEndpoint(endpoint))
+		if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+			t.Errorf("Expected validation error for %q, got %v", endpoint, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package openmeteo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+const defaultGeocodingBaseURL = "https://geocoding-api.open-meteo.com/v1"
+
+// Location is a place returned by the Open Meteo geocoding API.
+// Name and administrative area names are translated into the language requested
+// with WithLanguage (English by default).
+type Location struct {
+	// ID is the unique GeoNames identifier of the location
+	ID int
+
+	// Name is the place name in the requested language
+	Name string
+
+	// Latitude of the location in degrees
+	Latitude float64
+
+	// Longitude of the location in degrees
+	Longitude float64
+
+	// Elevation of the location in meters above sea level
+	Elevation float64
+
+	// FeatureCode is the GeoNames feature code (e.g., "PPLC" for a capital city)
+	FeatureCode string
+
+	// CountryCode is the ISO-3166-1 alpha-2 country code (e.g., "DE")
+	CountryCode string
+
+	// Country is the country name in the requested language
+	Country string
+
+	// Timezone is the IANA time zone of the location (e.g., "Europe/Berlin")
+	Timezone string
+
+	// Population of the location (0 if unknown)
+	Population int
+
+	// Postcodes lists the postal codes of the location, if known
+	Postcodes []string
+
+	// Admin1 to Admin4 are the names of the administrative areas containing the location,
+	// from largest (state) to smallest, in the requested language
+	Admin1 string
+	Admin2 string
+	Admin3 string
+	Admin4 string
+}
+
+// geocodingResponse is an internal structure for unmarshaling geocoding API responses.
+type geocodingResponse struct {
+	Results []struct {
+		ID          int      `json:"id"`
+		Name        string   `json:"name"`
+		Latitude    float64  `json:"latitude"`
+		Longitude   float64  `json:"longitude"`
+		Elevation   float64  `json:"elevation"`
+		FeatureCode string   `json:"feature_code"`
+		CountryCode string   `json:"country_code"`
+		Country     string   `json:"country"`
+		Timezone    string   `json:"timezone"`
+		Population  int      `json:"population"`
+		Postcodes   []string `json:"postcodes"`
+		Admin1      string   `json:"admin1"`
+		Admin2      string   `json:"admin2"`
+		Admin3      string   `json:"admin3"`
+		Admin4      string   `json:"admin4"`
+	} `json:"results"`
+}
+
+// SearchLocations looks up places by name using the Open Meteo geocoding API.
+// Results are ordered by relevance; an empty slice is returned when nothing matches.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - name: Place name or postal code to search for
+//   - opts: Optional per-request settings (WithLanguage, WithResultCount, WithCountryCode)
+//
+// Example:
+//
+//	locations, err := client.SearchLocations(ctx, "München",
+//	    openmeteo.WithLanguage("de"),
+//	    openmeteo.WithCountryCode("DE"),
+//	    openmeteo.WithResultCount(5),
+//	)
+func (c *Client) SearchLocations(ctx context.Context, name string, opts ...RequestOption) ([]Location, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if strings.TrimSpace(name) == "" {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "location name must not be empty",
+			RequestID: requestID,
+		}
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+
+	u, err := c.endpointURL(c.endpoints.Geocoding, "/search")
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+	q := u.Query()
+	q.Set("name", name)
+	q.Set("format", "json")
+	if cfg.language != "" {
+		q.Set("language", cfg.language)
+	}
+	if cfg.count > 0 {
+		q.Set("count", strconv.Itoa(cfg.count))
+	}
+	if cfg.countryCode != "" {
+		q.Set("countryCode", cfg.countryCode)
+	}
+	if c.apiKey != "" {
+		q.Set("apikey", c.apiKey)
+	}
+	u.RawQuery = q.Encode()
+
+	var apiResp geocodingResponse
+	if _, err := c.doRequest(ctx, requestID, u.String(), cfg, &apiResp); err != nil {
+		return nil, err
+	}
+
+	locations := make([]Location, len(apiResp.Results))
+	for i, r := range apiResp.Results {
+		locations[i] = Location{
+			ID:          r.ID,
+			Name:        r.Name,
+			Latitude:    r.Latitude,
+			Longitude:   r.Longitude,
+			Elevation:   r.Elevation,
+			FeatureCode: r.FeatureCode,
+			CountryCode: r.CountryCode,
+			Country:     r.Country,
+			Timezone:    r.Timezone,
+			Population:  r.Population,
+			Postcodes:   r.Postcodes,
+			Admin1:      r.Admin1,
+			Admin2:      r.Admin2,
+			Admin3:      r.Admin3,
+			Admin4:      r.Admin4,
+		}
+	}
+	return locations, nil
+}
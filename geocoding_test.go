@@ -0,0 +1,128 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestSearchLocations_Success tests geocoding with language, count and country filters
+func TestSearchLocations_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search" {
+			t.Errorf("Expected path /search, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("name") != "Munich" {
+			t.Errorf("Expected name=Munich, got %s", q.Get("name"))
+		}
+		if q.Get("language") != "de" {
+			t.Errorf("Expected language=de, got %s", q.Get("language"))
+		}
+		if q.Get("count") != "5" {
+			t.Errorf("Expected count=5, got %s", q.Get("count"))
+		}
+		if q.Get("countryCode") != "DE" {
+			t.Errorf("Expected countryCode=DE, got %s", q.Get("countryCode"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"results": [{
+				"id": 2867714,
+				"name": "München",
+				"latitude": 48.13743,
+				"longitude": 11.57549,
+				"elevation": 524.0,
+				"feature_code": "PPLA",
+				"country_code": "DE",
+				"country": "Deutschland",
+				"timezone": "Europe/Berlin",
+				"population": 1260391,
+				"postcodes": ["80331", "80333"],
+				"admin1": "Bayern",
+				"admin2": "Oberbayern"
+			}],
+			"generationtime_ms": 0.5
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithGeocodingBaseURL(server.URL))
+	locations, err := client.SearchLocations(context.Background(), "Munich",
+		WithLanguage("DE"), WithResultCount(5), WithCountryCode("de"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(locations) != 1 {
+		t.Fatalf("Expected 1 location, got %d", len(locations))
+	}
+	loc := locations[0]
+	if loc.Name != "München" || loc.Country != "Deutschland" || loc.Admin1 != "Bayern" {
+		t.Errorf("Expected translated names, got %q, %q, %q", loc.Name, loc.Country, loc.Admin1)
+	}
+	if loc.ID != 2867714 || loc.CountryCode != "DE" || loc.Timezone != "Europe/Berlin" {
+		t.Errorf("Unexpected location metadata %+v", loc)
+	}
+	if loc.Latitude != 48.13743 || loc.Longitude != 11.57549 {
+		t.Errorf("Unexpected coordinates %.5f, %.5f", loc.Latitude, loc.Longitude)
+	}
+	if len(loc.Postcodes) != 2 || loc.Population != 1260391 {
+		t.Errorf("Unexpected postcodes/population %v %d", loc.Postcodes, loc.Population)
+	}
+}
+
+// TestSearchLocations_NoResults tests that a response without results yields an empty slice
+func TestSearchLocations_NoResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("language") || r.URL.Query().Has("count") || r.URL.Query().Has("countryCode") {
+			t.Errorf("Expected no optional parameters, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"generationtime_ms": 0.3}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithGeocodingBaseURL(server.URL))
+	locations, err := client.SearchLocations(context.Background(), "Nowhereville")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(locations) != 0 {
+		t.Errorf("Expected no locations, got %d", len(locations))
+	}
+}
+
+// TestSearchLocations_ValidationErrors tests input validation
+func TestSearchLocations_ValidationErrors(t *testing.T) {
+	client := NewClient()
+
+	testCases := []struct {
+		name  string
+		query string
+		opts  []RequestOption
+	}{
+		{"Empty name", "  ", nil},
+		{"Count too small", "Berlin", []RequestOption{WithResultCount(0)}},
+		{"Count too large", "Berlin", []RequestOption{WithResultCount(101)}},
+		{"Invalid country code", "Berlin", []RequestOption{WithCountryCode("DEU")}},
+		{"Non-letter country code", "Berlin", []RequestOption{WithCountryCode("D1")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.SearchLocations(context.Background(), tc.query, tc.opts...)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected *Error, got %T", err)
+			}
+			if apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected ErrorTypeValidation, got %v", apiErr.Type)
+			}
+		})
+	}
+}
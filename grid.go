@@ -0,0 +1,137 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// maxGridPoints is the largest number of grid points fetched by GetCurrentWeatherGrid.
+const maxGridPoints = 1000
+
+// BoundingBox is a geographic rectangle in degrees. Boxes crossing the antimeridian
+// are not supported.
+type BoundingBox struct {
+	// South is the southern edge latitude (-90 to 90)
+	South float64
+
+	// West is the western edge longitude (-180 to 180)
+	West float64
+
+	// North is the northern edge latitude (-90 to 90, not below South)
+	North float64
+
+	// East is the eastern edge longitude (-180 to 180, not below West)
+	East float64
+}
+
+// WeatherGrid holds the current weather on a regular grid returned by GetCurrentWeatherGrid.
+type WeatherGrid struct {
+	// Latitudes are the grid rows from south to north
+	Latitudes []float64
+
+	// Longitudes are the grid columns from west to east
+	Longitudes []float64
+
+	// Cells holds one result per grid point, indexed as Cells[row][column]
+	Cells [][]CurrentWeatherResult
+}
+
+// Field extracts one value per grid point as a matrix indexed like Cells, e.g., for a heat
+// map. Points whose request failed are NaN.
+//
+// Example:
+//
+//	temps := grid.Field(func(w *openmeteo.CurrentWeather) float64 { return w.Temperature })
+func (g *WeatherGrid) Field(value func(*CurrentWeather) float64) [][]float64 {
+	out := make([][]float64, len(g.Cells))
+	for i, row := range g.Cells {
+		out[i] = make([]float64, len(row))
+		for j, cell := range row {
+			if cell.Err != nil || cell.Weather == nil {
+				out[i][j] = math.NaN()
+				continue
+			}
+			out[i][j] = value(cell.Weather)
+		}
+	}
+	return out
+}
+
+// GetCurrentWeatherGrid samples the current weather on a regular grid covering box, with
+// step degrees between neighbouring points in both directions, starting at the south-west
+// corner. All points are fetched with GetCurrentWeatherMany, so the request options and the
+// client's concurrency limit apply and each point carries its own error.
+//
+// An invalid box, a step that is not positive or more than 1000 grid points fail with an
+// ErrorTypeValidation error before any request is sent.
+//
+// Example:
+//
+//	grid, err := client.GetCurrentWeatherGrid(ctx, openmeteo.BoundingBox{
+//	    South: 47.3, West: 5.9, North: 55.1, East: 15.0,
+//	}, 0.5)
+//	if err != nil {
+//	    return err
+//	}
+//	temps := grid.Field(func(w *openmeteo.CurrentWeather) float64 { return w.Temperature })
+func (c *Client) GetCurrentWeatherGrid(ctx context.Context, box BoundingBox, step float64, opts ...RequestOption) (*WeatherGrid, error) {
+	requestID := requestIDFor(ctx)
+	ctx = WithRequestID(ctx, requestID)
+
+	invalid := func(format string, args ...any) error {
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf(format, args...),
+			RequestID: requestID,
+		}
+	}
+	if err := validateCoordinates(box.South, box.West, requestID); err != nil {
+		return nil, err
+	}
+	if err := validateCoordinates(box.North, box.East, requestID); err != nil {
+		return nil, err
+	}
+	if box.North < box.South || box.East < box.West {
+		return nil, invalid("invalid bounding box: north must not be below south and east must not be below west")
+	}
+	if !(step > 0) || math.IsInf(step, 0) {
+		return nil, invalid("invalid grid step: %v (must be positive)", step)
+	}
+
+	grid := &WeatherGrid{
+		Latitudes:  gridAxis(box.South, box.North, step),
+		Longitudes: gridAxis(box.West, box.East, step),
+	}
+	if n := len(grid.Latitudes) * len(grid.Longitudes); n > maxGridPoints {
+		return nil, invalid("grid has %d points (at most %d); use a larger step or a smaller box", n, maxGridPoints)
+	}
+
+	coords := make([]Coordinates, 0, len(grid.Latitudes)*len(grid.Longitudes))
+	for _, lat := range grid.Latitudes {
+		for _, lon := range grid.Longitudes {
+			coords = append(coords, Coordinates{Latitude: lat, Longitude: lon})
+		}
+	}
+	results := c.GetCurrentWeatherMany(ctx, coords, opts...)
+
+	grid.Cells = make([][]CurrentWeatherResult, len(grid.Latitudes))
+	for i := range grid.Cells {
+		grid.Cells[i] = results[i*len(grid.Longitudes) : (i+1)*len(grid.Longitudes)]
+	}
+	return grid, nil
+}
+
+// gridAxis returns the points from lo to hi (inclusive when hi is on the grid) spaced by step.
+// Points are rounded to 6 decimals to avoid floating-point noise in request URLs.
+func gridAxis(lo, hi, step float64) []float64 {
+	n := int(math.Floor((hi-lo)/step+1e-9)) + 1
+	if n > maxGridPoints+1 {
+		n = maxGridPoints + 1 // large enough to fail the point limit without allocating more
+	}
+	axis := make([]float64, n)
+	for i := range axis {
+		axis[i] = math.Round((lo+float64(i)*step)*1e6) / 1e6
+	}
+	return axis
+}
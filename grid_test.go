@@ -0,0 +1,75 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetCurrentWeatherGrid tests grid generation and the row/column layout of results
+func TestGetCurrentWeatherGrid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("latitude") == "52.5" && q.Get("longitude") == "13.5" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"latitude": %s, "longitude": %s, "current": {"time": "2025-12-29T10:00", "temperature_2m": %s}}`,
+			q.Get("latitude"), q.Get("longitude"), q.Get("latitude"))
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	grid, err := client.GetCurrentWeatherGrid(context.Background(), BoundingBox{South: 52, West: 13, North: 52.5, East: 14}, 0.5)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fmt.Sprint(grid.Latitudes) != "[52 52.5]" || fmt.Sprint(grid.Longitudes) != "[13 13.5 14]" {
+		t.Fatalf("Unexpected axes %v and %v", grid.Latitudes, grid.Longitudes)
+	}
+	if len(grid.Cells) != 2 || len(grid.Cells[1]) != 3 {
+		t.Fatalf("Expected 2x3 cells, got %d rows", len(grid.Cells))
+	}
+	if c := grid.Cells[1][2].Coordinates; c.Latitude != 52.5 || c.Longitude != 14 {
+		t.Errorf("Expected north-east cell at 52.5,14, got %v", c)
+	}
+
+	temps := grid.Field(func(w *CurrentWeather) float64 { return w.Temperature })
+	if temps[0][0] != 52 || temps[1][0] != 52.5 || !math.IsNaN(temps[1][1]) {
+		t.Errorf("Unexpected field %v", temps)
+	}
+	if grid.Cells[1][1].Err == nil {
+		t.Error("Expected the failing point to carry its error")
+	}
+}
+
+// TestGetCurrentWeatherGrid_Validation tests invalid boxes, steps and grid sizes
+func TestGetCurrentWeatherGrid_Validation(t *testing.T) {
+	client := NewClient(WithBaseURL("http://127.0.0.1:0"))
+
+	testCases := []struct {
+		name string
+		box  BoundingBox
+		step float64
+	}{
+		{"invalid latitude", BoundingBox{South: -91, West: 0, North: 0, East: 1}, 0.5},
+		{"inverted box", BoundingBox{South: 53, West: 13, North: 52, East: 14}, 0.5},
+		{"antimeridian", BoundingBox{South: 0, West: 170, North: 1, East: -170}, 0.5},
+		{"zero step", BoundingBox{South: 52, West: 13, North: 53, East: 14}, 0},
+		{"too many points", BoundingBox{South: -90, West: -180, North: 90, East: 180}, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.GetCurrentWeatherGrid(context.Background(), tc.box, tc.step)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package openmeteo
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// defaultHistoricalForecastBaseURL is the base URL of the Open Meteo historical forecast API
+const defaultHistoricalForecastBaseURL = "https://historical-forecast-api.open-meteo.com/v1"
+
+// HistoricalWeather holds the hourly and daily data returned by GetHistoricalWeather.
+type HistoricalWeather struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds
+	UTCOffsetSeconds int
+
+	// Hourly holds the requested hourly variables (empty unless requested)
+	Hourly Series
+
+	// Daily holds the requested daily variables, one step per local day (empty unless requested)
+	Daily Series
+}
+
+// dailyVariableSuffixes mark the daily aggregations of the Open Meteo APIs.
+var dailyVariableSuffixes = []string{"_max", "_min", "_mean", "_sum", "_dominant"}
+
+// dailyOnlyVariables are the daily variables without an aggregation suffix or hourly counterpart.
+var dailyOnlyVariables = map[Variable]bool{
+	DailySunrise:            true,
+	DailySunset:             true,
+	DailyDaylightDuration:   true,
+	DailyPrecipitationHours: true,
+}
+
+// isDailyVariable reports whether v names a daily variable. Names shared by both blocks
+// (e.g., weather_code) are treated as hourly.
+func isDailyVariable(v Variable) bool {
+	if dailyOnlyVariables[v] {
+		return true
+	}
+	for _, suffix := range dailyVariableSuffixes {
+		if strings.HasSuffix(string(v), suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetHistoricalWeather fetches hourly and daily data from the historical weather API
+// (archive-api.open-meteo.com, see WithArchiveBaseURL) for the calendar days from start to
+// end (inclusive) in a single request. Variables are sorted into the hourly and daily blocks
+// by name: daily aggregations (names ending in _max, _min, _mean, _sum or _dominant, as well
+// as daylight_duration and precipitation_hours) go to Daily, everything else to Hourly.
+// Data is returned in the time zone of the coordinates (as with WithTimezone("auto")), so
+// daily values cover local days. Names shared by both blocks, such as weather_code, are
+// requested hourly; use DownloadHistoricalDaily for their daily values, and
+// DownloadHistoricalHourly for multi-year ranges. Request options such as WithTimezone or
+// WithCellSelection apply to this call only; the period is always taken from start and end.
+//
+// Example:
+//
+//	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+//	history, err := client.GetHistoricalWeather(ctx, 52.52, 13.41, start, start.AddDate(0, 0, 6),
+//	    []openmeteo.Variable{openmeteo.HourlyTemperature2m, openmeteo.DailyPrecipitationSum})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(history.Daily.Get(openmeteo.DailyPrecipitationSum))
+func (c *Client) GetHistoricalWeather(ctx context.Context, latitude, longitude float64, start, end time.Time, vars []Variable, opts ...RequestOption) (*HistoricalWeather, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(append([]RequestOption{WithTimezone("auto")}, opts...))
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+
+	if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	if len(vars) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one variable is required",
+			RequestID: requestID,
+		}
+	}
+	if err := validateDateRange(start, end); err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   err.Error(),
+			RequestID: requestID,
+		}
+	}
+	cfg.startDate, cfg.endDate = start, end
+	cfg.startHour, cfg.endHour = time.Time{}, time.Time{}
+	if err := c.checkDateLimits(cfg, ServiceArchive, requestID); err != nil {
+		return nil, err
+	}
+
+	var hourly, daily []Variable
+	for _, v := range vars {
+		if isDailyVariable(v) {
+			daily = append(daily, v)
+		} else {
+			hourly = append(hourly, v)
+		}
+	}
+	q := url.Values{}
+	if len(hourly) > 0 {
+		q.Set("hourly", joinVariables(hourly))
+	}
+	if len(daily) > 0 {
+		q.Set("daily", joinVariables(daily))
+	}
+	if err := checkCompatibility(q, cfg, latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+
+	reqURL, err := c.buildServiceURL(c.endpoints.Archive, "/archive", latitude, longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp forecastResponse
+	if _, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp); err != nil {
+		return nil, err
+	}
+	forecast, _, err := c.convertToForecast(apiResp, false, c.unitsFor(cfg), requestID)
+	if err != nil {
+		return nil, err
+	}
+	return &HistoricalWeather{
+		Latitude:         forecast.Latitude,
+		Longitude:        forecast.Longitude,
+		Location:         forecast.Location,
+		Timezone:         forecast.Timezone,
+		UTCOffsetSeconds: forecast.UTCOffsetSeconds,
+		Hourly:           forecast.Hourly,
+		Daily:            forecast.Daily,
+	}, nil
+}
+
+// GetHistoricalForecast fetches what the weather models forecasted in the past, as archived by
+// the historical forecast API (historical-forecast-api.open-meteo.com, see
+// WithHistoricalForecastBaseURL), for the calendar days from start to end (inclusive). Unlike
+// the reanalysis of GetHistoricalWeather, the data is the continuous series of the models'
+// short-range forecasts, which makes it suitable for evaluating forecast skill against
+// observations. Data is available from 2016, depending on the model.
+//
+// The hourly and daily blocks are selected in req as for GetForecast; current conditions
+// cannot be requested. Date and hour ranges set through opts are replaced by start and end;
+// other request options (e.g., WithTimezone, required for daily data, or WithModels to
+// evaluate a particular model) apply as usual.
+//
+// Example:
+//
+//	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+//	past, err := client.GetHistoricalForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude:  52.52,
+//	    Longitude: 13.41,
+//	    Hourly:    []openmeteo.Variable{openmeteo.HourlyTemperature2m},
+//	}, start, start.AddDate(0, 0, 6))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(past.Hourly.Get(openmeteo.HourlyTemperature2m))
+func (c *Client) GetHistoricalForecast(ctx context.Context, req ForecastRequest, start, end time.Time, opts ...RequestOption) (*Forecast, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(req.Latitude, req.Longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if req.Current {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "current conditions are not available from the historical forecast API",
+			RequestID: requestID,
+		}
+	}
+	if len(req.Hourly) == 0 && len(req.Daily) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one of hourly or daily data must be requested",
+			RequestID: requestID,
+		}
+	}
+	if err := validateDateRange(start, end); err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   err.Error(),
+			RequestID: requestID,
+		}
+	}
+	cfg.startDate, cfg.endDate = start, end
+	cfg.startHour, cfg.endHour = time.Time{}, time.Time{}
+	if err := c.checkDateLimits(cfg, ServiceHistoricalForecast, requestID); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	if len(req.Hourly) > 0 {
+		q.Set("hourly", joinVariables(req.Hourly))
+	}
+	if len(req.Daily) > 0 {
+		q.Set("daily", joinVariables(req.Daily))
+	}
+	if err := checkCompatibility(q, cfg, req.Latitude, req.Longitude, requestID); err != nil {
+		return nil, err
+	}
+
+	if len(cfg.models) > 0 {
+		q.Set("models", joinModels(cfg.models))
+	}
+	reqURL, err := c.buildServiceURL(c.endpoints.HistoricalForecast, "/forecast", req.Latitude, req.Longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp forecastResponse
+	meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+	forecast, _, err := c.convertToForecast(apiResp, false, c.unitsFor(cfg), requestID)
+	if err != nil {
+		return nil, err
+	}
+	forecast.Stale, forecast.Age = meta.stale, meta.age
+	return forecast, nil
+}
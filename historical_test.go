@@ -0,0 +1,159 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestGetHistoricalWeather tests the archive request and the hourly and daily blocks
+func TestGetHistoricalWeather(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/archive" {
+			t.Errorf("Expected /archive path, got %s", r.URL.Path)
+		}
+		if q.Get("hourly") != "temperature_2m,weather_code" || q.Get("daily") != "precipitation_sum,daylight_duration" {
+			t.Errorf("Unexpected blocks hourly=%q daily=%q", q.Get("hourly"), q.Get("daily"))
+		}
+		if q.Get("start_date") != "2024-07-01" || q.Get("end_date") != "2024-07-02" || q.Get("timezone") != "auto" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"latitude": 52.5, "longitude": 13.4, "timezone": "Europe/Berlin", "utc_offset_seconds": 7200,
+			"hourly_units": {"temperature_2m": "°C", "weather_code": "wmo code"},
+			"hourly": {"time": ["2024-07-01T00:00", "2024-07-01T01:00"], "temperature_2m": [18.5, 17.9], "weather_code": [0, 1]},
+			"daily_units": {"precipitation_sum": "mm"},
+			"daily": {"time": ["2024-07-01", "2024-07-02"], "precipitation_sum": [0, 4.2], "daylight_duration": [59000, 58950]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithArchiveBaseURL(server.URL))
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	history, err := client.GetHistoricalWeather(context.Background(), 52.52, 13.41, start, start.AddDate(0, 0, 1),
+		[]Variable{HourlyTemperature2m, DailyPrecipitationSum, HourlyWeatherCode, DailyDaylightDuration})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if history.Hourly.Len() != 2 || history.Hourly.Get(HourlyTemperature2m)[0] != 18.5 {
+		t.Errorf("Unexpected hourly data %+v", history.Hourly)
+	}
+	if history.Daily.Len() != 2 || history.Daily.Get(DailyPrecipitationSum)[1] != 4.2 {
+		t.Errorf("Unexpected daily data %+v", history.Daily)
+	}
+	if history.Location.String() != "Europe/Berlin" || history.Timezone != "Europe/Berlin" || history.UTCOffsetSeconds != 7200 {
+		t.Errorf("Unexpected location %v", history.Location)
+	}
+	if !history.Hourly.Time[0].Equal(time.Date(2024, 6, 30, 22, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected local timestamps, got %v", history.Hourly.Time[0])
+	}
+}
+
+// TestGetHistoricalWeather_Validation tests that invalid requests fail before any HTTP call
+func TestGetHistoricalWeather_Validation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client := NewClient(WithArchiveBaseURL(server.URL))
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		lat        float64
+		start, end time.Time
+		vars       []Variable
+	}{
+		{"no variables", 52.52, start, start, nil},
+		{"invalid latitude", 91, start, start, []Variable{HourlyTemperature2m}},
+		{"reversed range", 52.52, start, start.AddDate(0, 0, -1), []Variable{HourlyTemperature2m}},
+		{"future range", 52.52, start, time.Now().AddDate(0, 0, 3), []Variable{HourlyTemperature2m}},
+	}
+	if _, err := client.GetHistoricalWeather(context.Background(), 52.52, 13.41, start, start,
+		[]Variable{HourlyTemperature2m}, WithCellSelection("ocean")); err == nil {
+		t.Error("Expected validation error for an invalid request option")
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetHistoricalWeather(context.Background(), tt.lat, 13.41, tt.start, tt.end, tt.vars)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+	if called {
+		t.Error("Expected no HTTP request for invalid input")
+	}
+}
+
+// TestIsDailyVariable tests the classification of variables into hourly and daily blocks
+func TestIsDailyVariable(t *testing.T) {
+	for _, v := range []Variable{DailyTemperature2mMax, DailyPrecipitationSum, DailyWindDirection10mDominant, DailyDaylightDuration, "temperature_2m_mean"} {
+		if !isDailyVariable(v) {
+			t.Errorf("Expected %s to be daily", v)
+		}
+	}
+	for _, v := range []Variable{HourlyTemperature2m, HourlyWeatherCode, HourlyPrecipitation, HourlyUVIndex} {
+		if isDailyVariable(v) {
+			t.Errorf("Expected %s to be hourly", v)
+		}
+	}
+}
+
+// TestGetHistoricalForecast tests the historical forecast request and validation
+func TestGetHistoricalForecast(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if r.URL.Path != "/forecast" || q.Get("hourly") != "temperature_2m" || q.Get("daily") != "" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		if q.Get("start_date") != "2023-03-01" || q.Get("end_date") != "2023-03-01" || q.Has("start_hour") {
+			t.Errorf("Unexpected range in %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"latitude": 52.5, "longitude": 13.4, "hourly_units": {"temperature_2m": "°C"},
+			"hourly": {"time": ["2023-03-01T00:00", "2023-03-01T01:00"], "temperature_2m": [2.1, 1.8]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithHistoricalForecastBaseURL(server.URL), WithBaseURL("http://forecast.invalid"))
+	day := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	req := ForecastRequest{Latitude: 52.52, Longitude: 13.41, Hourly: []Variable{HourlyTemperature2m}}
+	forecast, err := client.GetHistoricalForecast(context.Background(), req, day, day,
+		WithHourRange(day.AddDate(0, 0, 1), day.AddDate(0, 0, 2)))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if forecast.Hourly.Len() != 2 || forecast.Hourly.Get(HourlyTemperature2m)[1] != 1.8 || forecast.Current != nil {
+		t.Errorf("Unexpected forecast %+v", forecast)
+	}
+
+	tests := []struct {
+		name       string
+		req        ForecastRequest
+		start, end time.Time
+	}{
+		{"current", ForecastRequest{Latitude: 52.52, Longitude: 13.41, Current: true}, day, day},
+		{"no blocks", ForecastRequest{Latitude: 52.52, Longitude: 13.41}, day, day},
+		{"before 2016", req, time.Date(2015, 12, 31, 0, 0, 0, 0, time.UTC), day},
+		{"daily without timezone", ForecastRequest{Latitude: 52.52, Longitude: 13.41, Daily: []Variable{DailyTemperature2mMax}}, day, day},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetHistoricalForecast(context.Background(), tt.req, tt.start, tt.end)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
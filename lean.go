@@ -0,0 +1,28 @@
+//go:build openmeteo_lean
+
+package openmeteo
+
+import (
+	"net/http"
+	"time"
+)
+
+// Lean builds (-tags openmeteo_lean) drop request statistics, debug dumps, the model
+// catalog, JSON Schema generation, spreadsheet and NetCDF export, color scales, the
+// terminal banner and the activity helpers (ski, pollen, road). The stubs below keep the
+// request path compiling without them.
+
+// statsRecorder is a no-op in lean builds.
+type statsRecorder struct{}
+
+func (s *statsRecorder) record(string, time.Duration, bool) {}
+
+// endpointName is a no-op in lean builds.
+func endpointName(string) string { return "" }
+
+// debugDumper is never set in lean builds because WithDebug is not available.
+type debugDumper struct{}
+
+func (d *debugDumper) dumpRequest(*http.Request)                                 {}
+func (d *debugDumper) dumpResponse(*http.Request, *http.Response, time.Duration) {}
+func (d *debugDumper) dumpError(*http.Request, error, time.Duration)             {}
@@ -0,0 +1,111 @@
+package openmeteo
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PressureLevel is an atmospheric pressure level in hPa. Upper-air variables are available
+// at the levels below; lower pressure means higher altitude.
+type PressureLevel int
+
+// Pressure levels served by the forecast API, with their approximate altitude.
+const (
+	Level1000 PressureLevel = 1000 // 110 m
+	Level975  PressureLevel = 975  // 320 m
+	Level950  PressureLevel = 950  // 500 m
+	Level925  PressureLevel = 925  // 800 m
+	Level900  PressureLevel = 900  // 1000 m
+	Level850  PressureLevel = 850  // 1500 m
+	Level800  PressureLevel = 800  // 1900 m
+	Level700  PressureLevel = 700  // 3000 m
+	Level600  PressureLevel = 600  // 4200 m
+	Level500  PressureLevel = 500  // 5600 m
+	Level400  PressureLevel = 400  // 7200 m
+	Level300  PressureLevel = 300  // 9200 m
+	Level250  PressureLevel = 250  // 10400 m
+	Level200  PressureLevel = 200  // 11800 m
+	Level150  PressureLevel = 150  // 13500 m
+	Level100  PressureLevel = 100  // 15800 m
+	Level70   PressureLevel = 70   // 17700 m
+	Level50   PressureLevel = 50   // 19300 m
+	Level30   PressureLevel = 30   // 22000 m
+)
+
+// LevelVariable is an upper-air variable available on pressure levels (e.g., "temperature").
+// Combine it with a level to request it (see At and PressureLevelVariables).
+type LevelVariable string
+
+// Upper-air variables available on every pressure level.
+const (
+	LevelTemperature        LevelVariable = "temperature"
+	LevelRelativeHumidity   LevelVariable = "relative_humidity"
+	LevelDewPoint           LevelVariable = "dew_point"
+	LevelCloudCover         LevelVariable = "cloud_cover"
+	LevelWindSpeed          LevelVariable = "wind_speed"
+	LevelWindDirection      LevelVariable = "wind_direction"
+	LevelGeopotentialHeight LevelVariable = "geopotential_height"
+	LevelVerticalVelocity   LevelVariable = "vertical_velocity"
+)
+
+// At returns the hourly variable of v at the given pressure level (e.g., "temperature_850hPa").
+func (v LevelVariable) At(level PressureLevel) Variable {
+	return Variable(fmt.Sprintf("%s_%dhPa", v, level))
+}
+
+// PressureLevelVariables returns the hourly variables of every combination of vars and levels,
+// for use with GetHourlyForecast.
+//
+// Example:
+//
+//	vars := openmeteo.PressureLevelVariables(
+//	    []openmeteo.LevelVariable{openmeteo.LevelTemperature, openmeteo.LevelWindSpeed},
+//	    []openmeteo.PressureLevel{openmeteo.Level850, openmeteo.Level700, openmeteo.Level500},
+//	)
+//	forecast, err := client.GetHourlyForecast(ctx, 47.37, 8.54, vars)
+func PressureLevelVariables(vars []LevelVariable, levels []PressureLevel) []Variable {
+	result := make([]Variable, 0, len(vars)*len(levels))
+	for _, v := range vars {
+		for _, level := range levels {
+			result = append(result, v.At(level))
+		}
+	}
+	return result
+}
+
+// Levels returns the pressure levels at which the series holds variable v, from the
+// highest pressure (closest to the ground) to the lowest.
+//
+// Example:
+//
+//	for _, level := range forecast.Hourly.Levels(openmeteo.LevelTemperature) {
+//	    fmt.Printf("%d hPa: %.1f°C\n", level, forecast.Hourly.AtLevel(openmeteo.LevelTemperature, level)[0])
+//	}
+func (s *Series) Levels(v LevelVariable) []PressureLevel {
+	prefix := string(v) + "_"
+	var levels []PressureLevel
+	for name := range s.Values {
+		rest, ok := strings.CutPrefix(string(name), prefix)
+		if !ok {
+			continue
+		}
+		digits, ok := strings.CutSuffix(rest, "hPa")
+		if !ok {
+			continue
+		}
+		if hPa, err := strconv.Atoi(digits); err == nil {
+			levels = append(levels, PressureLevel(hPa))
+		}
+	}
+	slices.Sort(levels)
+	slices.Reverse(levels)
+	return levels
+}
+
+// AtLevel returns the values of variable v at the given pressure level, or nil if they were
+// not returned.
+func (s *Series) AtLevel(v LevelVariable, level PressureLevel) []float64 {
+	return s.Get(v.At(level))
+}
@@ -0,0 +1,52 @@
+package openmeteo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+// TestPressureLevelVariables tests building upper-air variable names
+func TestPressureLevelVariables(t *testing.T) {
+	got := PressureLevelVariables([]LevelVariable{LevelTemperature, LevelGeopotentialHeight}, []PressureLevel{Level850, Level500})
+	want := []Variable{"temperature_850hPa", "temperature_500hPa", "geopotential_height_850hPa", "geopotential_height_500hPa"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if LevelWindSpeed.At(Level30) != "wind_speed_30hPa" {
+		t.Errorf("Unexpected name %s", LevelWindSpeed.At(Level30))
+	}
+}
+
+// TestSeries_Levels tests iterating the pressure levels of a forecast
+func TestSeries_Levels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("hourly"); got != "temperature_500hPa,temperature_850hPa,temperature_700hPa" {
+			t.Errorf("Unexpected hourly parameter %q", got)
+		}
+		_, _ = w.Write([]byte(`{"latitude": 47.4, "longitude": 8.5, "hourly": {"time": ["2025-12-29T12:00"],
+			"temperature_500hPa": [-28.5], "temperature_850hPa": [-2.1], "temperature_700hPa": [-11.0],
+			"temperature_2m": [3.0], "temperature_abchPa": [0]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	f, err := client.GetHourlyForecast(context.Background(), 47.37, 8.54,
+		PressureLevelVariables([]LevelVariable{LevelTemperature}, []PressureLevel{Level500, Level850, Level700}))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	levels := f.Hourly.Levels(LevelTemperature)
+	if !slices.Equal(levels, []PressureLevel{Level850, Level700, Level500}) {
+		t.Errorf("Expected levels from the ground up, got %v", levels)
+	}
+	if got := f.Hourly.AtLevel(LevelTemperature, Level700); len(got) != 1 || got[0] != -11 {
+		t.Errorf("Unexpected 700 hPa values %v", got)
+	}
+	if f.Hourly.Levels(LevelWindSpeed) != nil || f.Hourly.AtLevel(LevelWindSpeed, Level850) != nil {
+		t.Error("Expected no wind levels")
+	}
+}
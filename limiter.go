@@ -0,0 +1,103 @@
+package openmeteo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter paces API calls. Wait blocks until a call may be sent, or returns an error if the
+// call should not be sent (for example ctx.Err() when the context is done).
+// Implementations must be safe for concurrent use; an implementation backed by a shared
+// store (e.g., Redis) lets several processes cooperate on the same API quota.
+// A *rate.Limiter from golang.org/x/time/rate satisfies Limiter as is.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// RateLimiter is an in-process token bucket Limiter: it allows perSecond calls per second on
+// average with bursts of up to burst calls. A single RateLimiter can be shared by several
+// clients (see WithSharedLimiter). It is safe for concurrent use.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a token bucket allowing perSecond calls per second with bursts of up to
+// burst calls (at least 1). The bucket starts full. A rate that is not positive (or NaN)
+// does not limit calls, like WithRateLimit.
+//
+// Example:
+//
+//	limiter := openmeteo.NewRateLimiter(5, 10)
+//	forecasts := openmeteo.NewClient(openmeteo.WithSharedLimiter(limiter))
+//	archive := openmeteo.NewClient(openmeteo.WithSharedLimiter(limiter), openmeteo.WithTimeout(time.Minute))
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	b := float64(max(burst, 1))
+	return &RateLimiter{rate: perSecond, burst: b, tokens: b, last: time.Now()}
+}
+
+// Wait blocks until a token is available or ctx is done, in which case it returns ctx.Err().
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if !(l.rate > 0) {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// WithSharedLimiter makes the client wait for l before every API call, so that several clients
+// in one process (or several processes, with a Limiter backed by a shared store) cooperate on
+// the same API quota. The wait happens before the client's concurrency limit is taken, so
+// waiting calls do not block other calls of the client.
+//
+// Example:
+//
+//	limiter := openmeteo.NewRateLimiter(10, 10)
+//	a := openmeteo.NewClient(openmeteo.WithSharedLimiter(limiter))
+//	b := openmeteo.NewClient(openmeteo.WithSharedLimiter(limiter), openmeteo.WithBaseURL(mirror))
+func WithSharedLimiter(l Limiter) Option {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// WithRateLimit throttles the client to perSecond calls per second on average, with bursts of
+// up to burst calls, using a RateLimiter of its own. Use it to stay within the fair-use limits
+// of the free API in batch jobs; use WithSharedLimiter instead to share one budget between
+// several clients. A rate that is not positive disables client-side throttling.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithRateLimit(5, 1))
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(c *Client) {
+		if !(perSecond > 0) {
+			c.limiter = nil
+			return
+		}
+		c.limiter = NewRateLimiter(perSecond, burst)
+	}
+}
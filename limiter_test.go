@@ -0,0 +1,119 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestRateLimiter tests bursts, pacing and cancellation
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(20, 2)
+	start := time.Now()
+	for range 4 {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("Expected 2 calls beyond the burst to take about 100ms, took %s", elapsed)
+	}
+
+	slow := NewRateLimiter(0.001, 0)
+	_ = slow.Wait(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := slow.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// TestRateLimiter_NonPositiveRate tests that a rate that is not positive does not limit calls
+func TestRateLimiter_NonPositiveRate(t *testing.T) {
+	for _, rate := range []float64{0, -5, math.NaN()} {
+		l := NewRateLimiter(rate, 1)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		for range 100 {
+			if err := l.Wait(ctx); err != nil {
+				t.Fatalf("Rate %v: expected no error, got %v", rate, err)
+			}
+		}
+		cancel()
+	}
+}
+
+// countingLimiter counts calls and optionally rejects them
+type countingLimiter struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (l *countingLimiter) Wait(context.Context) error {
+	l.calls.Add(1)
+	return l.err
+}
+
+// TestWithSharedLimiter tests that clients sharing a limiter all wait for it
+func TestWithSharedLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 15.3}}`)
+	}))
+	defer server.Close()
+
+	limiter := &countingLimiter{}
+	a := NewClient(WithBaseURL(server.URL), WithSharedLimiter(limiter))
+	b := NewClient(WithBaseURL(server.URL), WithSharedLimiter(limiter))
+	for _, c := range []*Client{a, b, a} {
+		if _, err := c.GetCurrentWeather(context.Background(), 52.52, 13.41); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if limiter.calls.Load() != 3 {
+		t.Errorf("Expected 3 limiter waits, got %d", limiter.calls.Load())
+	}
+
+	limiter.err = errors.New("quota exhausted")
+	_, err := a.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation || !errors.Is(err, limiter.err) {
+		t.Errorf("Expected validation error wrapping the limiter error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter.err = ctx.Err()
+	if _, err := a.GetCurrentWeather(ctx, 52.52, 13.41); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// TestWithRateLimit tests that a client throttles its own calls
+func TestWithRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 15.3}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithRateLimit(20, 1))
+	start := time.Now()
+	for range 3 {
+		if _, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("Expected 2 calls beyond the burst to take about 100ms, took %s", elapsed)
+	}
+
+	if client := NewClient(WithSharedLimiter(&countingLimiter{}), WithRateLimit(0, 1)); client.limiter != nil {
+		t.Errorf("Expected a zero rate to disable throttling, got %T", client.limiter)
+	}
+}
@@ -0,0 +1,106 @@
+package openmeteo
+
+import (
+	"fmt"
+	"time"
+)
+
+// serviceLimits describes the period of data a service can serve, relative to today.
+type serviceLimits struct {
+	// earliest is the first day with data (zero means limited by maxPastDays instead)
+	earliest time.Time
+
+	// latest is the last day with data for static datasets (zero means relative to today)
+	latest time.Time
+
+	// maxPastDays is how many days before today the service keeps (when earliest is zero)
+	maxPastDays int
+
+	// maxForecastDays is how many days after today the service forecasts (0 means up to today)
+	maxForecastDays int
+}
+
+// apiLimits lists the date limits of the Open Meteo services, following the API documentation.
+var apiLimits = map[Service]serviceLimits{
+	ServiceForecast:           {maxPastDays: 92, maxForecastDays: 16},
+	ServiceEnsemble:           {maxPastDays: 92, maxForecastDays: 35},
+	ServiceAirQuality:         {maxPastDays: 92, maxForecastDays: 7},
+	ServiceMarine:             {maxPastDays: 92, maxForecastDays: 16},
+	ServiceFlood:              {earliest: time.Date(1984, 1, 1, 0, 0, 0, 0, time.UTC), maxForecastDays: 210},
+	ServiceArchive:            {earliest: earliestDate},
+	ServiceHistoricalForecast: {earliest: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)},
+	ServiceClimate: {
+		earliest: time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC),
+		latest:   time.Date(2050, 12, 31, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+// bounds returns the first and last calendar day (midnight UTC) the service can serve,
+// given today's calendar date.
+func (l serviceLimits) bounds(today time.Time) (first, last time.Time) {
+	first = l.earliest
+	if first.IsZero() {
+		first = today.AddDate(0, 0, -l.maxPastDays)
+	}
+	last = l.latest
+	if last.IsZero() {
+		last = today.AddDate(0, 0, l.maxForecastDays)
+	}
+	return first, last
+}
+
+// checkDateLimits validates the date and hour ranges of cfg against the limits of service,
+// so that out-of-range requests fail with a clear ErrorTypeValidation error instead of
+// an API error. Today is taken in the requested timezone.
+func (r *requestConfig) checkDateLimits(service Service, now time.Time, requestID string) error {
+	limits, ok := apiLimits[service]
+	if !ok {
+		return nil
+	}
+	today := calendarDate(now.In(r.location()))
+	first, last := limits.bounds(today)
+
+	check := func(kind string, start, end time.Time) error {
+		if start.IsZero() {
+			return nil
+		}
+		s, e := calendarDate(start), calendarDate(end)
+		if s.Before(first) {
+			return &Error{
+				Type:      ErrorTypeValidation,
+				Message:   fmt.Sprintf("%s starts on %s, before the first day served by the %s API (%s)", kind, formatDate(start), service, formatDate(first)),
+				RequestID: requestID,
+			}
+		}
+		if e.After(last) {
+			return &Error{
+				Type:      ErrorTypeValidation,
+				Message:   fmt.Sprintf("%s ends on %s, after the last day served by the %s API (%s)", kind, formatDate(end), service, formatDate(last)),
+				RequestID: requestID,
+			}
+		}
+		return nil
+	}
+
+	if limits.maxForecastDays > 0 && r.forecastDays > limits.maxForecastDays {
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf("%d forecast days requested, but the %s API forecasts at most %d days", r.forecastDays, service, limits.maxForecastDays),
+			RequestID: requestID,
+		}
+	}
+	if err := check("date range", r.startDate, r.endDate); err != nil {
+		return err
+	}
+	loc := r.location()
+	return check("hour range", r.startHour.In(loc), r.endHour.In(loc))
+}
+
+// checkDateLimits validates cfg against the date limits of the public API, unless the client
+// talks to a self-hosted instance, which keeps as much data as it was configured to.
+func (c *Client) checkDateLimits(cfg *requestConfig, service Service, requestID string) error {
+	if c.selfHosted {
+		return nil
+	}
+	return cfg.checkDateLimits(service, time.Now(), requestID)
+}
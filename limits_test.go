@@ -0,0 +1,78 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCheckDateLimits tests date ranges against the periods served by each API
+func TestCheckDateLimits(t *testing.T) {
+	now := time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return now.AddDate(0, 0, offset) }
+
+	tests := []struct {
+		name    string
+		service Service
+		opts    []RequestOption
+		wantErr string
+	}{
+		{"no range", ServiceForecast, nil, ""},
+		{"forecast within limits", ServiceForecast, []RequestOption{WithDateRange(day(-92), day(16))}, ""},
+		{"forecast too far ahead", ServiceForecast, []RequestOption{WithDateRange(day(0), day(17))}, "ends on 2026-01-15, after the last day served by the forecast API (2026-01-14)"},
+		{"forecast too far back", ServiceForecast, []RequestOption{WithDateRange(day(-93), day(0))}, "starts on 2025-09-27, before the first day served by the forecast API"},
+		{"ensemble horizon", ServiceEnsemble, []RequestOption{WithDateRange(day(0), day(35))}, ""},
+		{"ensemble beyond horizon", ServiceEnsemble, []RequestOption{WithDateRange(day(0), day(36))}, "ensemble API"},
+		{"archive in the future", ServiceArchive, []RequestOption{WithDateRange(day(-10), day(1))}, "archive API"},
+		{"archive from 1940", ServiceArchive, []RequestOption{WithDateRange(earliestDate, day(0))}, ""},
+		{"climate projection", ServiceClimate, []RequestOption{WithDateRange(day(0), time.Date(2051, 1, 1, 0, 0, 0, 0, time.UTC))}, "(2050-12-31)"},
+		{"hour range too far ahead", ServiceForecast, []RequestOption{WithHourRange(now, now.Add(17*24*time.Hour))}, "hour range ends on"},
+		{"forecast days", ServiceForecast, []RequestOption{WithForecastDays(16)}, ""},
+		{"air quality forecast days", ServiceAirQuality, []RequestOption{WithForecastDays(8)}, "air-quality API forecasts at most 7 days"},
+		{"unknown service", Service("custom"), []RequestOption{WithDateRange(day(0), day(1000))}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newRequestConfig(tt.opts)
+			err := cfg.checkDateLimits(tt.service, now, "id")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected validation error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestGetHourlyForecast_DateLimits tests that out-of-range requests fail before any HTTP call
+func TestGetHourlyForecast_DateLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request to be sent")
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL), WithArchiveBaseURL(server.URL))
+	now := time.Now()
+
+	_, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41, []Variable{HourlyTemperature2m},
+		WithDateRange(now, now.AddDate(0, 0, 30)))
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+
+	_, err = client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41, []Variable{HourlyTemperature2m},
+		now.AddDate(0, 0, -3), now.AddDate(0, 0, 3))
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error for future archive dates, got %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package openmeteo
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+// WithLogger makes the client log its activity to l: request start (debug), completion
+// (info) and failure (warn), retries (info), cache hits and misses (debug) and rate limiter
+// waits (debug). Records carry the request ID, the endpoint, the coordinates and, where
+// applicable, the duration as attributes. Request URLs are not logged, so API keys never
+// reach the logs. By default nothing is logged.
+//
+// Example:
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+//	client := openmeteo.NewClient(openmeteo.WithLogger(logger))
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// logRequest logs msg at level with the request attributes of reqURL and attrs, if a logger
+// is configured and enabled for level.
+func (c *Client) logRequest(ctx context.Context, level slog.Level, msg, requestID, reqURL string, attrs ...slog.Attr) {
+	if c.logger == nil || !c.logger.Enabled(ctx, level) {
+		return
+	}
+	base := []slog.Attr{slog.String("request_id", requestID)}
+	if u, err := url.Parse(reqURL); err == nil {
+		base = append(base, slog.String("endpoint", path.Base(u.Path)))
+		q := u.Query()
+		for _, name := range []string{"latitude", "longitude"} {
+			if v, err := strconv.ParseFloat(q.Get(name), 64); err == nil {
+				base = append(base, slog.Float64(name, v))
+			}
+		}
+	}
+	c.logger.LogAttrs(ctx, level, msg, append(base, attrs...)...)
+}
@@ -0,0 +1,75 @@
+package openmeteo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestWithLogger tests the logged events and their attributes
+func TestWithLogger(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 15.3}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithAPIKey("secret"),
+		WithLogger(logger),
+		WithRetry(RetryPolicy{InitialBackoff: time.Millisecond}),
+		WithCache(NewLRUCache(10), time.Minute),
+		WithRateLimit(100, 10),
+	)
+	ctx := WithRequestID(context.Background(), "req-1")
+	for range 2 {
+		if _, err := client.GetCurrentWeather(ctx, 52.52, 13.41); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Error("Expected the API key not to be logged")
+	}
+	var messages []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Expected JSON log records, got %q", line)
+		}
+		if record["request_id"] != "req-1" || record["endpoint"] != "forecast" || record["latitude"] != 52.52 || record["longitude"] != 13.41 {
+			t.Errorf("Expected request attributes, got %v", record)
+		}
+		messages = append(messages, record["msg"].(string))
+	}
+	want := []string{
+		"openmeteo: cache miss",
+		"openmeteo: rate limiter wait",
+		"openmeteo: request started",
+		"openmeteo: request failed",
+		"openmeteo: retrying request",
+		"openmeteo: rate limiter wait",
+		"openmeteo: request started",
+		"openmeteo: request completed",
+		"openmeteo: cache hit",
+	}
+	if strings.Join(messages, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected log messages %q, got %q", want, messages)
+	}
+}
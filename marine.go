@@ -0,0 +1,263 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+)
+
+// defaultMarineBaseURL is the base URL of the Open Meteo marine weather API
+const defaultMarineBaseURL = "https://marine-api.open-meteo.com/v1"
+
+// Variables of the marine weather API, available for the current and hourly blocks.
+// Heights are in meters, directions in degrees (where the waves come from) and periods in seconds.
+const (
+	MarineWaveHeight         Variable = "wave_height"
+	MarineWaveDirection      Variable = "wave_direction"
+	MarineWavePeriod         Variable = "wave_period"
+	MarineWindWaveHeight     Variable = "wind_wave_height"
+	MarineWindWaveDirection  Variable = "wind_wave_direction"
+	MarineWindWavePeriod     Variable = "wind_wave_period"
+	MarineSwellWaveHeight    Variable = "swell_wave_height"
+	MarineSwellWaveDirection Variable = "swell_wave_direction"
+	MarineSwellWavePeriod    Variable = "swell_wave_period"
+)
+
+// Daily variables of the marine weather API. Daily data requires a timezone (see WithTimezone).
+const (
+	DailyWaveHeightMax              Variable = "wave_height_max"
+	DailyWaveDirectionDominant      Variable = "wave_direction_dominant"
+	DailyWavePeriodMax              Variable = "wave_period_max"
+	DailyWindWaveHeightMax          Variable = "wind_wave_height_max"
+	DailyWindWaveDirectionDominant  Variable = "wind_wave_direction_dominant"
+	DailyWindWavePeriodMax          Variable = "wind_wave_period_max"
+	DailySwellWaveHeightMax         Variable = "swell_wave_height_max"
+	DailySwellWaveDirectionDominant Variable = "swell_wave_direction_dominant"
+	DailySwellWavePeriodMax         Variable = "swell_wave_period_max"
+)
+
+// MarineVariables lists the total, wind-wave and swell components of height, direction and period,
+// for use with the current and hourly blocks of GetMarineWeather.
+var MarineVariables = []Variable{
+	MarineWaveHeight, MarineWaveDirection, MarineWavePeriod,
+	MarineWindWaveHeight, MarineWindWaveDirection, MarineWindWavePeriod,
+	MarineSwellWaveHeight, MarineSwellWaveDirection, MarineSwellWavePeriod,
+}
+
+// MarineRequest selects the location and the blocks fetched by GetMarineWeather.
+// At least one block must be requested.
+type MarineRequest struct {
+	// Latitude in degrees (-90 to 90)
+	Latitude float64
+
+	// Longitude in degrees (-180 to 180)
+	Longitude float64
+
+	// Current lists the variables of the current conditions to request
+	Current []Variable
+
+	// Hourly lists the hourly variables to request
+	Hourly []Variable
+
+	// Daily lists the daily variables to request (requires WithTimezone)
+	Daily []Variable
+}
+
+// MarineConditions holds the current values of the requested marine variables.
+type MarineConditions struct {
+	// Time is the start of the interval the values describe, in UTC
+	Time time.Time
+
+	// Values maps each returned variable to its value (NaN when missing)
+	Values map[Variable]float64
+
+	// Units maps each returned variable to its unit as reported by the API (e.g., "m")
+	Units map[Variable]string
+}
+
+// Get returns the current value of variable v, or NaN if the variable was not returned.
+func (m *MarineConditions) Get(v Variable) float64 {
+	if value, ok := m.Values[v]; ok {
+		return value
+	}
+	return math.NaN()
+}
+
+// MarineWeather holds the blocks returned by GetMarineWeather.
+type MarineWeather struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Location is the time zone of the response (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds
+	UTCOffsetSeconds int
+
+	// Current holds the current conditions (nil unless requested)
+	Current *MarineConditions `json:",omitempty"`
+
+	// Hourly holds the requested hourly variables (empty unless requested)
+	Hourly Series
+
+	// Daily holds the requested daily variables, one step per local day (empty unless requested)
+	Daily Series
+
+	// Stale reports that the data was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since stale data was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// marineResponse is an internal structure for unmarshaling marine API responses.
+type marineResponse struct {
+	forecastResponse
+	Current      map[string]json.RawMessage `json:"current"`
+	CurrentUnits map[string]string          `json:"current_units"`
+}
+
+// GetMarineWeather fetches wave, wind-wave and swell data from the marine weather API
+// (marine-api.open-meteo.com, see WithMarineBaseURL). Only the blocks selected in req are
+// requested. Marine data is only available over the sea; on land the values are missing (NaN).
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - req: Coordinates and blocks to request
+//   - opts: Optional per-request settings (e.g., WithTimezone, required for daily data)
+//
+// Example:
+//
+//	marine, err := client.GetMarineWeather(ctx, openmeteo.MarineRequest{
+//	    Latitude:  54.32,
+//	    Longitude: 10.14,
+//	    Current:   []openmeteo.Variable{openmeteo.MarineWaveHeight, openmeteo.MarineSwellWavePeriod},
+//	    Hourly:    openmeteo.MarineVariables,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("waves %.1f m\n", marine.Current.Get(openmeteo.MarineWaveHeight))
+func (c *Client) GetMarineWeather(ctx context.Context, req MarineRequest, opts ...RequestOption) (*MarineWeather, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+
+	if err := validateCoordinates(req.Latitude, req.Longitude, requestID); err != nil {
+		return nil, err
+	}
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+	if err := c.checkDateLimits(cfg, ServiceMarine, requestID); err != nil {
+		return nil, err
+	}
+	if len(req.Current) == 0 && len(req.Hourly) == 0 && len(req.Daily) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one of current, hourly or daily data must be requested",
+			RequestID: requestID,
+		}
+	}
+
+	q := url.Values{}
+	if len(req.Current) > 0 {
+		q.Set("current", joinVariables(req.Current))
+	}
+	if len(req.Hourly) > 0 {
+		q.Set("hourly", joinVariables(req.Hourly))
+	}
+	if len(req.Daily) > 0 {
+		q.Set("daily", joinVariables(req.Daily))
+	}
+	if err := checkCompatibility(q, cfg, req.Latitude, req.Longitude, requestID); err != nil {
+		return nil, err
+	}
+	reqURL, err := c.buildServiceURL(c.endpoints.Marine, "/marine", req.Latitude, req.Longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp marineResponse
+	meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, _, err := c.convertToForecast(apiResp.forecastResponse, false, UnitsMetric, requestID)
+	if err != nil {
+		return nil, err
+	}
+	marine := &MarineWeather{
+		Latitude:         forecast.Latitude,
+		Longitude:        forecast.Longitude,
+		Location:         forecast.Location,
+		Timezone:         forecast.Timezone,
+		UTCOffsetSeconds: forecast.UTCOffsetSeconds,
+		Hourly:           forecast.Hourly,
+		Daily:            forecast.Daily,
+		Stale:            meta.stale,
+		Age:              meta.age,
+	}
+	if len(req.Current) > 0 {
+		marine.Current, err = parseMarineConditions(apiResp.Current, apiResp.CurrentUnits, forecast.Location)
+		if err != nil {
+			return nil, &Error{
+				Type:      ErrorTypeAPI,
+				Message:   "failed to parse current data",
+				Cause:     err,
+				RequestID: requestID,
+			}
+		}
+	}
+	return marine, nil
+}
+
+// parseMarineConditions converts the current block of a marine response. Null values become NaN.
+func parseMarineConditions(block map[string]json.RawMessage, units map[string]string, loc *time.Location) (*MarineConditions, error) {
+	m := &MarineConditions{
+		Values: make(map[Variable]float64),
+		Units:  make(map[Variable]string),
+	}
+	for key, raw := range block {
+		switch key {
+		case "time":
+			var ts apiTimestamp
+			if err := json.Unmarshal(raw, &ts); err != nil {
+				return nil, fmt.Errorf("invalid time: %w", err)
+			}
+			t, err := parseAPITime(string(ts), loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timestamp %q: %w", ts, err)
+			}
+			m.Time = t.UTC()
+		case "interval":
+		default:
+			var value *float64
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+			m.Values[Variable(key)] = math.NaN()
+			if value != nil {
+				m.Values[Variable(key)] = *value
+			}
+			if unit, ok := units[key]; ok {
+				m.Units[Variable(key)] = unit
+			}
+		}
+	}
+	return m, nil
+}
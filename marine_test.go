@@ -0,0 +1,87 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestGetMarineWeather tests the marine request with current, hourly and daily blocks
+func TestGetMarineWeather(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/marine" || q.Get("current") != "wave_height,swell_wave_period" ||
+			q.Get("hourly") != joinVariables(MarineVariables) || q.Get("daily") != "wave_height_max" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"latitude": 54.3, "longitude": 10.1, "timezone": "Europe/Berlin", "utc_offset_seconds": 3600,
+			"current_units": {"time": "iso8601", "interval": "seconds", "wave_height": "m", "swell_wave_period": "s"},
+			"current": {"time": "2025-12-29T10:00", "interval": 900, "wave_height": 1.4, "swell_wave_period": null},
+			"hourly_units": {"wave_height": "m"},
+			"hourly": {"time": ["2025-12-29T10:00"], "wave_height": [1.4]},
+			"daily_units": {"wave_height_max": "m"},
+			"daily": {"time": ["2025-12-29"], "wave_height_max": [2.1]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithMarineBaseURL(server.URL))
+	marine, err := client.GetMarineWeather(context.Background(), MarineRequest{
+		Latitude:  54.32,
+		Longitude: 10.14,
+		Current:   []Variable{MarineWaveHeight, MarineSwellWavePeriod},
+		Hourly:    MarineVariables,
+		Daily:     []Variable{DailyWaveHeightMax},
+	}, WithTimezone("auto"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	current := marine.Current
+	if current == nil || current.Get(MarineWaveHeight) != 1.4 || current.Units[MarineWaveHeight] != "m" {
+		t.Fatalf("Unexpected current conditions %+v", current)
+	}
+	if !math.IsNaN(current.Get(MarineSwellWavePeriod)) || !math.IsNaN(current.Get(MarineWindWaveHeight)) {
+		t.Errorf("Expected NaN for null and missing values, got %+v", current.Values)
+	}
+	if !current.Time.Equal(time.Date(2025, 12, 29, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected time %v", current.Time)
+	}
+	if marine.Hourly.Get(MarineWaveHeight)[0] != 1.4 || marine.Daily.Get(DailyWaveHeightMax)[0] != 2.1 {
+		t.Errorf("Unexpected series %+v %+v", marine.Hourly, marine.Daily)
+	}
+}
+
+// TestGetMarineWeather_Validation tests that invalid requests fail before any HTTP call
+func TestGetMarineWeather_Validation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client := NewClient(WithMarineBaseURL(server.URL))
+	tests := []struct {
+		name string
+		req  MarineRequest
+	}{
+		{"no blocks", MarineRequest{Latitude: 54.32, Longitude: 10.14}},
+		{"invalid latitude", MarineRequest{Latitude: 91, Hourly: MarineVariables}},
+		{"daily without timezone", MarineRequest{Latitude: 54.32, Longitude: 10.14, Daily: []Variable{DailyWaveHeightMax}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetMarineWeather(context.Background(), tt.req)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+	if called {
+		t.Error("Expected no HTTP request for invalid input")
+	}
+}
@@ -0,0 +1,56 @@
+package openmeteo
+
+import "github.com/gregbalnis/open-meteo-weather-sdk/units"
+
+// Measurements holds the physical fields of a CurrentWeather as typed values of the units
+// package, independent of the units the API returned them in (see WithUnits).
+type Measurements struct {
+	Temperature         units.Temperature
+	ApparentTemperature units.Temperature
+	DewPoint            units.Temperature
+
+	WindSpeed     units.Speed
+	WindGusts     units.Speed
+	WindDirection units.Direction
+
+	Precipitation units.Length
+	Rain          units.Length
+	Showers       units.Length
+	Snowfall      units.Length
+	Visibility    units.Length
+
+	PressureMSL     units.Pressure
+	SurfacePressure units.Pressure
+}
+
+// Measurements returns the physical fields of w as typed values, which convert to any unit of
+// their kind and format themselves. Fields the API returned no value for (see Has) are zero.
+//
+// Example:
+//
+//	m := weather.Measurements()
+//	fmt.Printf("%.1f°F, wind %.0f kn %s\n",
+//	    m.Temperature.In(units.Fahrenheit), m.WindSpeed.In(units.Knots), m.WindDirection.Compass())
+func (w *CurrentWeather) Measurements() Measurements {
+	temperature := units.TemperatureUnit(w.Units.temperature())
+	windSpeed := units.SpeedUnit(w.Units.windSpeed())
+	precipitation := units.LengthUnit(w.Units.precipitation())
+	return Measurements{
+		Temperature:         units.NewTemperature(w.Temperature, temperature),
+		ApparentTemperature: units.NewTemperature(w.ApparentTemperature, temperature),
+		DewPoint:            units.NewTemperature(w.DewPoint, temperature),
+
+		WindSpeed:     units.NewSpeed(w.WindSpeed, windSpeed),
+		WindGusts:     units.NewSpeed(w.WindGusts, windSpeed),
+		WindDirection: units.Direction(w.WindDirection),
+
+		Precipitation: units.NewLength(w.Precipitation, precipitation),
+		Rain:          units.NewLength(w.Rain, precipitation),
+		Showers:       units.NewLength(w.Showers, precipitation),
+		Snowfall:      units.NewLength(w.Snowfall, units.LengthUnit(w.Units.snowfall())),
+		Visibility:    units.NewLength(w.Visibility, units.Meter),
+
+		PressureMSL:     units.NewPressure(w.PressureMSL, units.Hectopascal),
+		SurfacePressure: units.NewPressure(w.SurfacePressure, units.Hectopascal),
+	}
+}
@@ -0,0 +1,43 @@
+package openmeteo
+
+import (
+	"path"
+	"time"
+)
+
+// Metrics receives request events for instrumentation (see WithMetrics). Endpoints are
+// named by the last path segment of the request, e.g., "forecast" or "search".
+// Implementations must be safe for concurrent use and should return quickly, as they are
+// called on the request path. The prommetrics package provides a Prometheus implementation.
+type Metrics interface {
+	// RequestStarted is called when a request is sent, after rate limiting and the
+	// concurrency limit.
+	RequestStarted(endpoint string)
+
+	// RequestFinished is called when a request started with RequestStarted has completed,
+	// with its duration and error (nil on success). Failed requests carry an *Error.
+	RequestFinished(endpoint string, duration time.Duration, err error)
+
+	// CacheLookup is called for every lookup in the response cache (see WithCache).
+	CacheLookup(endpoint string, hit bool)
+}
+
+// WithMetrics reports request events to m, e.g., to export request latency, in-flight
+// requests, errors and cache hits to a monitoring system. Retried requests are reported
+// once per attempt.
+//
+// Example:
+//
+//	collector := prommetrics.New("openmeteo")
+//	client := openmeteo.NewClient(openmeteo.WithMetrics(collector))
+//	http.Handle("/metrics", collector)
+func WithMetrics(m Metrics) Option {
+	return func(c *Client) {
+		c.metrics = m
+	}
+}
+
+// metricsEndpoint returns the endpoint name reported to Metrics for a request URL path.
+func metricsEndpoint(urlPath string) string {
+	return path.Base(urlPath)
+}
@@ -0,0 +1,168 @@
+package openmeteo
+
+import "strings"
+
+// Model identifies a weather model (or dataset) served by the Open Meteo API,
+// e.g., "ecmwf_ifs025". Models not listed in the catalog can be used by converting
+// their API name: openmeteo.Model("ncep_nbm_conus").
+type Model string
+
+// Forecast models that can be pinned with WithModels (see Models for details)
+const (
+	// ModelBestMatch combines the best models for each location (the API default)
+	ModelBestMatch Model = "best_match"
+
+	// ModelECMWFIFS025 is the ECMWF IFS global model at 0.25°
+	ModelECMWFIFS025 Model = "ecmwf_ifs025"
+
+	// ModelECMWFAIFS025 is the ECMWF AIFS machine-learning model at 0.25°
+	ModelECMWFAIFS025 Model = "ecmwf_aifs025_single"
+
+	// ModelGFSSeamless combines the NOAA GFS and HRRR models
+	ModelGFSSeamless Model = "gfs_seamless"
+
+	// ModelGFSGlobal is the NOAA GFS global model
+	ModelGFSGlobal Model = "gfs_global"
+
+	// ModelICONSeamless combines the DWD ICON global, EU and D2 models
+	ModelICONSeamless Model = "icon_seamless"
+
+	// ModelICONGlobal is the DWD ICON global model
+	ModelICONGlobal Model = "icon_global"
+
+	// ModelICONEU is the DWD ICON-EU model for Europe
+	ModelICONEU Model = "icon_eu"
+
+	// ModelICOND2 is the DWD ICON-D2 model for Central Europe
+	ModelICOND2 Model = "icon_d2"
+
+	// ModelMeteoFranceSeamless combines the Météo-France ARPEGE and AROME models
+	ModelMeteoFranceSeamless Model = "meteofrance_seamless"
+
+	// ModelJMASeamless combines the JMA GSM and MSM models
+	ModelJMASeamless Model = "jma_seamless"
+
+	// ModelGEMSeamless combines the Environment Canada GEM models
+	ModelGEMSeamless Model = "gem_seamless"
+
+	// ModelUKMOSeamless combines the UK Met Office global and UK models
+	ModelUKMOSeamless Model = "ukmo_seamless"
+
+	// ModelMetNoNordic is the MET Norway model for the Nordic countries
+	ModelMetNoNordic Model = "metno_nordic"
+
+	// ModelKNMISeamless combines the KNMI HARMONIE models for Europe and the Netherlands
+	ModelKNMISeamless Model = "knmi_seamless"
+)
+
+// ModelEndpoint is a model-specific endpoint of the forecast API (e.g., /v1/dwd-icon), which
+// serves the models of one provider and their model-specific variables (see WithModelEndpoint).
+// Endpoints not listed here can be used by converting their path segment:
+// openmeteo.ModelEndpoint("italia-meteo-arpae").
+type ModelEndpoint string
+
+const (
+	// EndpointDWDICON serves the DWD ICON models (/v1/dwd-icon)
+	EndpointDWDICON ModelEndpoint = "dwd-icon"
+
+	// EndpointGFS serves the NOAA GFS and HRRR models (/v1/gfs)
+	EndpointGFS ModelEndpoint = "gfs"
+
+	// EndpointECMWF serves the ECMWF IFS and AIFS models (/v1/ecmwf)
+	EndpointECMWF ModelEndpoint = "ecmwf"
+
+	// EndpointMeteoFrance serves the Météo-France ARPEGE and AROME models (/v1/meteofrance)
+	EndpointMeteoFrance ModelEndpoint = "meteofrance"
+
+	// EndpointJMA serves the JMA GSM and MSM models (/v1/jma)
+	EndpointJMA ModelEndpoint = "jma"
+
+	// EndpointMetNo serves the MET Norway Nordic model (/v1/metno)
+	EndpointMetNo ModelEndpoint = "metno"
+
+	// EndpointGEM serves the Environment Canada GEM models (/v1/gem)
+	EndpointGEM ModelEndpoint = "gem"
+
+	// EndpointUKMO serves the UK Met Office models (/v1/ukmo)
+	EndpointUKMO ModelEndpoint = "ukmo"
+
+	// EndpointKNMI serves the KNMI HARMONIE models (/v1/knmi)
+	EndpointKNMI ModelEndpoint = "knmi"
+
+	// EndpointDMI serves the DMI HARMONIE model (/v1/dmi)
+	EndpointDMI ModelEndpoint = "dmi"
+)
+
+// Service identifies an Open Meteo API service (endpoint family).
+type Service string
+
+const (
+	// ServiceForecast is the weather forecast API (/v1/forecast)
+	ServiceForecast Service = "forecast"
+
+	// ServiceEnsemble is the ensemble forecast API (/v1/ensemble)
+	ServiceEnsemble Service = "ensemble"
+
+	// ServiceArchive is the historical reanalysis API (/v1/archive)
+	ServiceArchive Service = "archive"
+
+	// ServiceHistoricalForecast is the archive of past forecasts (historical-forecast-api, /v1/forecast)
+	ServiceHistoricalForecast Service = "historical-forecast"
+
+	// ServiceClimate is the CMIP6 climate projection API (/v1/climate)
+	ServiceClimate Service = "climate"
+
+	// ServiceAirQuality is the air quality API (/v1/air-quality)
+	ServiceAirQuality Service = "air-quality"
+
+	// ServiceMarine is the marine weather API (/v1/marine)
+	ServiceMarine Service = "marine"
+
+	// ServiceFlood is the GloFAS river discharge API (/v1/flood)
+	ServiceFlood Service = "flood"
+)
+
+// joinModels formats models as the comma-separated list expected by the API.
+func joinModels(models []Model) string {
+	names := make([]string, len(models))
+	for i, m := range models {
+		names[i] = string(m)
+	}
+	return strings.Join(names, ",")
+}
+
+// ForModel returns the variables of one model from a multi-model series (see WithModels),
+// with the model suffix removed from the variable names: "temperature_2m_gfs_seamless"
+// becomes HourlyTemperature2m. Single-model responses carry no suffix and need no conversion.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars,
+//	    openmeteo.WithModels(openmeteo.ModelGFSSeamless, openmeteo.ModelICONSeamless),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	gfs := forecast.Hourly.ForModel(openmeteo.ModelGFSSeamless)
+//	icon := forecast.Hourly.ForModel(openmeteo.ModelICONSeamless)
+//	fmt.Println(gfs.Get(openmeteo.HourlyTemperature2m)[0], icon.Get(openmeteo.HourlyTemperature2m)[0])
+func (s *Series) ForModel(model Model) Series {
+	out := Series{
+		Time:     s.Time,
+		Values:   make(map[Variable][]float64),
+		Units:    make(map[Variable]string),
+		Location: s.Location,
+	}
+	suffix := "_" + string(model)
+	for v, values := range s.Values {
+		name, ok := strings.CutSuffix(string(v), suffix)
+		if !ok || name == "" {
+			continue
+		}
+		out.Values[Variable(name)] = values
+		if unit, ok := s.Units[v]; ok {
+			out.Units[Variable(name)] = unit
+		}
+	}
+	return out
+}
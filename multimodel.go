@@ -0,0 +1,133 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// multiModelResponse is an internal structure for unmarshaling current weather responses
+// of several models, whose variables are suffixed with the model name.
+type multiModelResponse struct {
+	weatherResponse
+	Current map[string]json.RawMessage `json:"current"`
+}
+
+// GetCurrentWeatherMultiModel fetches the current weather of several models in a single
+// request and returns it per model, e.g., to show the spread between models without one
+// round trip per model. Models without data for the coordinates are left out of the map.
+// The Model field of each result is set to its model. Request options apply to this call
+// only; the models argument takes precedence over WithModels.
+//
+// Example:
+//
+//	byModel, err := client.GetCurrentWeatherMultiModel(ctx, 52.52, 13.41, []openmeteo.Model{
+//	    openmeteo.ModelECMWFIFS025, openmeteo.ModelGFSSeamless, openmeteo.ModelICONSeamless,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for model, w := range byModel {
+//	    fmt.Printf("%s: %.1f°C\n", model, w.Temperature)
+//	}
+func (c *Client) GetCurrentWeatherMultiModel(ctx context.Context, latitude, longitude float64, models []Model, opts ...RequestOption) (map[Model]*CurrentWeather, error) {
+	requestID := requestIDFor(ctx)
+	cfg := newRequestConfig(opts)
+	if err := cfg.check(requestID); err != nil {
+		return nil, err
+	}
+
+	if err := validateCoordinates(latitude, longitude, requestID); err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "at least one model is required",
+			RequestID: requestID,
+		}
+	}
+	for _, m := range models {
+		if m == "" {
+			return nil, &Error{
+				Type:      ErrorTypeValidation,
+				Message:   "model names must not be empty",
+				RequestID: requestID,
+			}
+		}
+	}
+
+	q := url.Values{}
+	q.Set("current", currentVariables)
+	q.Set("models", joinModels(models))
+
+	reqURL, err := c.buildRequestURL(latitude, longitude, q, cfg)
+	if err != nil {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   "failed to build request URL",
+			Cause:     err,
+			RequestID: requestID,
+		}
+	}
+
+	var apiResp multiModelResponse
+	meta, err := c.doRequest(ctx, requestID, reqURL, cfg, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := splitByModel(apiResp.Current, models)
+	result := make(map[Model]*CurrentWeather, len(models))
+	for _, model := range models {
+		raw, err := json.Marshal(blocks[model])
+		if err != nil {
+			return nil, &Error{Type: ErrorTypeAPI, Message: "failed to parse current data", Cause: err, RequestID: requestID}
+		}
+		resp := apiResp.weatherResponse
+		resp.CurrentWeather = currentWeatherResponse{}
+		if err := json.Unmarshal(raw, &resp.CurrentWeather); err != nil {
+			return nil, &Error{Type: ErrorTypeAPI, Message: "failed to parse current data for " + string(model), Cause: err, RequestID: requestID}
+		}
+		if !hasCurrentData(resp) {
+			continue
+		}
+		weather := c.convertToCurrentWeather(resp, c.unitsFor(cfg))
+		weather.Model = model
+		weather.Stale, weather.Age = meta.stale, meta.age
+		result[model] = weather
+	}
+	return result, nil
+}
+
+// splitByModel distributes the variables of a multi-model data block to their models,
+// removing the model suffix. Unsuffixed keys (e.g., "time") are shared by all models;
+// with a single model the API does not suffix variables, so the block is used as is.
+// When one model name is a suffix of another, the longest match wins.
+func splitByModel(block map[string]json.RawMessage, models []Model) map[Model]map[string]json.RawMessage {
+	out := make(map[Model]map[string]json.RawMessage, len(models))
+	for _, m := range models {
+		out[m] = make(map[string]json.RawMessage)
+	}
+
+	for key, raw := range block {
+		var owner Model
+		name := key
+		if len(models) > 1 {
+			for _, m := range models {
+				if base, ok := strings.CutSuffix(key, "_"+string(m)); ok && base != "" && len(m) > len(owner) {
+					owner, name = m, base
+				}
+			}
+		}
+		if owner == "" {
+			for _, m := range models {
+				out[m][key] = raw
+			}
+			continue
+		}
+		out[owner][name] = raw
+	}
+	return out
+}
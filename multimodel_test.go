@@ -0,0 +1,84 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestGetCurrentWeatherMultiModel tests splitting model-suffixed current variables
+func TestGetCurrentWeatherMultiModel(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if q.Get("models") != "gfs_seamless,icon_seamless,icon_d2" || q.Get("current") != currentVariables || q.Get("cell_selection") != "sea" {
+			t.Errorf("Unexpected query %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {
+			"time": "2025-12-29T10:00", "interval": 900,
+			"temperature_2m_gfs_seamless": 4.5, "weather_code_gfs_seamless": 3, "is_day_gfs_seamless": 1,
+			"temperature_2m_icon_seamless": 5.5, "weather_code_icon_seamless": 61, "is_day_icon_seamless": 1,
+			"temperature_2m_icon_d2": null, "weather_code_icon_d2": null}}`)
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	byModel, err := client.GetCurrentWeatherMultiModel(context.Background(), 52.52, 13.41,
+		[]Model{ModelGFSSeamless, ModelICONSeamless, ModelICOND2}, WithCellSelection(CellSelectionSea))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(byModel) != 2 {
+		t.Fatalf("Expected models without data to be left out, got %v", byModel)
+	}
+	gfs, icon := byModel[ModelGFSSeamless], byModel[ModelICONSeamless]
+	if gfs.Temperature != 4.5 || gfs.WeatherCode != 3 || gfs.Model != ModelGFSSeamless {
+		t.Errorf("Unexpected gfs_seamless weather %+v", gfs)
+	}
+	if icon.Temperature != 5.5 || icon.WeatherCode != 61 || !icon.IsDay {
+		t.Errorf("Unexpected icon_seamless weather %+v", icon)
+	}
+	if !icon.Time.Equal(time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the shared time on every model, got %v", icon.Time)
+	}
+
+	var apiErr *Error
+	for _, models := range [][]Model{nil, {ModelGFSSeamless, ""}} {
+		_, err := client.GetCurrentWeatherMultiModel(context.Background(), 52.52, 13.41, models)
+		if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+			t.Errorf("Expected validation error for %v, got %v", models, err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected invalid calls not to be sent, got %d requests", requests)
+	}
+}
+
+// TestSplitByModel tests suffix matching for single models and overlapping model names
+func TestSplitByModel(t *testing.T) {
+	raw := map[string]json.RawMessage{
+		"time":                    json.RawMessage(`"2025-12-29T10:00"`),
+		"temperature_2m_gfs":      json.RawMessage(`1`),
+		"temperature_2m_ncep_gfs": json.RawMessage(`2`),
+	}
+
+	split := splitByModel(raw, []Model{"gfs", "ncep_gfs"})
+	if string(split["gfs"]["temperature_2m"]) != "1" || string(split["ncep_gfs"]["temperature_2m"]) != "2" {
+		t.Errorf("Expected longest suffix match, got %v", split)
+	}
+	if _, ok := split["ncep_gfs"]["time"]; !ok {
+		t.Error("Expected unsuffixed keys to be shared")
+	}
+
+	single := splitByModel(raw, []Model{"gfs"})
+	if len(single["gfs"]) != 3 {
+		t.Errorf("Expected a single model to keep the block unchanged, got %v", single)
+	}
+}
@@ -0,0 +1,221 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+)
+
+// NetCDF classic format tags and types (64-bit offset variant, CDF-2).
+const (
+	ncDimension = 0x0A
+	ncVariable  = 0x0B
+	ncAttribute = 0x0C
+
+	ncChar   = 2
+	ncDouble = 6
+)
+
+// LocationSeries is the time series of one location in a multi-location export.
+type LocationSeries struct {
+	// Latitude of the location in degrees
+	Latitude float64
+
+	// Longitude of the location in degrees
+	Longitude float64
+
+	// Series holds the data of the location; all locations of an export share the same time steps
+	Series *Series
+}
+
+// ncAttr is a text or numeric attribute.
+type ncAttr struct {
+	name   string
+	text   string
+	number float64
+	isNum  bool
+}
+
+// ncVar is a double-precision variable with its dimension ids and data.
+type ncVar struct {
+	name  string
+	dims  []int
+	attrs []ncAttr
+	data  []float64
+}
+
+// WriteNetCDF writes the series of several locations (e.g., a grid pulled with
+// DownloadHistoricalHourly) to w as a NetCDF file (classic 64-bit offset format) following the
+// CF conventions for time series: dimensions time and location, coordinate variables time,
+// latitude and longitude, and one double variable per weather variable with dimensions
+// (location, time), its unit and NaN as the fill value for missing data.
+// All locations must have the same time steps; a variable missing at a location is written
+// as missing values.
+//
+// Example:
+//
+//	var points []openmeteo.LocationSeries
+//	for _, c := range grid {
+//	    h, err := client.DownloadHistoricalHourly(ctx, c.Latitude, c.Longitude, vars, start, end)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    points = append(points, openmeteo.LocationSeries{Latitude: h.Latitude, Longitude: h.Longitude, Series: &h.Hourly})
+//	}
+//	return openmeteo.WriteNetCDF(out, points)
+func WriteNetCDF(w io.Writer, locations []LocationSeries) error {
+	if len(locations) == 0 {
+		return fmt.Errorf("no locations to export")
+	}
+	times := locations[0].Series.Time
+	for i, l := range locations[1:] {
+		if !slices.EqualFunc(l.Series.Time, times, func(a, b time.Time) bool { return a.Equal(b) }) {
+			return fmt.Errorf("location %d has different time steps than location 0", i+1)
+		}
+	}
+
+	// Dimension ids
+	const timeDim, locationDim = 0, 1
+
+	timeData := make([]float64, len(times))
+	for i, t := range times {
+		timeData[i] = float64(t.Unix())
+	}
+	lat := make([]float64, len(locations))
+	lon := make([]float64, len(locations))
+	units := make(map[Variable]string)
+	for i, l := range locations {
+		lat[i], lon[i] = l.Latitude, l.Longitude
+		for v := range l.Series.Values {
+			if _, ok := units[v]; !ok || units[v] == "" {
+				units[v] = l.Series.Unit(v)
+			}
+		}
+	}
+
+	vars := []ncVar{
+		{name: "time", dims: []int{timeDim}, data: timeData, attrs: []ncAttr{
+			{name: "standard_name", text: "time"},
+			{name: "units", text: "seconds since 1970-01-01 00:00:00 UTC"},
+			{name: "calendar", text: "standard"},
+		}},
+		{name: "latitude", dims: []int{locationDim}, data: lat, attrs: []ncAttr{
+			{name: "standard_name", text: "latitude"},
+			{name: "units", text: "degrees_north"},
+		}},
+		{name: "longitude", dims: []int{locationDim}, data: lon, attrs: []ncAttr{
+			{name: "standard_name", text: "longitude"},
+			{name: "units", text: "degrees_east"},
+		}},
+	}
+	names := make([]Variable, 0, len(units))
+	for v := range units {
+		names = append(names, v)
+	}
+	slices.Sort(names)
+	for _, v := range names {
+		data := make([]float64, 0, len(locations)*len(times))
+		for _, l := range locations {
+			for i := range times {
+				data = append(data, l.Series.valueAt(v, i))
+			}
+		}
+		attrs := []ncAttr{{name: "_FillValue", number: math.NaN(), isNum: true}, {name: "coordinates", text: "time latitude longitude"}}
+		if units[v] != "" {
+			attrs = append(attrs, ncAttr{name: "units", text: units[v]})
+		}
+		vars = append(vars, ncVar{name: string(v), dims: []int{locationDim, timeDim}, attrs: attrs, data: data})
+	}
+
+	globals := []ncAttr{
+		{name: "Conventions", text: "CF-1.8"},
+		{name: "featureType", text: "timeSeries"},
+		{name: "source", text: "Open-Meteo (https://open-meteo.com)"},
+	}
+	dims := []struct {
+		name   string
+		length int
+	}{{"time", len(times)}, {"location", len(locations)}}
+
+	// The header stores the file offset of each variable's data, so it is encoded once to
+	// learn its size and again with the final offsets.
+	header := func(begin int64) []byte {
+		var b bytes.Buffer
+		b.WriteString("CDF\x02")
+		ncPut(&b, 0) // numrecs: no record (unlimited) dimension
+		ncPut(&b, ncDimension, len(dims))
+		for _, d := range dims {
+			ncName(&b, d.name)
+			ncPut(&b, d.length)
+		}
+		ncAttrs(&b, globals)
+		ncPut(&b, ncVariable, len(vars))
+		offset := begin
+		for _, v := range vars {
+			ncName(&b, v.name)
+			ncPut(&b, len(v.dims))
+			for _, d := range v.dims {
+				ncPut(&b, d)
+			}
+			ncAttrs(&b, v.attrs)
+			size := len(v.data) * 8
+			ncPut(&b, ncDouble, size)
+			_ = binary.Write(&b, binary.BigEndian, offset)
+			offset += int64(size)
+		}
+		return b.Bytes()
+	}
+
+	var out bytes.Buffer
+	out.Write(header(int64(len(header(0)))))
+	for _, v := range vars {
+		_ = binary.Write(&out, binary.BigEndian, v.data)
+	}
+	_, err := out.WriteTo(w)
+	return err
+}
+
+// ncPut writes big-endian 32-bit integers.
+func ncPut(b *bytes.Buffer, values ...int) {
+	for _, v := range values {
+		_ = binary.Write(b, binary.BigEndian, int32(v))
+	}
+}
+
+// ncName writes a length-prefixed name padded to 4 bytes.
+func ncName(b *bytes.Buffer, name string) {
+	ncPut(b, len(name))
+	b.WriteString(name)
+	ncPad(b, len(name))
+}
+
+// ncPad writes the zero bytes that pad n bytes of data to a multiple of 4.
+func ncPad(b *bytes.Buffer, n int) {
+	b.Write(make([]byte, (4-n%4)%4))
+}
+
+// ncAttrs writes an attribute list (the absent marker when empty).
+func ncAttrs(b *bytes.Buffer, attrs []ncAttr) {
+	if len(attrs) == 0 {
+		ncPut(b, 0, 0)
+		return
+	}
+	ncPut(b, ncAttribute, len(attrs))
+	for _, a := range attrs {
+		ncName(b, a.name)
+		if a.isNum {
+			ncPut(b, ncDouble, 1)
+			_ = binary.Write(b, binary.BigEndian, a.number)
+			continue
+		}
+		ncPut(b, ncChar, len(a.text))
+		b.WriteString(a.text)
+		ncPad(b, len(a.text))
+	}
+}
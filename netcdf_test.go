@@ -0,0 +1,158 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+	"time"
+)
+
+// ncFile is a decoded NetCDF file as written by WriteNetCDF
+type ncFile struct {
+	dims    map[string]int
+	globals map[string]string
+	attrs   map[string]map[string]any
+	vars    map[string][]float64
+}
+
+// readNetCDF decodes a classic 64-bit offset NetCDF file with double variables
+func readNetCDF(t *testing.T, data []byte) ncFile {
+	t.Helper()
+	r := bytes.NewReader(data)
+	i32 := func() int {
+		var v int32
+		if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+			t.Fatalf("Unexpected end of header: %v", err)
+		}
+		return int(v)
+	}
+	padded := func(n int) []byte {
+		b := make([]byte, n+(4-n%4)%4)
+		if _, err := io.ReadFull(r, b); err != nil {
+			t.Fatalf("Unexpected end of header: %v", err)
+		}
+		return b[:n]
+	}
+	name := func() string { return string(padded(i32())) }
+	attrs := func() map[string]any {
+		tag, n := i32(), i32()
+		if tag != ncAttribute && !(tag == 0 && n == 0) {
+			t.Fatalf("Expected attribute list, got tag %d", tag)
+		}
+		out := make(map[string]any)
+		for range n {
+			key := name()
+			switch typ, count := i32(), i32(); typ {
+			case ncChar:
+				out[key] = string(padded(count))
+			case ncDouble:
+				var v float64
+				_ = binary.Read(r, binary.BigEndian, &v)
+				out[key] = v
+			default:
+				t.Fatalf("Unexpected attribute type %d", typ)
+			}
+		}
+		return out
+	}
+
+	if magic := string(padded(4)); magic != "CDF\x02" {
+		t.Fatalf("Unexpected magic %q", magic)
+	}
+	f := ncFile{dims: map[string]int{}, globals: map[string]string{}, attrs: map[string]map[string]any{}, vars: map[string][]float64{}}
+	if numrecs := i32(); numrecs != 0 {
+		t.Errorf("Expected no records, got %d", numrecs)
+	}
+	if tag := i32(); tag != ncDimension {
+		t.Fatalf("Expected dimension list, got tag %d", tag)
+	}
+	dimLengths := make([]int, i32())
+	for i := range dimLengths {
+		n := name()
+		dimLengths[i] = i32()
+		f.dims[n] = dimLengths[i]
+	}
+	for k, v := range attrs() {
+		f.globals[k], _ = v.(string)
+	}
+	if tag := i32(); tag != ncVariable {
+		t.Fatalf("Expected variable list, got tag %d", tag)
+	}
+	for range i32() {
+		n := name()
+		size := 1
+		for range i32() {
+			size *= dimLengths[i32()]
+		}
+		f.attrs[n] = attrs()
+		if typ, vsize := i32(), i32(); typ != ncDouble || vsize != size*8 {
+			t.Fatalf("Variable %s: unexpected type %d or size %d", n, typ, vsize)
+		}
+		var begin int64
+		_ = binary.Read(r, binary.BigEndian, &begin)
+		values := make([]float64, size)
+		if err := binary.Read(bytes.NewReader(data[begin:]), binary.BigEndian, values); err != nil {
+			t.Fatalf("Variable %s: %v", n, err)
+		}
+		f.vars[n] = values
+	}
+	return f
+}
+
+// TestWriteNetCDF tests dimensions, coordinates, metadata and data layout
+func TestWriteNetCDF(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := hourlySeries(start, map[Variable][]float64{HourlyTemperature2m: {1, 2, 3}, HourlyPrecipitation: {0, 0.5, 0}})
+	a.Units = map[Variable]string{HourlyTemperature2m: "°C", HourlyPrecipitation: "mm"}
+	b := hourlySeries(start, map[Variable][]float64{HourlyTemperature2m: {4, math.NaN(), 6}})
+
+	var buf bytes.Buffer
+	err := WriteNetCDF(&buf, []LocationSeries{
+		{Latitude: 52.5, Longitude: 13.4, Series: &a},
+		{Latitude: 48.1, Longitude: 11.6, Series: &b},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	f := readNetCDF(t, buf.Bytes())
+
+	if f.dims["time"] != 3 || f.dims["location"] != 2 {
+		t.Errorf("Unexpected dimensions %v", f.dims)
+	}
+	if f.globals["Conventions"] != "CF-1.8" || f.globals["featureType"] != "timeSeries" {
+		t.Errorf("Unexpected global attributes %v", f.globals)
+	}
+	if got := f.vars["time"]; got[0] != float64(start.Unix()) || got[2] != float64(start.Add(2*time.Hour).Unix()) {
+		t.Errorf("Unexpected time coordinate %v", got)
+	}
+	if got := f.vars["latitude"]; got[0] != 52.5 || got[1] != 48.1 {
+		t.Errorf("Unexpected latitudes %v", got)
+	}
+	temperature := f.vars["temperature_2m"]
+	if temperature[0] != 1 || temperature[3] != 4 || !math.IsNaN(temperature[4]) || temperature[5] != 6 {
+		t.Errorf("Unexpected temperature layout %v", temperature)
+	}
+	if f.attrs["temperature_2m"]["units"] != "°C" || !math.IsNaN(f.attrs["temperature_2m"]["_FillValue"].(float64)) {
+		t.Errorf("Unexpected temperature attributes %v", f.attrs["temperature_2m"])
+	}
+	precipitation := f.vars["precipitation"]
+	if precipitation[1] != 0.5 || !math.IsNaN(precipitation[3]) {
+		t.Errorf("Expected precipitation to be missing at the second location, got %v", precipitation)
+	}
+}
+
+// TestWriteNetCDF_Invalid tests rejected inputs
+func TestWriteNetCDF_Invalid(t *testing.T) {
+	if err := WriteNetCDF(io.Discard, nil); err == nil {
+		t.Error("Expected an error without locations")
+	}
+	a := hourlySeries(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{HourlyTemperature2m: {1, 2}})
+	b := hourlySeries(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), map[Variable][]float64{HourlyTemperature2m: {1, 2}})
+	if err := WriteNetCDF(io.Discard, []LocationSeries{{Series: &a}, {Series: &b}}); err == nil {
+		t.Error("Expected an error for different time steps")
+	}
+}
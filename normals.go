@@ -0,0 +1,261 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// normalsSmoothingDays is the half-width of the window (in days) over which daily normals
+	// are averaged, so that each day-of-year normal is based on 15 days per year
+	normalsSmoothingDays = 7
+
+	// daysInLeapYear is the number of day-of-year slots of daily normals
+	daysInLeapYear = 366
+
+	// maxNormalsEntries bounds the number of normals kept by the client's cache
+	maxNormalsEntries = 64
+)
+
+// NormalsPeriod is the range of years (inclusive) over which normals are computed.
+type NormalsPeriod struct {
+	// StartYear is the first year of the period
+	StartYear int
+
+	// EndYear is the last year of the period
+	EndYear int
+}
+
+// StandardNormalsPeriod is the current WMO climatological standard normal period (1991-2020).
+var StandardNormalsPeriod = NormalsPeriod{StartYear: 1991, EndYear: 2020}
+
+// Normals holds climatological normals (long-term averages) of daily variables for a
+// location, computed by GetNormals. Values are NaN where no data was available.
+type Normals struct {
+	// Latitude of the grid cell used by the API in degrees
+	Latitude float64
+
+	// Longitude of the grid cell used by the API in degrees
+	Longitude float64
+
+	// Period is the range of years the normals are computed from
+	Period NormalsPeriod
+
+	// Monthly maps each variable to its mean per calendar month (index 0 is January)
+	Monthly map[Variable][]float64
+
+	// Daily maps each variable to its smoothed mean per day of the year (366 entries; index 0
+	// is January 1 and index 59 is February 29). Each value averages the 15 days centred on
+	// that day over all years of the period.
+	Daily map[Variable][]float64
+
+	// samples holds the values of each variable per day of the year, for percentiles
+	samples map[Variable]*[daysInLeapYear][]float64
+}
+
+// ForMonth returns the normal of v for a calendar month, or NaN if unavailable.
+func (n *Normals) ForMonth(v Variable, month time.Month) float64 {
+	values := n.Monthly[v]
+	if month < time.January || month > time.December || len(values) != 12 {
+		return math.NaN()
+	}
+	return values[month-1]
+}
+
+// ForDate returns the daily normal of v for the calendar day of date, or NaN if unavailable.
+func (n *Normals) ForDate(v Variable, date time.Time) float64 {
+	values := n.Daily[v]
+	if len(values) != daysInLeapYear {
+		return math.NaN()
+	}
+	return values[leapYearDay(date)]
+}
+
+// Percentile returns the p-th percentile (0-100) of the daily values of v within the 15 days
+// centred on the calendar day of date over all years of the period (e.g., the 90th percentile
+// of maximum temperatures used to define heatwaves). It returns NaN if unavailable.
+func (n *Normals) Percentile(v Variable, date time.Time, p float64) float64 {
+	samples := n.samples[v]
+	if samples == nil || p < 0 || p > 100 {
+		return math.NaN()
+	}
+	center := leapYearDay(date)
+	var window []float64
+	for offset := -normalsSmoothingDays; offset <= normalsSmoothingDays; offset++ {
+		window = append(window, samples[(center+offset+daysInLeapYear)%daysInLeapYear]...)
+	}
+	if len(window) == 0 {
+		return math.NaN()
+	}
+	slices.Sort(window)
+	return percentileOf(window, p)
+}
+
+// percentileOf returns the p-th percentile (0-100) of sorted values using linear interpolation
+// between the closest ranks.
+func percentileOf(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// leapYearDay returns the zero-based day of the year of t's calendar date in a leap year,
+// so that the same calendar day maps to the same index in every year.
+func leapYearDay(t time.Time) int {
+	day := t.YearDay() - 1
+	if t.Month() > time.February && !isLeapYear(t.Year()) {
+		day++
+	}
+	return day
+}
+
+// isLeapYear reports whether year has 366 days.
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// normalsCache keeps computed normals per location, variables and period. The zero value is
+// ready to use, and it is safe for concurrent use.
+type normalsCache struct {
+	mu      sync.Mutex
+	entries map[string]*Normals
+	order   []string
+}
+
+// get returns the cached normals for key.
+func (n *normalsCache) get(key string) (*Normals, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	normals, ok := n.entries[key]
+	return normals, ok
+}
+
+// put stores normals for key, evicting the oldest entry when full.
+func (n *normalsCache) put(key string, normals *Normals) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.entries == nil {
+		n.entries = make(map[string]*Normals)
+	}
+	if _, ok := n.entries[key]; !ok {
+		if len(n.order) >= maxNormalsEntries {
+			delete(n.entries, n.order[0])
+			n.order = n.order[1:]
+		}
+		n.order = append(n.order, key)
+	}
+	n.entries[key] = normals
+}
+
+// GetNormals computes climatological normals of daily variables (e.g., DailyTemperature2mMax,
+// DailyPrecipitationSum) for a location from the historical weather API: monthly means and
+// smoothed day-of-year means over the years of period (StandardNormalsPeriod, 1991-2020, when
+// zero). Days are local to the coordinates unless a timezone is set with WithTimezone.
+//
+// Computing 30-year normals downloads 30 years of daily data, so results are cached by the
+// client (per coordinates rounded to 0.01°, variables and period) and later calls return
+// the cached normals without contacting the API. Normals are the baseline for anomalies.
+//
+// Example:
+//
+//	normals, err := client.GetNormals(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.DailyTemperature2mMax}, openmeteo.StandardNormalsPeriod)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("normal July maximum: %.1f °C\n", normals.ForMonth(openmeteo.DailyTemperature2mMax, time.July))
+func (c *Client) GetNormals(ctx context.Context, latitude, longitude float64, vars []Variable, period NormalsPeriod, opts ...RequestOption) (*Normals, error) {
+	requestID := requestIDFor(ctx)
+	ctx = WithRequestID(ctx, requestID)
+
+	if period == (NormalsPeriod{}) {
+		period = StandardNormalsPeriod
+	}
+	if period.StartYear < earliestDate.Year() || period.EndYear < period.StartYear || period.EndYear >= time.Now().Year() {
+		return nil, &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf("invalid normals period %d-%d (must be complete years from %d to last year)", period.StartYear, period.EndYear, earliestDate.Year()),
+			RequestID: requestID,
+		}
+	}
+
+	opts = append([]RequestOption{WithTimezone("auto")}, opts...)
+	cfg := newRequestConfig(opts)
+	key := fmt.Sprintf("%.2f,%.2f|%s|%d-%d|%s", latitude, longitude, joinVariables(vars), period.StartYear, period.EndYear, cfg.timezone)
+	if normals, ok := c.normals.get(key); ok {
+		return normals, nil
+	}
+
+	start := time.Date(period.StartYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(period.EndYear, time.December, 31, 0, 0, 0, 0, time.UTC)
+	history, err := c.DownloadHistoricalDaily(ctx, latitude, longitude, vars, start, end, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	normals := computeNormals(&history.Daily, vars)
+	normals.Latitude, normals.Longitude, normals.Period = history.Latitude, history.Longitude, period
+	c.normals.put(key, normals)
+	return normals, nil
+}
+
+// computeNormals computes the monthly and smoothed daily means of vars in a daily series.
+func computeNormals(daily *Series, vars []Variable) *Normals {
+	normals := &Normals{
+		Monthly: make(map[Variable][]float64, len(vars)),
+		Daily:   make(map[Variable][]float64, len(vars)),
+		samples: make(map[Variable]*[daysInLeapYear][]float64, len(vars)),
+	}
+	days := daily.TimesInLocal()
+
+	for _, v := range vars {
+		var monthSum, monthCount [12]float64
+		var daySum, dayCount [daysInLeapYear]float64
+		samples := new([daysInLeapYear][]float64)
+		for i, t := range days {
+			value := daily.valueAt(v, i)
+			if math.IsNaN(value) {
+				continue
+			}
+			monthSum[t.Month()-1] += value
+			monthCount[t.Month()-1]++
+			day := leapYearDay(t)
+			daySum[day] += value
+			dayCount[day]++
+			samples[day] = append(samples[day], value)
+		}
+
+		monthly := make([]float64, 12)
+		for m := range monthly {
+			monthly[m] = mean(monthSum[m], monthCount[m])
+		}
+		smoothed := make([]float64, daysInLeapYear)
+		for d := range smoothed {
+			var sum, count float64
+			for offset := -normalsSmoothingDays; offset <= normalsSmoothingDays; offset++ {
+				j := (d + offset + daysInLeapYear) % daysInLeapYear
+				sum += daySum[j]
+				count += dayCount[j]
+			}
+			smoothed[d] = mean(sum, count)
+		}
+		normals.Monthly[v] = monthly
+		normals.Daily[v] = smoothed
+		normals.samples[v] = samples
+	}
+	return normals
+}
+
+// mean returns sum/count, or NaN when count is zero.
+func mean(sum, count float64) float64 {
+	if count == 0 {
+		return math.NaN()
+	}
+	return sum / count
+}
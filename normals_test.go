@@ -0,0 +1,160 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// dailyArchiveHandler serves daily archive data where temperature_2m_max is the month number
+// and precipitation_sum is missing in January.
+func dailyArchiveHandler(t *testing.T, requests *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		q := r.URL.Query()
+		if q.Get("daily") != "temperature_2m_max,precipitation_sum" || q.Get("timezone") != "auto" {
+			t.Errorf("Unexpected query %v", q)
+		}
+		start, _ := time.Parse(apiDateLayout, q.Get("start_date"))
+		end, _ := time.Parse(apiDateLayout, q.Get("end_date"))
+
+		var times, temps, precip []string
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			times = append(times, `"`+d.Format(apiDateLayout)+`"`)
+			temps = append(temps, fmt.Sprint(int(d.Month())))
+			if d.Month() == time.January {
+				precip = append(precip, "null")
+			} else {
+				precip = append(precip, "2")
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"latitude": 52.5, "longitude": 13.4, "timezone": "GMT", "daily": {"time": [%s], "temperature_2m_max": [%s], "precipitation_sum": [%s]}}`,
+			strings.Join(times, ","), strings.Join(temps, ","), strings.Join(precip, ","))
+	}
+}
+
+// TestGetNormals tests monthly and smoothed daily normals and caching
+func TestGetNormals(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(dailyArchiveHandler(t, &requests))
+	defer server.Close()
+
+	client := NewClient(WithArchiveBaseURL(server.URL))
+	vars := []Variable{DailyTemperature2mMax, DailyPrecipitationSum}
+	period := NormalsPeriod{StartYear: 2001, EndYear: 2004}
+
+	normals, err := client.GetNormals(context.Background(), 52.52, 13.41, vars, period)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := requests.Load(); got != 4 {
+		t.Errorf("Expected 4 yearly chunks, got %d", got)
+	}
+	if normals.Period != period || normals.Latitude != 52.5 {
+		t.Errorf("Unexpected metadata %+v", normals)
+	}
+
+	for m := time.January; m <= time.December; m++ {
+		if got := normals.ForMonth(DailyTemperature2mMax, m); got != float64(m) {
+			t.Errorf("Expected normal %d for %s, got %v", m, m, got)
+		}
+	}
+	if got := normals.ForMonth(DailyPrecipitationSum, time.January); !math.IsNaN(got) {
+		t.Errorf("Expected NaN for a month without data, got %v", got)
+	}
+
+	if got := normals.ForDate(DailyTemperature2mMax, time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC)); got != 7 {
+		t.Errorf("Expected mid-July normal of 7, got %v", got)
+	}
+	// January 1 averages December 25-31 (7 days) and January 1-8 (8 days) of every year
+	want := (7*12.0 + 8*1.0) / 15
+	if got := normals.ForDate(DailyTemperature2mMax, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected smoothed January 1 normal %.3f, got %v", want, got)
+	}
+	// March 1 maps to the same slot in leap and non-leap years
+	if normals.ForDate(DailyTemperature2mMax, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)) !=
+		normals.ForDate(DailyTemperature2mMax, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Expected March 1 to map to the same day in leap and non-leap years")
+	}
+	if got := normals.ForDate(HourlyTemperature2m, time.Now()); !math.IsNaN(got) {
+		t.Errorf("Expected NaN for an unknown variable, got %v", got)
+	}
+	if got := normals.ForMonth(DailyTemperature2mMax, 13); !math.IsNaN(got) {
+		t.Errorf("Expected NaN for an invalid month, got %v", got)
+	}
+
+	cached, err := client.GetNormals(context.Background(), 52.521, 13.409, vars, period)
+	if err != nil || cached != normals {
+		t.Errorf("Expected cached normals, got %v (%v)", cached, err)
+	}
+	if got := requests.Load(); got != 4 {
+		t.Errorf("Expected no additional requests for cached normals, got %d", got)
+	}
+}
+
+// TestGetNormals_Validation tests period validation
+func TestGetNormals_Validation(t *testing.T) {
+	client := NewClient(WithArchiveBaseURL("http://127.0.0.1:1"))
+	for _, period := range []NormalsPeriod{
+		{StartYear: 1900, EndYear: 1930},
+		{StartYear: 2010, EndYear: 2000},
+		{StartYear: 2000, EndYear: time.Now().Year()},
+	} {
+		var apiErr *Error
+		_, err := client.GetNormals(context.Background(), 52.52, 13.41, []Variable{DailyTemperature2mMax}, period)
+		if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+			t.Errorf("Period %+v: expected validation error, got %v", period, err)
+		}
+	}
+}
+
+// TestNormalsCache_Eviction tests that the oldest normals are evicted when the cache is full
+func TestNormalsCache_Eviction(t *testing.T) {
+	var cache normalsCache
+	for i := range maxNormalsEntries + 1 {
+		cache.put(fmt.Sprint(i), &Normals{})
+	}
+	if _, ok := cache.get("0"); ok {
+		t.Error("Expected oldest entry to be evicted")
+	}
+	if _, ok := cache.get(fmt.Sprint(maxNormalsEntries)); !ok {
+		t.Error("Expected newest entry to be cached")
+	}
+}
+
+// TestNormals_Percentile tests percentiles over the smoothing window
+func TestNormals_Percentile(t *testing.T) {
+	values := make([]float64, 365)
+	for i := range values {
+		values[i] = float64(i % 10)
+	}
+	s := dailySeries(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{DailyTemperature2mMax: values}, map[Variable]string{})
+	normals := computeNormals(&s, []Variable{DailyTemperature2mMax})
+
+	// The 15-day window around July 15 (day 195) holds the values of days 188 to 202
+	date := time.Date(2001, 7, 15, 0, 0, 0, 0, time.UTC)
+	if got := normals.Percentile(DailyTemperature2mMax, date, 0); got != 0 {
+		t.Errorf("Expected minimum 0, got %v", got)
+	}
+	if got := normals.Percentile(DailyTemperature2mMax, date, 100); got != 9 {
+		t.Errorf("Expected maximum 9, got %v", got)
+	}
+	if got := normals.Percentile(DailyTemperature2mMax, date, 50); got != 4 {
+		t.Errorf("Expected median 4, got %v", got)
+	}
+	for _, p := range []float64{-1, 101} {
+		if got := normals.Percentile(DailyTemperature2mMax, date, p); !math.IsNaN(got) {
+			t.Errorf("Expected NaN for percentile %v, got %v", p, got)
+		}
+	}
+	if got := normals.Percentile(DailyPrecipitationSum, date, 50); !math.IsNaN(got) {
+		t.Errorf("Expected NaN for a variable without normals, got %v", got)
+	}
+}
@@ -0,0 +1,87 @@
+package openmeteo
+
+import (
+	"sync"
+	"time"
+)
+
+// maxOfflineEntries bounds the number of responses kept by the offline cache
+const maxOfflineEntries = 256
+
+// offlineEntry is a raw response body and the time it was fetched.
+type offlineEntry struct {
+	body    []byte
+	fetched time.Time
+}
+
+// offlineCache keeps the last good response per request URL. It is safe for concurrent use.
+type offlineCache struct {
+	mu      sync.Mutex
+	maxAge  time.Duration
+	now     func() time.Time
+	entries map[string]offlineEntry
+}
+
+// WithOfflineFallback enables offline mode: when a call fails with a network error, the client
+// returns the last successful result for the same request instead of the error. Results served
+// this way have Stale set to true and Age set to the time since they were fetched. Entries older
+// than maxAge are not served (zero means no age limit). API errors (HTTP 4xx/5xx) are never
+// masked. This is intended for kiosk and embedded deployments with flaky connectivity.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithOfflineFallback(6 * time.Hour))
+//	weather, err := client.GetCurrentWeather(ctx, 52.52, 13.41)
+//	if err == nil && weather.Stale {
+//	    fmt.Printf("offline, showing data from %s ago\n", weather.Age.Round(time.Minute))
+//	}
+func WithOfflineFallback(maxAge time.Duration) Option {
+	return func(c *Client) {
+		c.offline = &offlineCache{
+			maxAge:  maxAge,
+			entries: make(map[string]offlineEntry),
+		}
+	}
+}
+
+// clock returns the current time.
+func (o *offlineCache) clock() time.Time {
+	if o.now != nil {
+		return o.now()
+	}
+	return time.Now()
+}
+
+// put stores body as the last good response for key, evicting the oldest entry when full.
+func (o *offlineCache) put(key string, body []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.entries[key]; !ok && len(o.entries) >= maxOfflineEntries {
+		var oldestKey string
+		var oldest time.Time
+		for k, e := range o.entries {
+			if oldestKey == "" || e.fetched.Before(oldest) {
+				oldestKey, oldest = k, e.fetched
+			}
+		}
+		delete(o.entries, oldestKey)
+	}
+	o.entries[key] = offlineEntry{body: body, fetched: o.clock()}
+}
+
+// get returns the last good response for key and its age, if one within maxAge exists.
+func (o *offlineCache) get(key string) ([]byte, time.Duration, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	e, ok := o.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+	age := o.clock().Sub(e.fetched)
+	if o.maxAge > 0 && age > o.maxAge {
+		return nil, 0, false
+	}
+	return e.body, age, true
+}
@@ -0,0 +1,111 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestWithOfflineFallback tests that network failures are answered from the offline cache
+func TestWithOfflineFallback(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 4.5}}`)
+	}))
+
+	client := NewClient(WithBaseURL(server.URL), WithOfflineFallback(0))
+	ctx := context.Background()
+
+	fresh, err := client.GetCurrentWeather(ctx, 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fresh.Stale || fresh.Age != 0 {
+		t.Errorf("Expected fresh result, got stale=%v age=%v", fresh.Stale, fresh.Age)
+	}
+
+	// API errors are never masked
+	status = http.StatusServiceUnavailable
+	_, err = client.GetCurrentWeather(ctx, 52.52, 13.41)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeAPI {
+		t.Errorf("Expected API error, got %v", err)
+	}
+
+	server.Close()
+
+	stale, err := client.GetCurrentWeather(ctx, 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected stale result, got %v", err)
+	}
+	if !stale.Stale || stale.Temperature != 4.5 || stale.Age < 0 {
+		t.Errorf("Expected stale result with cached data, got %+v", stale)
+	}
+
+	// Requests never fetched before still fail
+	_, err = client.GetCurrentWeather(ctx, 48.85, 2.35)
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeNetwork {
+		t.Errorf("Expected network error for uncached request, got %v", err)
+	}
+
+	// Canceled calls are not answered from the cache
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := client.GetCurrentWeather(canceled, 52.52, 13.41); err == nil {
+		t.Error("Expected error for canceled context")
+	}
+}
+
+// TestWithOfflineFallback_HourlyForecast tests staleness reporting on forecasts
+func TestWithOfflineFallback_HourlyForecast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "hourly": {"time": ["2025-12-29T10:00"], "temperature_2m": [4.5]}}`)
+	}))
+
+	client := NewClient(WithBaseURL(server.URL), WithOfflineFallback(time.Hour))
+	vars := []Variable{HourlyTemperature2m}
+	if _, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41, vars); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	server.Close()
+
+	forecast, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41, vars)
+	if err != nil {
+		t.Fatalf("Expected stale result, got %v", err)
+	}
+	if !forecast.Stale || forecast.Hourly.Get(HourlyTemperature2m)[0] != 4.5 {
+		t.Errorf("Expected stale forecast, got %+v", forecast)
+	}
+}
+
+// TestOfflineCache tests expiry and eviction of offline entries
+func TestOfflineCache(t *testing.T) {
+	now := time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)
+	o := &offlineCache{maxAge: time.Hour, now: func() time.Time { return now }, entries: make(map[string]offlineEntry)}
+
+	o.put("a", []byte("1"))
+	now = now.Add(30 * time.Minute)
+	if body, age, ok := o.get("a"); !ok || string(body) != "1" || age != 30*time.Minute {
+		t.Errorf("Expected entry aged 30m, got %q %v %v", body, age, ok)
+	}
+	now = now.Add(time.Hour)
+	if _, _, ok := o.get("a"); ok {
+		t.Error("Expected expired entry not to be served")
+	}
+
+	for i := range maxOfflineEntries + 1 {
+		now = now.Add(time.Second)
+		o.put(fmt.Sprint(i), nil)
+	}
+	if len(o.entries) != maxOfflineEntries {
+		t.Errorf("Expected %d entries, got %d", maxOfflineEntries, len(o.entries))
+	}
+	if _, ok := o.entries["a"]; ok {
+		t.Error("Expected oldest entry to be evicted")
+	}
+}
@@ -2,6 +2,7 @@ package openmeteo
 
 import (
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -47,6 +48,238 @@ func WithHTTPClient(httpClient *http.Client) Option {
 //	client := openmeteo.NewClient(openmeteo.WithBaseURL("http://localhost:8080"))
 func WithBaseURL(baseURL string) Option {
 	return func(c *Client) {
-		c.baseURL = baseURL
+		c.endpoints.Forecast = baseURL
+	}
+}
+
+// WithAPIKey sets the API key of a commercial Open Meteo subscription, which is sent as the
+// apikey query parameter of every request. Base URLs that point at the public open-meteo.com
+// hosts (including the defaults) are switched to their customer hosts, e.g.,
+// https://customer-api.open-meteo.com/v1; other base URLs (self-hosted instances, proxies and
+// mock servers) are left unchanged. The key is redacted from debug dumps and errors.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithAPIKey(os.Getenv("OPEN_METEO_API_KEY")))
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = strings.TrimSpace(key)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request. Open Meteo asks clients
+// to identify themselves, e.g., with the application name and a contact URL or address. The
+// default identifies the SDK.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithUserAgent("acme-dashboard/2.1 (ops@acme.example)"))
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.header.Set("User-Agent", userAgent)
+	}
+}
+
+// WithDefaultHeader adds an HTTP header to every request of the client, e.g., for corporate
+// proxies that require authentication or tagging headers. Setting the same key twice keeps
+// the last value. Headers set with the WithHeader request option take precedence, and the
+// X-Request-ID header is always controlled by the SDK (see WithRequestID).
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithDefaultHeader("Proxy-Authorization", "Basic "+token))
+func WithDefaultHeader(key, value string) Option {
+	return func(c *Client) {
+		c.header.Set(key, value)
+	}
+}
+
+// WithPathPrefix replaces the path component of the base URL (by default "/v1") with prefix.
+// This is useful for self-hosted Open Meteo instances served behind a reverse proxy under
+// a path prefix, or without the "/v1" segment. An empty prefix serves endpoints from the root.
+//
+// Example:
+//
+//	// Requests go to https://weather.internal/openmeteo/forecast
+//	client := openmeteo.NewClient(
+//	    openmeteo.WithBaseURL("https://weather.internal"),
+//	    openmeteo.WithPathPrefix("/openmeteo"),
+//	)
+func WithPathPrefix(prefix string) Option {
+	return func(c *Client) {
+		if prefix != "" && !strings.HasPrefix(prefix, "/") {
+			prefix = "/" + prefix
+		}
+		c.pathPrefix = &prefix
+	}
+}
+
+// WithGeocodingBaseURL sets a custom base URL for the Open Meteo geocoding API.
+// The default is https://geocoding-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithGeocodingBaseURL("http://localhost:8081"))
+func WithGeocodingBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.Geocoding = baseURL
+	}
+}
+
+// WithArchiveBaseURL sets a custom base URL for the Open Meteo historical weather (archive) API.
+// The default is https://archive-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithArchiveBaseURL("http://localhost:8082"))
+func WithArchiveBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.Archive = baseURL
+	}
+}
+
+// WithAirQualityBaseURL sets a custom base URL for the Open Meteo air quality API.
+// The default is https://air-quality-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithAirQualityBaseURL("http://localhost:8083"))
+func WithAirQualityBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.AirQuality = baseURL
+	}
+}
+
+// WithMarineBaseURL sets a custom base URL for the Open Meteo marine weather API.
+// The default is https://marine-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithMarineBaseURL("http://localhost:8087"))
+func WithMarineBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.Marine = baseURL
+	}
+}
+
+// WithFloodBaseURL sets a custom base URL for the Open Meteo flood API.
+// The default is https://flood-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithFloodBaseURL("http://localhost:8088"))
+func WithFloodBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.Flood = baseURL
+	}
+}
+
+// WithClimateBaseURL sets a custom base URL for the Open Meteo climate projection API.
+// The default is https://climate-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithClimateBaseURL("http://localhost:8086"))
+func WithClimateBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.Climate = baseURL
+	}
+}
+
+// WithEnsembleBaseURL sets a custom base URL for the Open Meteo ensemble API.
+// The default is https://ensemble-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithEnsembleBaseURL("http://localhost:8085"))
+func WithEnsembleBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.Ensemble = baseURL
+	}
+}
+
+// WithHistoricalForecastBaseURL sets a custom base URL for the Open Meteo historical forecast API.
+// The default is https://historical-forecast-api.open-meteo.com/v1
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithHistoricalForecastBaseURL("http://localhost:8084"))
+func WithHistoricalForecastBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.endpoints.HistoricalForecast = baseURL
+	}
+}
+
+// WithLegacyCurrentWeather makes GetCurrentWeather request the legacy current_weather block
+// (current_weather=true) instead of the modern current block. Use it with older mirrors or
+// self-hosted instances that do not support the current parameter. The legacy schema only
+// provides temperature, wind speed, wind direction, weather code and day/night; all other
+// CurrentWeather fields are left at their zero values.
+//
+// Responses are parsed according to the block actually returned, so this option is only
+// needed to change what is requested.
+func WithLegacyCurrentWeather() Option {
+	return func(c *Client) {
+		c.legacyCurrentWeather = true
+	}
+}
+
+// WithUnixTime requests timestamps as Unix seconds (timeformat=unixtime) instead of ISO 8601
+// strings. Results are the same either way, as both forms are parsed into time.Time in the
+// reported time zone; epoch integers are cheaper to decode for large hourly responses.
+// Time-valued variables such as sunrise are returned as Unix seconds in both cases.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithUnixTime())
+func WithUnixTime() Option {
+	return func(c *Client) {
+		c.unixTime = true
+	}
+}
+
+// WithBlockingConcurrency controls what happens when the client's concurrency limit
+// (10 simultaneous requests) is reached. By default surplus requests fail immediately with
+// an ErrorTypeValidation error; with blocking enabled they wait for a free slot instead,
+// until their context is cancelled or its deadline expires.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithBlockingConcurrency(true))
+func WithBlockingConcurrency(blocking bool) Option {
+	return func(c *Client) {
+		c.blockingConcurrency = blocking
+	}
+}
+
+// WithUnits makes the API return temperatures, wind speeds and precipitation in the given
+// units by setting the temperature_unit, wind_speed_unit and precipitation_unit parameters on
+// forecast, ensemble, historical and climate requests. The units in use are recorded on the
+// results (see CurrentWeather.Units) and series report them through Series.Unit. Unsupported
+// units are ignored and the metric default is kept. Helpers that apply metric thresholds
+// (e.g., storm, road risk and umbrella checks) convert values to the default units first;
+// use WithDisplayUnits instead to only format values in other units.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithUnits(openmeteo.UnitsImperial))
+func WithUnits(units UnitSystem) Option {
+	return func(c *Client) {
+		c.units = units.normalize()
+	}
+}
+
+// WithDisplayUnits sets the display preference attached to every CurrentWeather returned
+// by the client, so that QuantityOf... methods render e.g. "59.5°F" or "7.8 mph".
+// Stored field values keep the units the API returned them in (metric unless WithUnits is
+// used). The preference can also be changed per result through the
+// CurrentWeather.DisplayUnits field.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithDisplayUnits(openmeteo.DisplayUnitsImperial))
+func WithDisplayUnits(units DisplayUnits) Option {
+	return func(c *Client) {
+		c.displayUnits = units
 	}
 }
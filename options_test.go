@@ -1,7 +1,13 @@
 package openmeteo
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -40,8 +46,8 @@ func TestWithBaseURL(t *testing.T) {
 	customURL := "https://custom-api.example.com/v2"
 	client := NewClient(WithBaseURL(customURL))
 
-	if client.baseURL != customURL {
-		t.Errorf("Expected base URL %s, got %s", customURL, client.baseURL)
+	if client.endpoints.Forecast != customURL {
+		t.Errorf("Expected base URL %s, got %s", customURL, client.endpoints.Forecast)
 	}
 }
 
@@ -62,8 +68,8 @@ func TestMultipleOptions(t *testing.T) {
 	if client.httpClient != customClient {
 		t.Error("Expected custom HTTP client")
 	}
-	if client.baseURL != customURL {
-		t.Errorf("Expected base URL %s, got %s", customURL, client.baseURL)
+	if client.endpoints.Forecast != customURL {
+		t.Errorf("Expected base URL %s, got %s", customURL, client.endpoints.Forecast)
 	}
 	// Note: WithHTTPClient overrides timeout set by WithTimeout
 	if client.httpClient.Timeout != customTimeout {
@@ -98,8 +104,8 @@ func TestWithTimeout_ZeroValue(t *testing.T) {
 func TestWithBaseURL_EmptyString(t *testing.T) {
 	client := NewClient(WithBaseURL(""))
 
-	if client.baseURL != "" {
-		t.Errorf("Expected empty base URL, got %s", client.baseURL)
+	if client.endpoints.Forecast != "" {
+		t.Errorf("Expected empty base URL, got %s", client.endpoints.Forecast)
 	}
 }
 
@@ -110,10 +116,262 @@ func TestDefaultsWithoutOptions(t *testing.T) {
 	if client.httpClient.Timeout != defaultTimeout {
 		t.Errorf("Expected default timeout %v, got %v", defaultTimeout, client.httpClient.Timeout)
 	}
-	if client.baseURL != defaultBaseURL {
-		t.Errorf("Expected default base URL %s, got %s", defaultBaseURL, client.baseURL)
+	if client.endpoints.Forecast != defaultBaseURL {
+		t.Errorf("Expected default base URL %s, got %s", defaultBaseURL, client.endpoints.Forecast)
 	}
 	if cap(client.semaphore) != maxConcurrent {
 		t.Errorf("Expected semaphore capacity %d, got %d", maxConcurrent, cap(client.semaphore))
 	}
 }
+
+// TestWithEndpoints tests that services are routed to their configured base URLs
+func TestWithEndpoints(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "results": [], "current": {"time": "2025-12-29T10:00"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoints(Endpoints{
+		Forecast:  server.URL + "/v1",
+		Geocoding: server.URL + "/geo/v1",
+	}))
+	endpoints := client.Endpoints()
+	if endpoints.Archive != defaultArchiveBaseURL || endpoints.Marine != defaultMarineBaseURL {
+		t.Errorf("Expected unset endpoints to keep their defaults, got %+v", endpoints)
+	}
+
+	if _, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.SearchLocations(context.Background(), "Berlin"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := []string{"/v1/forecast", "/geo/v1/search"}; strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("Expected paths %v, got %v", want, paths)
+	}
+
+	client = NewClient(WithArchiveBaseURL("http://localhost:8082"), WithEndpoints(Endpoints{Flood: "http://localhost:8088"}), WithAPIKey("secret"))
+	endpoints = client.Endpoints()
+	if endpoints.Archive != "http://localhost:8082" || endpoints.Flood != "http://localhost:8088" {
+		t.Errorf("Expected custom endpoints to be kept, got %+v", endpoints)
+	}
+	if endpoints.Forecast != "https://customer-api.open-meteo.com/v1" {
+		t.Errorf("Expected customer forecast host, got %s", endpoints.Forecast)
+	}
+}
+
+// TestWithSelfHosted tests that all services share the instance and public API limits are lifted
+func TestWithSelfHosted(t *testing.T) {
+	var paths []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00"}, "hourly": {"time": []}}`)
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	client := NewClient(WithAPIKey("secret"), WithSelfHosted(host))
+	for _, base := range client.endpoints.fields() {
+		if *base != server.URL+"/v1" {
+			t.Errorf("Expected %s/v1, got %s", server.URL, *base)
+		}
+	}
+	if got := NewClient(WithSelfHosted("https://weather.internal/om/")).Endpoints().Archive; got != "https://weather.internal/om" {
+		t.Errorf("Expected path to be kept, got %s", got)
+	}
+
+	if _, err := client.GetAirQuality(context.Background(), 52.52, 13.41, []Variable{HourlyBirchPollen}, WithForecastDays(10)); err != nil {
+		t.Fatalf("Expected public date limits to be skipped, got %v", err)
+	}
+
+	if client.semaphore != nil {
+		t.Error("Expected no concurrency limit")
+	}
+	coords := make([]Coordinates, 2*maxConcurrent)
+	for i, result := range client.GetCurrentWeatherMany(context.Background(), coords) {
+		if result.Err != nil {
+			t.Fatalf("Expected no error for coordinate %d, got %v", i, result.Err)
+		}
+	}
+	if paths[0] != "/v1/air-quality" || paths[1] != "/v1/forecast" {
+		t.Errorf("Expected self-hosted paths, got %v", paths[:2])
+	}
+}
+
+// TestWithPathPrefix tests that the path prefix replaces the base URL path
+func TestWithPathPrefix(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseURL  string
+		prefix   *string
+		expected string
+	}{
+		{"Default", defaultBaseURL, nil, "https://api.open-meteo.com/v1/forecast"},
+		{"Trailing slash", "https://example.com/v1/", nil, "https://example.com/v1/forecast"},
+		{"Prefix replaces v1", defaultBaseURL, ptr("/openmeteo"), "https://api.open-meteo.com/openmeteo/forecast"},
+		{"Prefix without slash", "https://weather.internal", ptr("proxy/om/"), "https://weather.internal/proxy/om/forecast"},
+		{"Empty prefix", "https://weather.internal/v1", ptr(""), "https://weather.internal/forecast"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := []Option{WithBaseURL(tc.baseURL)}
+			if tc.prefix != nil {
+				opts = append(opts, WithPathPrefix(*tc.prefix))
+			}
+			client := NewClient(opts...)
+
+			u, err := client.endpointURL(client.endpoints.Forecast, "/forecast")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if u.String() != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, u.String())
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// TestWithDisplayUnits tests that the display preference is attached to results
+func TestWithDisplayUnits(t *testing.T) {
+	client := NewClient(WithDisplayUnits(DisplayUnitsImperial))
+	weather := client.convertToCurrentWeather(weatherResponse{}, client.units)
+
+	if weather.DisplayUnits != DisplayUnitsImperial {
+		t.Errorf("Expected imperial display units, got %+v", weather.DisplayUnits)
+	}
+}
+
+// TestWithAPIKey tests the apikey parameter, customer hosts and redaction from errors
+func TestWithAPIKey(t *testing.T) {
+	client := NewClient(WithAPIKey(" secret "), WithMarineBaseURL("http://localhost:8080/v1"))
+	if client.endpoints.Forecast != "https://customer-api.open-meteo.com/v1" || client.endpoints.Archive != "https://customer-archive-api.open-meteo.com/v1" {
+		t.Errorf("Expected customer hosts, got %s and %s", client.endpoints.Forecast, client.endpoints.Archive)
+	}
+	if client.endpoints.Geocoding != "https://customer-geocoding-api.open-meteo.com/v1" {
+		t.Errorf("Expected customer geocoding host, got %s", client.endpoints.Geocoding)
+	}
+	if client.endpoints.Marine != "http://localhost:8080/v1" {
+		t.Errorf("Expected custom host to be kept, got %s", client.endpoints.Marine)
+	}
+	if NewClient().endpoints.Forecast != defaultBaseURL {
+		t.Error("Expected public hosts without an API key")
+	}
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.URL.Query().Get("apikey"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "results": [], "current": {"time": "2025-12-29T10:00"}}`)
+	}))
+	defer server.Close()
+	client = NewClient(WithAPIKey("secret"), WithBaseURL(server.URL), WithGeocodingBaseURL(server.URL))
+	if _, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.SearchLocations(context.Background(), "Berlin"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(keys) != 2 || keys[0] != "secret" || keys[1] != "secret" {
+		t.Errorf("Expected apikey on every request, got %v", keys)
+	}
+
+	client = NewClient(WithAPIKey("s3cr3t/key"), WithBaseURL("http://127.0.0.1:1"))
+	_, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err == nil || strings.Contains(err.Error(), "s3cr3t") || !strings.Contains(err.Error(), "REDACTED") {
+		t.Errorf("Expected the API key to be redacted from the error, got %v", err)
+	}
+}
+
+// TestWithUserAgent tests the default and custom User-Agent and client-wide headers
+func TestWithUserAgent(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00"}}`)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	if _, err := NewClient(WithBaseURL(server.URL)).GetCurrentWeather(ctx, 52.52, 13.41); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client := NewClient(WithBaseURL(server.URL),
+		WithUserAgent("acme-dashboard/2.1"),
+		WithDefaultHeader("X-Proxy-Tag", "weather"),
+		WithDefaultHeader("X-Tenant-ID", "default"),
+		WithDefaultHeader(RequestIDHeader, "ignored"),
+	)
+	if _, err := client.GetCurrentWeather(WithRequestID(ctx, "req-1"), 52.52, 13.41, WithHeader("X-Tenant-ID", "acme")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := headers[0].Get("User-Agent"); got != defaultUserAgent {
+		t.Errorf("Expected default User-Agent %q, got %q", defaultUserAgent, got)
+	}
+	h := headers[1]
+	if h.Get("User-Agent") != "acme-dashboard/2.1" || h.Get("X-Proxy-Tag") != "weather" {
+		t.Errorf("Expected client headers on the request, got %v", h)
+	}
+	if h.Get("X-Tenant-ID") != "acme" || h.Get(RequestIDHeader) != "req-1" {
+		t.Errorf("Expected request headers and the request ID to take precedence, got %v", h)
+	}
+}
+
+// TestWithUnits tests the unit parameters, the recorded units and unit-aware formatting
+func TestWithUnits(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 59.5, "wind_speed_10m": 10, "snowfall": 1}, "hourly": {"time": []}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithAirQualityBaseURL(server.URL), WithUnits(UnitsImperial))
+	weather, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	q := queries[0]
+	if q.Get("temperature_unit") != "fahrenheit" || q.Get("wind_speed_unit") != "mph" || q.Get("precipitation_unit") != "inch" {
+		t.Errorf("Expected imperial unit parameters, got %v", q)
+	}
+	if weather.Units != UnitsImperial {
+		t.Errorf("Expected imperial units on the result, got %+v", weather.Units)
+	}
+	if got := weather.QuantityOfTemperature(); got != "59.5°F" {
+		t.Errorf("Expected 59.5°F, got %s", got)
+	}
+	if got := weather.QuantityOfSnowfall(); got != "1.0 inch" {
+		t.Errorf("Expected snowfall in inches, got %s", got)
+	}
+	weather.DisplayUnits = DisplayUnits{Temperature: UnitCelsius}
+	if got := weather.QuantityOfTemperature(); got != "15.3°C" {
+		t.Errorf("Expected conversion from the returned unit, got %s", got)
+	}
+
+	if _, err := client.GetAirQuality(context.Background(), 52.52, 13.41, []Variable{HourlyBirchPollen}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if queries[1].Has("temperature_unit") {
+		t.Errorf("Expected no unit parameters on air quality requests, got %v", queries[1])
+	}
+
+	client = NewClient(WithUnits(UnitSystem{Temperature: UnitKelvin, WindSpeed: UnitKnots, Precipitation: UnitMillimeter}))
+	if client.units != (UnitSystem{WindSpeed: UnitKnots}) {
+		t.Errorf("Expected unsupported and default units to be dropped, got %+v", client.units)
+	}
+}
@@ -0,0 +1,133 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+// Allergen identifies a pollen type tracked by the air quality API.
+type Allergen string
+
+const (
+	AllergenAlder   Allergen = "alder"
+	AllergenBirch   Allergen = "birch"
+	AllergenGrass   Allergen = "grass"
+	AllergenMugwort Allergen = "mugwort"
+	AllergenOlive   Allergen = "olive"
+	AllergenRagweed Allergen = "ragweed"
+)
+
+// allergens lists all allergens in a stable order
+var allergens = []Allergen{AllergenAlder, AllergenBirch, AllergenGrass, AllergenMugwort, AllergenOlive, AllergenRagweed}
+
+// pollenThresholds holds the concentrations (grains/m³) at which the risk of symptoms becomes
+// medium and high. The values follow commonly used European pollen information scales and are
+// indicative; highly allergenic pollen (ragweed) triggers symptoms at lower concentrations.
+var pollenThresholds = map[Allergen][2]float64{
+	AllergenAlder:   {10, 50},
+	AllergenBirch:   {10, 50},
+	AllergenGrass:   {5, 30},
+	AllergenMugwort: {5, 30},
+	AllergenOlive:   {10, 50},
+	AllergenRagweed: {5, 20},
+}
+
+// Variable returns the hourly pollen variable of the allergen (e.g., "birch_pollen").
+func (a Allergen) Variable() Variable {
+	return Variable(string(a) + "_pollen")
+}
+
+// Risk grades a pollen concentration in grains/m³ for the allergen.
+// Missing values (NaN) and unknown allergens are graded as low.
+func (a Allergen) Risk(concentration float64) RiskLevel {
+	thresholds, ok := pollenThresholds[a]
+	switch {
+	case !ok || math.IsNaN(concentration) || concentration < thresholds[0]:
+		return RiskLow
+	case concentration < thresholds[1]:
+		return RiskMedium
+	default:
+		return RiskHigh
+	}
+}
+
+// PollenDay is the pollen risk summary of one local calendar day.
+type PollenDay struct {
+	// Date is local midnight at the start of the day, in the series' Location
+	Date time.Time
+
+	// Peak holds the highest hourly concentration per allergen in grains/m³
+	// (allergens without data on this day are omitted)
+	Peak map[Allergen]float64
+
+	// Risk holds the risk level per allergen, graded from the peak concentration
+	Risk map[Allergen]RiskLevel
+
+	// Worst is the allergen with the highest risk (ties are broken by the peak relative to
+	// the allergen's high threshold); empty if no allergen has data
+	Worst Allergen
+
+	// WorstRisk is the risk level of Worst
+	WorstRisk RiskLevel
+}
+
+// PollenRisk computes daily pollen risk levels per allergen from an hourly series of pollen
+// variables and summarizes the worst allergen of each day. Only the allergens of the user's
+// allergy profile are considered; an empty profile considers all allergens.
+//
+// Example:
+//
+//	aq, err := client.GetAirQuality(ctx, 52.52, 13.41, openmeteo.PollenVariables,
+//	    openmeteo.WithTimezone("Europe/Berlin"))
+//	if err != nil {
+//	    return err
+//	}
+//	today := openmeteo.PollenRisk(&aq.Hourly, openmeteo.AllergenBirch, openmeteo.AllergenGrass)[0]
+//	fmt.Printf("worst today: %s (%s)\n", today.Worst, today.WorstRisk)
+func PollenRisk(hourly *Series, profile ...Allergen) []PollenDay {
+	if len(profile) == 0 {
+		profile = allergens
+	}
+
+	var days []PollenDay
+	index := make(map[time.Time]int)
+	for _, allergen := range profile {
+		for _, d := range hourly.AggregateDaily(allergen.Variable(), AggregateMax) {
+			i, ok := index[d.Date]
+			if !ok {
+				i = len(days)
+				index[d.Date] = i
+				days = append(days, PollenDay{
+					Date: d.Date,
+					Peak: make(map[Allergen]float64),
+					Risk: make(map[Allergen]RiskLevel),
+				})
+			}
+			if math.IsNaN(d.Value) {
+				continue
+			}
+			day := &days[i]
+			day.Peak[allergen] = d.Value
+			day.Risk[allergen] = allergen.Risk(d.Value)
+		}
+	}
+
+	for i := range days {
+		day := &days[i]
+		worstScore := -1.0
+		for _, allergen := range profile {
+			peak, ok := day.Peak[allergen]
+			if !ok {
+				continue
+			}
+			score := float64(day.Risk[allergen]) + min(peak/pollenThresholds[allergen][1], 1)*0.999
+			if score > worstScore {
+				worstScore = score
+				day.Worst, day.WorstRisk = allergen, day.Risk[allergen]
+			}
+		}
+	}
+	return days
+}
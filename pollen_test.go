@@ -0,0 +1,83 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestAllergen_Risk tests grading of pollen concentrations
+func TestAllergen_Risk(t *testing.T) {
+	tests := []struct {
+		allergen      Allergen
+		concentration float64
+		want          RiskLevel
+	}{
+		{AllergenBirch, 0, RiskLow},
+		{AllergenBirch, 10, RiskMedium},
+		{AllergenBirch, 50, RiskHigh},
+		{AllergenRagweed, 19, RiskMedium},
+		{AllergenRagweed, 20, RiskHigh},
+		{AllergenGrass, math.NaN(), RiskLow},
+		{Allergen("cedar"), 1000, RiskLow},
+	}
+	for _, tt := range tests {
+		if got := tt.allergen.Risk(tt.concentration); got != tt.want {
+			t.Errorf("%s at %v: expected %s, got %s", tt.allergen, tt.concentration, tt.want, got)
+		}
+	}
+	if AllergenMugwort.Variable() != HourlyMugwortPollen {
+		t.Errorf("Unexpected variable %q", AllergenMugwort.Variable())
+	}
+}
+
+// TestPollenRisk tests daily risk levels and the worst allergen summary
+func TestPollenRisk(t *testing.T) {
+	start := time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)
+	n := 48
+	birch := make([]float64, n)
+	grass := make([]float64, n)
+	ragweed := make([]float64, n)
+	for i := range n {
+		birch[i] = math.NaN()
+		grass[i] = 2
+		ragweed[i] = 0
+	}
+	birch[10] = 40   // day 1: medium, 80% of high threshold
+	grass[12] = 25   // day 1: medium, 83% of high threshold
+	ragweed[30] = 22 // day 2: high
+
+	s := hourlySeries(start, map[Variable][]float64{
+		HourlyBirchPollen:   birch,
+		HourlyGrassPollen:   grass,
+		HourlyRagweedPollen: ragweed,
+	})
+
+	days := PollenRisk(&s)
+	if len(days) != 2 {
+		t.Fatalf("Expected 2 days, got %d", len(days))
+	}
+	if days[0].Worst != AllergenGrass || days[0].WorstRisk != RiskMedium {
+		t.Errorf("Day 1: expected grass (medium), got %s (%s)", days[0].Worst, days[0].WorstRisk)
+	}
+	if days[0].Risk[AllergenBirch] != RiskMedium || days[0].Peak[AllergenBirch] != 40 {
+		t.Errorf("Day 1: unexpected birch risk %v", days[0])
+	}
+	if _, ok := days[1].Peak[AllergenBirch]; ok {
+		t.Error("Day 2: expected birch without data to be omitted")
+	}
+	if days[1].Worst != AllergenRagweed || days[1].WorstRisk != RiskHigh {
+		t.Errorf("Day 2: expected ragweed (high), got %s (%s)", days[1].Worst, days[1].WorstRisk)
+	}
+
+	// The allergy profile restricts the allergens considered
+	days = PollenRisk(&s, AllergenBirch)
+	if days[0].Worst != AllergenBirch || days[1].Worst != "" {
+		t.Errorf("Expected birch-only profile, got %s/%s", days[0].Worst, days[1].Worst)
+	}
+	if _, ok := days[0].Risk[AllergenGrass]; ok {
+		t.Error("Expected grass to be excluded from profile")
+	}
+}
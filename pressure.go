@@ -0,0 +1,202 @@
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// pressureTendencyPeriod is the period over which the pressure tendency is measured
+	pressureTendencyPeriod = 3 * time.Hour
+
+	// steadyPressureChange is the 3-hour change (hPa) below which pressure is steady
+	steadyPressureChange = 1.0
+
+	// rapidPressureChange is the 3-hour change (hPa) from which pressure changes rapidly
+	// ("quickly" on the Met Office scale)
+	rapidPressureChange = 3.6
+
+	// pressureHistoryRetention is how long PressureHistory keeps readings
+	pressureHistoryRetention = 6 * time.Hour
+)
+
+// Trend is the direction of a change in pressure.
+type Trend int
+
+const (
+	// TrendSteady indicates a change of less than 1 hPa in 3 hours
+	TrendSteady Trend = iota
+
+	// TrendRising indicates rising pressure
+	TrendRising
+
+	// TrendFalling indicates falling pressure
+	TrendFalling
+)
+
+// String returns the name of the trend ("steady", "rising" or "falling").
+func (t Trend) String() string {
+	switch t {
+	case TrendSteady:
+		return "steady"
+	case TrendRising:
+		return "rising"
+	case TrendFalling:
+		return "falling"
+	default:
+		return fmt.Sprintf("Trend(%d)", int(t))
+	}
+}
+
+// PressureTendency is the change in mean sea level pressure over the 3 hours up to Time,
+// a classic input for local forecasting rules (e.g., rapidly falling pressure announces
+// wind and rain).
+type PressureTendency struct {
+	// Time is the time of the latest reading
+	Time time.Time
+
+	// Pressure is the latest pressure in hPa
+	Pressure float64
+
+	// Trend is the direction of the change
+	Trend Trend
+
+	// Change is the pressure change over 3 hours in hPa (negative when falling)
+	Change float64
+
+	// Rate is the pressure change in hPa per hour
+	Rate float64
+
+	// Rapid reports a change of at least 3.6 hPa in 3 hours
+	Rapid bool
+}
+
+// pressureReading is a pressure value at a point in time.
+type pressureReading struct {
+	time     time.Time
+	pressure float64
+}
+
+// newPressureTendency computes the tendency between two readings, scaled to 3 hours.
+func newPressureTendency(from, to pressureReading) PressureTendency {
+	rate := (to.pressure - from.pressure) / to.time.Sub(from.time).Hours()
+	change := rate * pressureTendencyPeriod.Hours()
+	tendency := PressureTendency{
+		Time:     to.time,
+		Pressure: to.pressure,
+		Change:   change,
+		Rate:     rate,
+		Rapid:    math.Abs(change) >= rapidPressureChange,
+	}
+	switch {
+	case change >= steadyPressureChange:
+		tendency.Trend = TrendRising
+	case change <= -steadyPressureChange:
+		tendency.Trend = TrendFalling
+	}
+	return tendency
+}
+
+// PressureTendencyAt computes the 3-hour pressure tendency at time at from an hourly series
+// containing HourlyPressureMSL (in hPa). It compares the last step at or before at with the
+// step 3 hours earlier, so the series must include past data (e.g., WithHourRange starting
+// a few hours ago). It returns an error if either pressure value is missing.
+//
+// Example:
+//
+//	now := time.Now()
+//	f, err := client.GetHourlyForecast(ctx, 50.11, 8.68, []openmeteo.Variable{openmeteo.HourlyPressureMSL},
+//	    openmeteo.WithHourRange(now.Add(-6*time.Hour), now.Add(6*time.Hour)))
+//	if err != nil {
+//	    return err
+//	}
+//	tendency, err := openmeteo.PressureTendencyAt(&f.Hourly, now)
+//	if err == nil && tendency.Trend == openmeteo.TrendFalling && tendency.Rapid {
+//	    fmt.Println("pressure falling rapidly: expect wind and rain")
+//	}
+func PressureTendencyAt(hourly *Series, at time.Time) (PressureTendency, error) {
+	to := hourly.indexAt(at)
+	if to < 0 {
+		return PressureTendency{}, fmt.Errorf("series has no data at or before %s", at.UTC().Format(apiTimeLayout))
+	}
+	from := hourly.indexAt(hourly.Time[to].Add(-pressureTendencyPeriod))
+	if from < 0 || from == to {
+		return PressureTendency{}, fmt.Errorf("series has no data 3 hours before %s", hourly.Time[to].UTC().Format(apiTimeLayout))
+	}
+
+	for _, i := range []int{from, to} {
+		if math.IsNaN(hourly.valueAt(HourlyPressureMSL, i)) {
+			return PressureTendency{}, fmt.Errorf("pressure is missing at %s", hourly.Time[i].UTC().Format(apiTimeLayout))
+		}
+	}
+	return newPressureTendency(
+		pressureReading{time: hourly.Time[from], pressure: hourly.valueAt(HourlyPressureMSL, from)},
+		pressureReading{time: hourly.Time[to], pressure: hourly.valueAt(HourlyPressureMSL, to)},
+	), nil
+}
+
+// PressureHistory collects the pressure of repeated current observations (e.g., from polling
+// GetCurrentWeather) to compute the pressure tendency without requesting hourly data.
+// Readings older than 6 hours are discarded. The zero value is ready to use, and a
+// PressureHistory is safe for concurrent use.
+//
+// Example:
+//
+//	var history openmeteo.PressureHistory
+//	for range time.Tick(15 * time.Minute) {
+//	    weather, err := client.GetCurrentWeather(ctx, 50.11, 8.68)
+//	    if err != nil {
+//	        continue
+//	    }
+//	    history.Add(weather)
+//	    if tendency, err := history.Tendency(); err == nil {
+//	        fmt.Printf("%s (%+.1f hPa/3h)\n", tendency.Trend, tendency.Change)
+//	    }
+//	}
+type PressureHistory struct {
+	mu       sync.Mutex
+	readings []pressureReading
+}
+
+// Add records the mean sea level pressure of an observation. Observations without a
+// pressure value, and observations not newer than the latest reading, are ignored.
+func (h *PressureHistory) Add(w *CurrentWeather) {
+	if w == nil || math.IsNaN(w.PressureMSL) || w.PressureMSL == 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if n := len(h.readings); n > 0 && !w.Time.After(h.readings[n-1].time) {
+		return
+	}
+	h.readings = append(h.readings, pressureReading{time: w.Time, pressure: w.PressureMSL})
+
+	cutoff := w.Time.Add(-pressureHistoryRetention)
+	drop := 0
+	for drop < len(h.readings) && h.readings[drop].time.Before(cutoff) {
+		drop++
+	}
+	h.readings = h.readings[drop:]
+}
+
+// Tendency computes the 3-hour pressure tendency from the latest reading and the last
+// reading at least 3 hours older. It returns an error until readings span 3 hours.
+func (h *PressureHistory) Tendency() (PressureTendency, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	n := len(h.readings)
+	if n == 0 {
+		return PressureTendency{}, fmt.Errorf("no pressure readings")
+	}
+	latest := h.readings[n-1]
+	for i := n - 2; i >= 0; i-- {
+		if !h.readings[i].time.After(latest.time.Add(-pressureTendencyPeriod)) {
+			return newPressureTendency(h.readings[i], latest), nil
+		}
+	}
+	return PressureTendency{}, fmt.Errorf("pressure readings span less than 3 hours")
+}
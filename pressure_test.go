@@ -0,0 +1,117 @@
+package openmeteo
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pressureSeries builds an hourly pressure series starting at start
+func pressureSeries(start time.Time, values ...float64) Series {
+	s := Series{Values: map[Variable][]float64{HourlyPressureMSL: values}, Units: map[Variable]string{}, Location: time.UTC}
+	for i := range values {
+		s.Time = append(s.Time, start.Add(time.Duration(i)*time.Hour))
+	}
+	return s
+}
+
+// TestPressureTendencyAt tests the 3-hour tendency from hourly data
+func TestPressureTendencyAt(t *testing.T) {
+	start := time.Date(2025, 11, 3, 6, 0, 0, 0, time.UTC)
+	s := pressureSeries(start, 1015, 1014.8, 1014.5, 1014.2, 1012, 1010, 1009.5, math.NaN())
+
+	tests := []struct {
+		name       string
+		at         time.Time
+		wantTrend  Trend
+		wantChange float64
+		wantRapid  bool
+	}{
+		{"steady", start.Add(3 * time.Hour), TrendSteady, -0.8, false},
+		{"falling", start.Add(4 * time.Hour), TrendFalling, -2.8, false},
+		{"falling rapidly between steps", start.Add(5*time.Hour + 30*time.Minute), TrendFalling, -4.5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PressureTendencyAt(&s, tt.at)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got.Trend != tt.wantTrend || math.Abs(got.Change-tt.wantChange) > 1e-9 || got.Rapid != tt.wantRapid {
+				t.Errorf("Expected %s %.1f hPa (rapid=%v), got %+v", tt.wantTrend, tt.wantChange, tt.wantRapid, got)
+			}
+			if math.Abs(got.Rate-got.Change/3) > 1e-9 {
+				t.Errorf("Expected rate per hour, got %v", got.Rate)
+			}
+		})
+	}
+
+	rising := pressureSeries(start, 1000, 1001, 1002, 1003.5)
+	if got, err := PressureTendencyAt(&rising, start.Add(3*time.Hour)); err != nil || got.Trend != TrendRising || got.Pressure != 1003.5 {
+		t.Errorf("Expected rising pressure, got %+v (%v)", got, err)
+	}
+
+	for _, at := range []time.Time{start.Add(-time.Hour), start.Add(2 * time.Hour), start.Add(7 * time.Hour)} {
+		if _, err := PressureTendencyAt(&s, at); err == nil {
+			t.Errorf("Expected error at %s", at)
+		}
+	}
+}
+
+// TestPressureHistory tests the tendency from repeated current observations
+func TestPressureHistory(t *testing.T) {
+	var h PressureHistory
+	if _, err := h.Tendency(); err == nil {
+		t.Error("Expected error without readings")
+	}
+
+	start := time.Date(2025, 11, 3, 6, 0, 0, 0, time.UTC)
+	for i := range 12 {
+		h.Add(&CurrentWeather{Time: start.Add(time.Duration(i) * 15 * time.Minute), PressureMSL: 1010 + float64(i)*0.5})
+	}
+	_, err := h.Tendency()
+	if err == nil || !strings.Contains(err.Error(), "less than 3 hours") {
+		t.Errorf("Expected error before readings span 3 hours, got %v", err)
+	}
+
+	h.Add(&CurrentWeather{Time: start.Add(3 * time.Hour), PressureMSL: 1016})
+	h.Add(&CurrentWeather{Time: start.Add(3 * time.Hour), PressureMSL: 900})        // duplicate time
+	h.Add(&CurrentWeather{Time: start.Add(4 * time.Hour), PressureMSL: math.NaN()}) // missing
+	h.Add(nil)
+	got, err := h.Tendency()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got.Trend != TrendRising || got.Change != 6 || !got.Rapid || got.Pressure != 1016 {
+		t.Errorf("Expected rapidly rising pressure, got %+v", got)
+	}
+
+	// Old readings are discarded
+	h.Add(&CurrentWeather{Time: start.Add(12 * time.Hour), PressureMSL: 1016})
+	if _, err := h.Tendency(); err == nil {
+		t.Error("Expected error after a gap in readings")
+	}
+	if len(h.readings) != 1 {
+		t.Errorf("Expected old readings to be discarded, got %d", len(h.readings))
+	}
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.Add(&CurrentWeather{Time: start.Add(13*time.Hour + time.Duration(i)*time.Minute), PressureMSL: 1016})
+			_, _ = h.Tendency()
+		}()
+	}
+	wg.Wait()
+}
+
+// TestTrend_String tests trend names
+func TestTrend_String(t *testing.T) {
+	if TrendSteady.String() != "steady" || TrendRising.String() != "rising" || TrendFalling.String() != "falling" || Trend(9).String() != "Trend(9)" {
+		t.Error("Unexpected trend names")
+	}
+}
@@ -0,0 +1,94 @@
+package openmeteo
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Progress reports the state of a bulk operation (see WithProgress).
+type Progress struct {
+	// Done is the number of completed items (chunks of a historical download or entries of a batch)
+	Done int
+
+	// Total is the number of items of the operation
+	Total int
+
+	// Failed is the number of completed items that failed
+	Failed int
+
+	// Bytes is the number of response bytes received so far
+	Bytes int64
+
+	// Item describes the item that just completed (e.g., "2001-01-01 to 2001-12-31" for a chunk)
+	Item string
+
+	// Elapsed is the time since the operation started
+	Elapsed time.Duration
+
+	// ETA is the estimated remaining time, extrapolated from the average time per item
+	// (zero once all items are done)
+	ETA time.Duration
+}
+
+// WithProgress calls fn after every completed item of a bulk operation: each chunk of
+// DownloadHistoricalHourly and DownloadHistoricalDaily, and each entry of
+// GetCurrentWeatherMany. Calls are serialized, so fn does not need to be safe for concurrent
+// use, but it should return quickly. To stop an operation mid-way, cancel its context; items
+// in flight are abandoned and no further items are started. Other calls ignore this option.
+//
+// Example:
+//
+//	history, err := client.DownloadHistoricalHourly(ctx, 52.52, 13.41, vars, start, end,
+//	    openmeteo.WithProgress(func(p openmeteo.Progress) {
+//	        fmt.Printf("\r%d/%d chunks, %d kB, %s left", p.Done, p.Total, p.Bytes/1024, p.ETA.Round(time.Second))
+//	    }))
+func WithProgress(fn func(Progress)) RequestOption {
+	return func(r *requestConfig) {
+		r.progress = fn
+	}
+}
+
+// progressTracker aggregates the progress of a bulk operation. It is safe for concurrent use.
+type progressTracker struct {
+	fn    func(Progress)
+	total int
+	start time.Time
+	bytes atomic.Int64
+
+	mu     sync.Mutex
+	done   int
+	failed int
+}
+
+// newProgressTracker starts tracking an operation of total items for fn, which may be nil.
+func newProgressTracker(fn func(Progress), total int) *progressTracker {
+	return &progressTracker{fn: fn, total: total, start: time.Now()}
+}
+
+// complete records a finished item and reports the progress.
+func (t *progressTracker) complete(item string, err error) {
+	if t.fn == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.done++
+	if err != nil {
+		t.failed++
+	}
+	elapsed := time.Since(t.start)
+	p := Progress{
+		Done:    t.done,
+		Total:   t.total,
+		Failed:  t.failed,
+		Bytes:   t.bytes.Load(),
+		Item:    item,
+		Elapsed: elapsed,
+	}
+	if t.done < t.total {
+		p.ETA = elapsed / time.Duration(t.done) * time.Duration(t.total-t.done)
+	}
+	t.fn(p)
+}
@@ -0,0 +1,115 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestWithProgress_Historical tests progress reports for the chunks of a historical download
+func TestWithProgress_Historical(t *testing.T) {
+	server := httptest.NewServer(archiveHandler(t, nil, nil))
+	defer server.Close()
+
+	var reports []Progress
+	client := NewClient(WithArchiveBaseURL(server.URL))
+	start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)
+	_, err := client.DownloadHistoricalHourly(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m}, start, end,
+		WithProgress(func(p Progress) { reports = append(reports, p) }))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(reports) != 3 {
+		t.Fatalf("Expected 3 reports, got %+v", reports)
+	}
+	items := map[string]bool{}
+	for i, p := range reports {
+		items[p.Item] = true
+		if p.Done != i+1 || p.Total != 3 || p.Failed != 0 {
+			t.Errorf("Report %d: unexpected counts %+v", i, p)
+		}
+		if i > 0 && p.Bytes < reports[i-1].Bytes {
+			t.Errorf("Report %d: expected non-decreasing bytes, got %d after %d", i, p.Bytes, reports[i-1].Bytes)
+		}
+	}
+	if !items["2020-03-01 to 2021-02-28"] || !items["2022-03-01 to 2022-03-02"] {
+		t.Errorf("Expected chunk ranges as items, got %v", items)
+	}
+	last := reports[len(reports)-1]
+	if last.Bytes == 0 || last.ETA != 0 || last.Elapsed <= 0 {
+		t.Errorf("Unexpected final report %+v", last)
+	}
+}
+
+// TestWithProgress_Batch tests progress reports, failures and cancellation for GetCurrentWeatherMany
+func TestWithProgress_Batch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lat, _ := strconv.ParseFloat(r.URL.Query().Get("latitude"), 64)
+		if lat == 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"latitude": %v, "longitude": 0, "current": {"time": "2025-12-29T10:00", "temperature_2m": 1}}`, lat)
+	}))
+	defer server.Close()
+
+	coords := make([]Coordinates, 8)
+	for i := range coords {
+		coords[i] = Coordinates{Latitude: float64(i)}
+	}
+
+	var mu sync.Mutex
+	var last Progress
+	calls := 0
+	client := NewClient(WithBaseURL(server.URL))
+	client.GetCurrentWeatherMany(context.Background(), coords, WithProgress(func(p Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if p.Done != calls {
+			t.Errorf("Expected Done %d, got %d", calls, p.Done)
+		}
+		last = p
+	}))
+	if calls != len(coords) || last.Total != len(coords) || last.Failed != 1 || last.Bytes == 0 {
+		t.Errorf("Unexpected final report %+v after %d calls", last, calls)
+	}
+
+	// Cancelling from the callback stops the remaining entries
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	serial := NewClient(WithBaseURL(server.URL))
+	serial.semaphore = make(chan struct{}, 1)
+	results := serial.GetCurrentWeatherMany(ctx, coords,
+		WithProgress(func(p Progress) {
+			if p.Done == 2 {
+				cancel()
+			}
+		}))
+	if !errors.Is(results[len(results)-1].Err, context.Canceled) {
+		t.Errorf("Expected cancelled last entry, got %v", results[len(results)-1].Err)
+	}
+}
+
+// TestProgressTracker_ETA tests the remaining time estimate
+func TestProgressTracker_ETA(t *testing.T) {
+	var got Progress
+	tracker := newProgressTracker(func(p Progress) { got = p }, 4)
+	tracker.start = time.Now().Add(-time.Second)
+	tracker.complete("a", nil)
+	if got.ETA < 2900*time.Millisecond || got.ETA > 3500*time.Millisecond {
+		t.Errorf("Expected ETA of about 3s, got %v", got.ETA)
+	}
+
+	// Without a callback, nothing is recorded
+	newProgressTracker(nil, 1).complete("a", nil)
+}
@@ -0,0 +1,281 @@
+// Package prommetrics exports the request metrics of openmeteo clients in the Prometheus
+// text exposition format.
+//
+// A Collector implements openmeteo.Metrics and serves its metrics over HTTP, so it can be
+// mounted next to (or instead of) an existing /metrics endpoint. It has no dependency on
+// the Prometheus client library. The exported metrics are:
+//
+//   - <namespace>_request_duration_seconds: histogram of request latency by endpoint
+//   - <namespace>_requests_in_flight: gauge of requests currently in flight by endpoint
+//   - <namespace>_request_errors_total: counter of failed requests by endpoint and error type
+//     ("validation", "network", "api" or "canceled")
+//   - <namespace>_cache_requests_total: counter of response cache lookups by endpoint and
+//     result ("hit" or "miss")
+//   - <namespace>_cache_hit_ratio: gauge of the share of cache lookups that were hits
+//
+// Example:
+//
+//	collector := prommetrics.New("openmeteo")
+//	client := openmeteo.NewClient(
+//	    openmeteo.WithMetrics(collector),
+//	    openmeteo.WithCache(openmeteo.NewLRUCache(1000), 10*time.Minute),
+//	)
+//	http.Handle("/metrics", collector)
+package prommetrics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	openmeteo "github.com/gregbalnis/open-meteo-weather-sdk"
+)
+
+// DefaultBuckets are the upper bounds in seconds of the request duration histogram.
+var DefaultBuckets = []float64{0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// contentType is the media type of the Prometheus text exposition format.
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Collector records openmeteo request events and exposes them as Prometheus metrics.
+// A single Collector can be shared by several clients. It is safe for concurrent use.
+type Collector struct {
+	namespace string
+	buckets   []float64
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointMetrics
+}
+
+// endpointMetrics holds the metrics of one endpoint.
+type endpointMetrics struct {
+	bucketCounts []uint64 // cumulative counts per bucket
+	count        uint64
+	sum          float64
+	inFlight     int64
+	errors       map[string]uint64
+	cacheHits    uint64
+	cacheMisses  uint64
+}
+
+// New creates a Collector whose metric names start with namespace (e.g., "openmeteo").
+// Histogram buckets default to DefaultBuckets; pass bucket upper bounds in seconds to
+// override them.
+func New(namespace string, buckets ...float64) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	return &Collector{
+		namespace: namespace,
+		buckets:   buckets,
+		endpoints: make(map[string]*endpointMetrics),
+	}
+}
+
+// endpoint returns the metrics of endpoint, creating them if needed. c.mu must be held.
+func (c *Collector) endpoint(name string) *endpointMetrics {
+	e, ok := c.endpoints[name]
+	if !ok {
+		e = &endpointMetrics{
+			bucketCounts: make([]uint64, len(c.buckets)),
+			errors:       make(map[string]uint64),
+		}
+		c.endpoints[name] = e
+	}
+	return e
+}
+
+// RequestStarted implements openmeteo.Metrics.
+func (c *Collector) RequestStarted(endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.endpoint(endpoint).inFlight++
+}
+
+// RequestFinished implements openmeteo.Metrics.
+func (c *Collector) RequestFinished(endpoint string, duration time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.endpoint(endpoint)
+	e.inFlight--
+	seconds := duration.Seconds()
+	e.count++
+	e.sum += seconds
+	for i, bound := range c.buckets {
+		if seconds <= bound {
+			e.bucketCounts[i]++
+		}
+	}
+	if err != nil {
+		e.errors[errorType(err)]++
+	}
+}
+
+// CacheLookup implements openmeteo.Metrics.
+func (c *Collector) CacheLookup(endpoint string, hit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.endpoint(endpoint)
+	if hit {
+		e.cacheHits++
+	} else {
+		e.cacheMisses++
+	}
+}
+
+// errorType returns the error type label of a failed request.
+func errorType(err error) string {
+	var sdkErr *openmeteo.Error
+	if !errors.As(err, &sdkErr) {
+		return "canceled"
+	}
+	switch sdkErr.Type {
+	case openmeteo.ErrorTypeValidation:
+		return "validation"
+	case openmeteo.ErrorTypeNetwork:
+		return "network"
+	default:
+		return "api"
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", contentType)
+	_, _ = c.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format to w.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	names := make([]string, 0, len(c.endpoints))
+	for name := range c.endpoints {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	ns := c.namespace
+	if ns != "" {
+		ns += "_"
+	}
+
+	cw.header(ns+"request_duration_seconds", "histogram", "Latency of Open Meteo API requests.")
+	for _, name := range names {
+		e := c.endpoints[name]
+		for i, bound := range c.buckets {
+			cw.sample(ns+"request_duration_seconds_bucket", labels("endpoint", name, "le", formatFloat(bound)), float64(e.bucketCounts[i]))
+		}
+		cw.sample(ns+"request_duration_seconds_bucket", labels("endpoint", name, "le", "+Inf"), float64(e.count))
+		cw.sample(ns+"request_duration_seconds_sum", labels("endpoint", name), e.sum)
+		cw.sample(ns+"request_duration_seconds_count", labels("endpoint", name), float64(e.count))
+	}
+
+	cw.header(ns+"requests_in_flight", "gauge", "Open Meteo API requests currently in flight.")
+	for _, name := range names {
+		cw.sample(ns+"requests_in_flight", labels("endpoint", name), float64(c.endpoints[name].inFlight))
+	}
+
+	cw.header(ns+"request_errors_total", "counter", "Failed Open Meteo API requests by error type.")
+	for _, name := range names {
+		e := c.endpoints[name]
+		types := make([]string, 0, len(e.errors))
+		for t := range e.errors {
+			types = append(types, t)
+		}
+		slices.Sort(types)
+		for _, t := range types {
+			cw.sample(ns+"request_errors_total", labels("endpoint", name, "type", t), float64(e.errors[t]))
+		}
+	}
+
+	cw.header(ns+"cache_requests_total", "counter", "Response cache lookups by result.")
+	var hits, lookups uint64
+	for _, name := range names {
+		e := c.endpoints[name]
+		if e.cacheHits+e.cacheMisses == 0 {
+			continue
+		}
+		cw.sample(ns+"cache_requests_total", labels("endpoint", name, "result", "hit"), float64(e.cacheHits))
+		cw.sample(ns+"cache_requests_total", labels("endpoint", name, "result", "miss"), float64(e.cacheMisses))
+		hits += e.cacheHits
+		lookups += e.cacheHits + e.cacheMisses
+	}
+
+	cw.header(ns+"cache_hit_ratio", "gauge", "Share of response cache lookups that were hits.")
+	ratio := 0.0
+	if lookups > 0 {
+		ratio = float64(hits) / float64(lookups)
+	}
+	cw.sample(ns+"cache_hit_ratio", "", ratio)
+
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// countingWriter writes exposition lines, remembering the first error and the bytes written.
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+// header writes the HELP and TYPE lines of a metric.
+func (cw *countingWriter) header(name, kind, help string) {
+	cw.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// sample writes one sample line.
+func (cw *countingWriter) sample(name, labels string, value float64) {
+	cw.printf("%s%s %s\n", name, labels, formatFloat(value))
+}
+
+// printf writes formatted output unless an earlier write failed.
+func (cw *countingWriter) printf(format string, args ...any) {
+	if cw.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(cw.w, format, args...)
+	cw.n += int64(n)
+	cw.err = err
+}
+
+// labelEscaper escapes label values as required by the exposition format.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labels formats label name/value pairs, e.g., {endpoint="forecast",le="0.5"}.
+func labels(pairs ...string) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(pairs[i])
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(pairs[i+1]))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// formatFloat formats a sample value or bucket bound.
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
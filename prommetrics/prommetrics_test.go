@@ -0,0 +1,72 @@
+package prommetrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	openmeteo "github.com/gregbalnis/open-meteo-weather-sdk"
+)
+
+// TestCollector tests the exported metrics of a client's requests
+func TestCollector(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("latitude") == "0" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 15.3}}`)
+	}))
+	defer upstream.Close()
+
+	collector := New("openmeteo", 10, 0.5)
+	client := openmeteo.NewClient(
+		openmeteo.WithBaseURL(upstream.URL),
+		openmeteo.WithMetrics(collector),
+		openmeteo.WithCache(openmeteo.NewLRUCache(10), time.Minute),
+	)
+	ctx := context.Background()
+	for range 3 {
+		if _, err := client.GetCurrentWeather(ctx, 52.52, 13.41); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if _, err := client.GetCurrentWeather(ctx, 0, 0); err == nil {
+		t.Fatal("Expected an API error")
+	}
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("Expected the text exposition format, got %q", ct)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{
+		"# TYPE openmeteo_request_duration_seconds histogram\n",
+		`openmeteo_request_duration_seconds_bucket{endpoint="forecast",le="0.5"} 2` + "\n",
+		`openmeteo_request_duration_seconds_bucket{endpoint="forecast",le="10"} 2` + "\n",
+		`openmeteo_request_duration_seconds_bucket{endpoint="forecast",le="+Inf"} 2` + "\n",
+		`openmeteo_request_duration_seconds_count{endpoint="forecast"} 2` + "\n",
+		`openmeteo_requests_in_flight{endpoint="forecast"} 0` + "\n",
+		`openmeteo_request_errors_total{endpoint="forecast",type="api"} 1` + "\n",
+		`openmeteo_cache_requests_total{endpoint="forecast",result="hit"} 2` + "\n",
+		`openmeteo_cache_requests_total{endpoint="forecast",result="miss"} 2` + "\n",
+		"openmeteo_cache_hit_ratio 0.5\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected %q in metrics:\n%s", want, body)
+		}
+	}
+}
+
+// TestLabels tests the escaping of label values
+func TestLabels(t *testing.T) {
+	if got := labels("endpoint", `a"b\c`+"\n"); got != `{endpoint="a\"b\\c\n"}` {
+		t.Errorf("Unexpected labels %s", got)
+	}
+}
@@ -0,0 +1,266 @@
+// Package proxy implements a local caching proxy for the Open Meteo API.
+//
+// A Proxy serves the Open Meteo API surface (/v1/forecast, /v1/archive, /v1/air-quality and
+// /v1/search) and forwards requests to the upstream services, caching successful responses,
+// limiting the upstream request rate and injecting the API key. Pointing a fleet of services at
+// one proxy (see openmeteo.WithBaseURL) lets them share a single quota and avoids fetching
+// the same forecast many times.
+//
+// Example:
+//
+//	p := proxy.New(proxy.WithCacheTTL(10*time.Minute), proxy.WithRateLimit(5), proxy.WithAPIKey(key))
+//	log.Fatal(http.ListenAndServe(":8080", p))
+package proxy
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	openmeteo "github.com/gregbalnis/open-meteo-weather-sdk"
+)
+
+const (
+	// defaultCacheTTL is how long successful responses are cached by default
+	defaultCacheTTL = 5 * time.Minute
+
+	// defaultCacheSize is the default maximum number of cached responses
+	defaultCacheSize = 1024
+
+	// defaultTimeout bounds upstream requests
+	defaultTimeout = 30 * time.Second
+)
+
+// defaultUpstreams maps the served paths to the public Open Meteo services.
+var defaultUpstreams = map[string]string{
+	"/v1/forecast":    "https://api.open-meteo.com/v1/forecast",
+	"/v1/archive":     "https://archive-api.open-meteo.com/v1/archive",
+	"/v1/air-quality": "https://air-quality-api.open-meteo.com/v1/air-quality",
+	"/v1/search":      "https://geocoding-api.open-meteo.com/v1/search",
+}
+
+// Option configures a Proxy.
+type Option func(*Proxy)
+
+// Proxy is an http.Handler forwarding Open Meteo API requests upstream. It is safe for
+// concurrent use.
+type Proxy struct {
+	upstreams  map[string]string
+	httpClient *http.Client
+	apiKey     string
+	ttl        time.Duration
+	cache      *responseCache
+	limiter    *openmeteo.RateLimiter
+}
+
+// New creates a proxy forwarding to the public Open Meteo services, caching successful
+// responses for 5 minutes (up to 1024 responses) without a rate limit.
+func New(opts ...Option) *Proxy {
+	p := &Proxy{
+		upstreams:  make(map[string]string, len(defaultUpstreams)),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		ttl:        defaultCacheTTL,
+		cache:      newResponseCache(defaultCacheSize),
+	}
+	for path, upstream := range defaultUpstreams {
+		p.upstreams[path] = upstream
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithUpstream forwards requests for path (e.g., "/v1/forecast") to the given URL, for example
+// a self-hosted instance. New paths can be added the same way.
+func WithUpstream(path, upstreamURL string) Option {
+	return func(p *Proxy) {
+		p.upstreams[path] = upstreamURL
+	}
+}
+
+// WithAPIKey injects the key as the apikey query parameter of every upstream request and
+// switches the default upstreams to the commercial "customer-" hosts. Keys sent by clients
+// are replaced.
+func WithAPIKey(key string) Option {
+	return func(p *Proxy) {
+		p.apiKey = key
+		for path, upstream := range p.upstreams {
+			if upstream == defaultUpstreams[path] {
+				p.upstreams[path] = strings.Replace(upstream, "https://", "https://customer-", 1)
+			}
+		}
+	}
+}
+
+// WithCacheTTL sets how long successful responses are served from the cache.
+// A TTL of zero disables caching.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(p *Proxy) {
+		p.ttl = ttl
+	}
+}
+
+// WithCacheSize sets the maximum number of cached responses; the least recently used
+// responses are evicted first.
+func WithCacheSize(size int) Option {
+	return func(p *Proxy) {
+		p.cache = newResponseCache(max(size, 1))
+	}
+}
+
+// WithRateLimit limits upstream requests to perSecond on average, with bursts of up to one
+// second's worth of requests. Requests over the limit wait for their turn (or until the client
+// gives up); cache hits are never limited. Zero disables the limit.
+func WithRateLimit(perSecond float64) Option {
+	return func(p *Proxy) {
+		p.limiter = nil
+		if perSecond > 0 {
+			p.limiter = openmeteo.NewRateLimiter(perSecond, int(math.Ceil(perSecond)))
+		}
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for upstream requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(p *Proxy) {
+		p.httpClient = httpClient
+	}
+}
+
+// ServeHTTP forwards a GET request to the upstream of its path. Responses carry an X-Cache
+// header with HIT or MISS.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	upstream, ok := p.upstreams[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+	query.Del("apikey")
+	key := r.URL.Path + "?" + query.Encode() // Encode sorts by key, so equal queries share an entry
+	if p.ttl > 0 {
+		if entry, ok := p.cache.get(key, time.Now()); ok {
+			entry.write(w, "HIT")
+			return
+		}
+	}
+
+	if p.limiter != nil {
+		if err := p.limiter.Wait(r.Context()); err != nil {
+			http.Error(w, "request canceled while waiting for the rate limit", http.StatusServiceUnavailable)
+			return
+		}
+	}
+	if p.apiKey != "" {
+		query.Set("apikey", p.apiKey)
+	}
+	entry, err := p.fetch(r.Context(), upstream+"?"+query.Encode())
+	if err != nil {
+		http.Error(w, "upstream request failed: "+err.Error(), http.StatusBadGateway)
+		return
+	}
+	if p.ttl > 0 && entry.status == http.StatusOK {
+		entry.expires = time.Now().Add(p.ttl)
+		p.cache.put(key, entry)
+	}
+	entry.write(w, "MISS")
+}
+
+// fetch performs the upstream request and reads the whole response.
+func (p *Proxy) fetch(ctx context.Context, target string) (*cachedResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedResponse{status: resp.StatusCode, contentType: resp.Header.Get("Content-Type"), body: body}, nil
+}
+
+// cachedResponse is an upstream response kept in memory.
+type cachedResponse struct {
+	status      int
+	contentType string
+	body        []byte
+	expires     time.Time
+}
+
+// write sends the response to the client.
+func (c *cachedResponse) write(w http.ResponseWriter, cache string) {
+	if c.contentType != "" {
+		w.Header().Set("Content-Type", c.contentType)
+	}
+	w.Header().Set("X-Cache", cache)
+	w.WriteHeader(c.status)
+	_, _ = io.Copy(w, bytes.NewReader(c.body))
+}
+
+// responseCache is a size-bounded LRU cache of responses.
+type responseCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // front is most recently used; values are keys
+	entries map[string]*list.Element
+	values  map[string]*cachedResponse
+}
+
+func newResponseCache(size int) *responseCache {
+	return &responseCache{size: size, order: list.New(), entries: map[string]*list.Element{}, values: map[string]*cachedResponse{}}
+}
+
+// get returns the unexpired response for key.
+func (c *responseCache) get(key string, now time.Time) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.values[key]
+	if !ok {
+		return nil, false
+	}
+	if now.After(entry.expires) {
+		c.order.Remove(c.entries[key])
+		delete(c.entries, key)
+		delete(c.values, key)
+		return nil, false
+	}
+	c.order.MoveToFront(c.entries[key])
+	return entry, true
+}
+
+// put stores a response, evicting the least recently used one when full.
+func (c *responseCache) put(key string, entry *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		c.values[key] = entry
+		return
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(string))
+		delete(c.values, oldest.Value.(string))
+	}
+	c.entries[key] = c.order.PushFront(key)
+	c.values[key] = entry
+}
@@ -0,0 +1,138 @@
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newUpstream returns a server echoing the query, failing requests for latitude 0
+func newUpstream(t *testing.T, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("latitude") == "0" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = fmt.Fprintf(w, `{"path": %q, "query": %q}`, r.URL.Path, r.URL.RawQuery)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// get performs a GET against the proxy
+func get(t *testing.T, p http.Handler, target string) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec.Result()
+}
+
+// TestProxy_Caching tests cache hits for equivalent queries and uncached errors
+func TestProxy_Caching(t *testing.T) {
+	var calls atomic.Int32
+	upstream := newUpstream(t, &calls)
+	p := New(WithUpstream("/v1/forecast", upstream.URL+"/forecast"))
+
+	first := get(t, p, "/v1/forecast?latitude=52.52&longitude=13.41")
+	body, _ := io.ReadAll(first.Body)
+	if first.StatusCode != http.StatusOK || first.Header.Get("X-Cache") != "MISS" || !strings.Contains(string(body), `"path": "/forecast"`) {
+		t.Errorf("Unexpected first response %d %s %s", first.StatusCode, first.Header.Get("X-Cache"), body)
+	}
+	second := get(t, p, "/v1/forecast?longitude=13.41&latitude=52.52")
+	if second.Header.Get("X-Cache") != "HIT" || second.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Expected a cache hit for the reordered query, got %s", second.Header.Get("X-Cache"))
+	}
+
+	for range 2 {
+		if resp := get(t, p, "/v1/forecast?latitude=0&longitude=0"); resp.StatusCode != http.StatusBadRequest || resp.Header.Get("X-Cache") != "MISS" {
+			t.Errorf("Expected uncached upstream error, got %d %s", resp.StatusCode, resp.Header.Get("X-Cache"))
+		}
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 upstream calls, got %d", calls.Load())
+	}
+}
+
+// TestProxy_CacheExpiryAndEviction tests TTL and LRU eviction
+func TestProxy_CacheExpiryAndEviction(t *testing.T) {
+	c := newResponseCache(2)
+	now := time.Now()
+	for _, key := range []string{"a", "b"} {
+		c.put(key, &cachedResponse{expires: now.Add(time.Minute)})
+	}
+	c.get("a", now)
+	c.put("c", &cachedResponse{expires: now.Add(time.Minute)})
+	if _, ok := c.get("b", now); ok {
+		t.Error("Expected the least recently used entry to be evicted")
+	}
+	if _, ok := c.get("a", now); !ok {
+		t.Error("Expected the recently used entry to be kept")
+	}
+	if _, ok := c.get("c", now.Add(2*time.Minute)); ok {
+		t.Error("Expected the entry to expire")
+	}
+
+	var calls atomic.Int32
+	upstream := newUpstream(t, &calls)
+	p := New(WithUpstream("/v1/forecast", upstream.URL), WithCacheTTL(0), WithCacheSize(0))
+	get(t, p, "/v1/forecast?latitude=1")
+	get(t, p, "/v1/forecast?latitude=1")
+	if calls.Load() != 2 {
+		t.Errorf("Expected no caching with a zero TTL, got %d calls", calls.Load())
+	}
+}
+
+// TestProxy_APIKey tests key injection and the customer hosts
+func TestProxy_APIKey(t *testing.T) {
+	var calls atomic.Int32
+	upstream := newUpstream(t, &calls)
+	p := New(WithAPIKey("secret"), WithUpstream("/v1/archive", upstream.URL))
+
+	resp := get(t, p, "/v1/archive?latitude=1&apikey=client")
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "apikey=secret") || strings.Contains(string(body), "client") {
+		t.Errorf("Expected the proxy key to replace the client key, got %s", body)
+	}
+	if got := p.upstreams["/v1/forecast"]; got != "https://customer-api.open-meteo.com/v1/forecast" {
+		t.Errorf("Expected customer host, got %s", got)
+	}
+}
+
+// TestProxy_Routing tests unknown paths, methods and upstream failures
+func TestProxy_Routing(t *testing.T) {
+	p := New(WithUpstream("/v1/forecast", "http://127.0.0.1:1/forecast"))
+	if resp := get(t, p, "/v2/unknown"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404, got %d", resp.StatusCode)
+	}
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/forecast", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405, got %d", rec.Code)
+	}
+	if resp := get(t, p, "/v1/forecast?latitude=1"); resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected 502 for an unreachable upstream, got %d", resp.StatusCode)
+	}
+}
+
+// TestProxy_RateLimit tests that upstream calls beyond the burst wait for tokens
+func TestProxy_RateLimit(t *testing.T) {
+	var calls atomic.Int32
+	upstream := newUpstream(t, &calls)
+	p := New(WithUpstream("/v1/forecast", upstream.URL), WithRateLimit(10))
+
+	start := time.Now()
+	for i := range 12 {
+		get(t, p, fmt.Sprintf("/v1/forecast?latitude=%d", i+1))
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected 2 calls beyond the burst to wait about 200ms, took %s", elapsed)
+	}
+
+}
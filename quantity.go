@@ -0,0 +1,319 @@
+package openmeteo
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"strings"
+
+	"github.com/gregbalnis/open-meteo-weather-sdk/units"
+)
+
+// Unit is a unit of measurement, using the same symbols as the Open Meteo API (e.g., "km/h").
+type Unit string
+
+// Units of measurement used by weather variables.
+const (
+	UnitCelsius    Unit = "°C"
+	UnitFahrenheit Unit = "°F"
+	UnitKelvin     Unit = "K"
+
+	UnitKilometersPerHour Unit = "km/h"
+	UnitMetersPerSecond   Unit = "m/s"
+	UnitMilesPerHour      Unit = "mph"
+	UnitKnots             Unit = "kn"
+
+	UnitMillimeter Unit = "mm"
+	UnitCentimeter Unit = "cm"
+	UnitInch       Unit = "inch"
+	UnitFoot       Unit = "ft"
+	UnitMeter      Unit = "m"
+	UnitKilometer  Unit = "km"
+	UnitMile       Unit = "mi"
+
+	UnitHectopascal     Unit = "hPa"
+	UnitInchesOfMercury Unit = "inHg"
+	UnitKilopascal      Unit = "kPa"
+
+	UnitJoulesPerKilogram Unit = "J/kg"
+
+	UnitPercent Unit = "%"
+	UnitDegree  Unit = "°"
+
+	UnitSecond Unit = "s"
+	UnitHour   Unit = "h"
+)
+
+// dimension groups units that can be converted into each other.
+type dimension int
+
+const (
+	dimensionNone dimension = iota
+	dimensionTemperature
+	dimensionSpeed
+	dimensionLength
+	dimensionPressure
+	dimensionRatio
+	dimensionAngle
+	dimensionDuration
+	dimensionEnergy
+)
+
+// unitInfo describes the dimension of a unit and, for dimensions the units package does not
+// cover, its size in the base unit of the dimension.
+type unitInfo struct {
+	dimension dimension
+	scale     float64
+}
+
+// unitTable lists the known units. Temperatures, speeds, lengths and pressures are converted
+// by the units package, which holds their factors; the other base units are %, °, s and J/kg.
+var unitTable = map[Unit]unitInfo{
+	UnitCelsius:    {dimension: dimensionTemperature},
+	UnitFahrenheit: {dimension: dimensionTemperature},
+	UnitKelvin:     {dimension: dimensionTemperature},
+
+	UnitKilometersPerHour: {dimension: dimensionSpeed},
+	UnitMetersPerSecond:   {dimension: dimensionSpeed},
+	UnitMilesPerHour:      {dimension: dimensionSpeed},
+	UnitKnots:             {dimension: dimensionSpeed},
+
+	UnitMillimeter: {dimension: dimensionLength},
+	UnitCentimeter: {dimension: dimensionLength},
+	UnitInch:       {dimension: dimensionLength},
+	UnitFoot:       {dimension: dimensionLength},
+	UnitMeter:      {dimension: dimensionLength},
+	UnitKilometer:  {dimension: dimensionLength},
+	UnitMile:       {dimension: dimensionLength},
+
+	UnitHectopascal:     {dimension: dimensionPressure},
+	UnitInchesOfMercury: {dimension: dimensionPressure},
+	UnitKilopascal:      {dimension: dimensionPressure},
+
+	UnitJoulesPerKilogram: {dimensionEnergy, 1},
+
+	UnitPercent: {dimensionRatio, 1},
+	UnitDegree:  {dimensionAngle, 1},
+
+	UnitSecond: {dimensionDuration, 1},
+	UnitHour:   {dimensionDuration, 3600},
+}
+
+// convertValue converts value from one unit to another of the same dimension d.
+func convertValue(value float64, from, to Unit, d dimension) float64 {
+	switch d {
+	case dimensionTemperature:
+		return units.NewTemperature(value, units.TemperatureUnit(from)).In(units.TemperatureUnit(to))
+	case dimensionSpeed:
+		return units.NewSpeed(value, units.SpeedUnit(from)).In(units.SpeedUnit(to))
+	case dimensionLength:
+		return units.NewLength(value, units.LengthUnit(from)).In(units.LengthUnit(to))
+	case dimensionPressure:
+		return units.NewPressure(value, units.PressureUnit(from)).In(units.PressureUnit(to))
+	default:
+		return value * unitTable[from].scale / unitTable[to].scale
+	}
+}
+
+// Quantity is a numeric value with its unit of measurement.
+// Quantities can be converted between compatible units and compared unit-aware,
+// e.g., 5 m/s is above 17 km/h.
+type Quantity struct {
+	// Value is the numeric value expressed in Unit
+	Value float64
+
+	// Unit is the unit of measurement of Value
+	Unit Unit
+}
+
+// String formats the quantity for display, e.g., "15.3°C", "65%" or "12.5 km/h".
+// Percentages and angles are shown without decimals, all other units with one decimal.
+func (q Quantity) String() string {
+	switch q.Unit {
+	case UnitPercent, UnitDegree:
+		return fmt.Sprintf("%.0f%s", q.Value, q.Unit)
+	case UnitCelsius, UnitFahrenheit:
+		return fmt.Sprintf("%.1f%s", q.Value, q.Unit)
+	case UnitMeter, UnitJoulesPerKilogram:
+		return fmt.Sprintf("%.0f %s", q.Value, q.Unit)
+	case UnitKilopascal:
+		return fmt.Sprintf("%.2f %s", q.Value, q.Unit)
+	case "":
+		return fmt.Sprintf("%.1f", q.Value)
+	default:
+		return fmt.Sprintf("%.1f %s", q.Value, q.Unit)
+	}
+}
+
+// Convert returns the quantity expressed in another unit of the same dimension
+// (e.g., km/h to m/s). It returns an error if the units are unknown or incompatible.
+//
+// Example:
+//
+//	speed := openmeteo.Quantity{Value: 18, Unit: openmeteo.UnitKilometersPerHour}
+//	ms, _ := speed.Convert(openmeteo.UnitMetersPerSecond) // 5 m/s
+func (q Quantity) Convert(to Unit) (Quantity, error) {
+	if q.Unit == to {
+		return q, nil
+	}
+	fromUnit, toUnit := parseUnit(string(q.Unit)), parseUnit(string(to))
+	from, ok := unitTable[fromUnit]
+	if !ok {
+		return Quantity{}, fmt.Errorf("unknown unit %q", q.Unit)
+	}
+	target, ok := unitTable[toUnit]
+	if !ok {
+		return Quantity{}, fmt.Errorf("unknown unit %q", to)
+	}
+	if from.dimension != target.dimension {
+		return Quantity{}, fmt.Errorf("cannot convert %s to %s", q.Unit, to)
+	}
+	return Quantity{Value: convertValue(q.Value, fromUnit, toUnit, from.dimension), Unit: to}, nil
+}
+
+// apiUnitAliases maps the unit strings the API reports to their Unit where the two differ.
+var apiUnitAliases = map[string]Unit{"mp/h": UnitMilesPerHour}
+
+// parseUnit returns the Unit of a unit string reported by the API, e.g., UnitMilesPerHour
+// for "mp/h".
+func parseUnit(s string) Unit {
+	if u, ok := apiUnitAliases[s]; ok {
+		return u
+	}
+	return Unit(s)
+}
+
+// Compare compares q with other after converting other into q's unit. It returns -1 if
+// q is less than other, 0 if they are equal (within floating point tolerance), and +1 if
+// q is greater. It returns an error if the units are incompatible or a value is NaN.
+func (q Quantity) Compare(other Quantity) (int, error) {
+	o, err := other.Convert(q.Unit)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(q.Value) || math.IsNaN(o.Value) {
+		return 0, fmt.Errorf("cannot compare missing values")
+	}
+	const epsilon = 1e-9
+	switch diff := q.Value - o.Value; {
+	case math.Abs(diff) <= epsilon*math.Max(1, math.Abs(q.Value)):
+		return 0, nil
+	case diff < 0:
+		return -1, nil
+	default:
+		return 1, nil
+	}
+}
+
+// Above reports whether q is strictly greater than threshold, converting units as needed.
+// It returns false if the units are incompatible or a value is missing (NaN).
+//
+// Example:
+//
+//	gust := openmeteo.Quantity{Value: 5, Unit: openmeteo.UnitMetersPerSecond}
+//	gust.Above(openmeteo.Quantity{Value: 17, Unit: openmeteo.UnitKilometersPerHour}) // true
+func (q Quantity) Above(threshold Quantity) bool {
+	c, err := q.Compare(threshold)
+	return err == nil && c > 0
+}
+
+// Below reports whether q is strictly less than threshold, converting units as needed.
+// It returns false if the units are incompatible or a value is missing (NaN).
+func (q Quantity) Below(threshold Quantity) bool {
+	c, err := q.Compare(threshold)
+	return err == nil && c < 0
+}
+
+// Between reports whether q lies within [low, high] (inclusive), converting units as needed.
+// It returns false if the units are incompatible or a value is missing (NaN).
+func (q Quantity) Between(low, high Quantity) bool {
+	lo, err := q.Compare(low)
+	if err != nil {
+		return false
+	}
+	hi, err := q.Compare(high)
+	if err != nil {
+		return false
+	}
+	return lo >= 0 && hi <= 0
+}
+
+// Quantity returns the value of variable v at step i with the unit reported by the API
+// (e.g., sunshine_duration in seconds, or no unit for the UV index). Missing values have a
+// NaN Value.
+//
+// Example:
+//
+//	sunshine := f.Daily.Quantity(openmeteo.DailySunshineDuration, 0)
+//	hours, _ := sunshine.Convert(openmeteo.UnitHour)
+//	fmt.Println(hours, f.Daily.Quantity(openmeteo.DailyUVIndexMax, 0)) // e.g. "9.5 h 6.2"
+func (s *Series) Quantity(v Variable, i int) Quantity {
+	return Quantity{Value: s.valueAt(v, i), Unit: Unit(s.Units[v])}
+}
+
+// metricUnits maps the units the API returns with WithUnits to the default unit of the same
+// variables. Precipitation in inches becomes mm, except snowfall, which becomes cm.
+var metricUnits = map[Unit]Unit{
+	UnitFahrenheit:      UnitCelsius,
+	UnitMilesPerHour:    UnitKilometersPerHour,
+	UnitMetersPerSecond: UnitKilometersPerHour,
+	UnitKnots:           UnitKilometersPerHour,
+	UnitInch:            UnitMillimeter,
+	UnitFoot:            UnitMeter,
+}
+
+// metricUnit returns the API's default unit of v for values in unit, and false if unit is
+// already a default unit or unknown.
+func metricUnit(v Variable, unit Unit) (Unit, bool) {
+	target, ok := metricUnits[parseUnit(string(unit))]
+	if target == UnitMillimeter && strings.HasPrefix(string(v), "snowfall") {
+		target = UnitCentimeter
+	}
+	return target, ok
+}
+
+// toMetric converts a value of v in unit to the API's default unit of v (e.g., °F to °C).
+// Values in default or unknown units are returned unchanged.
+func toMetric(v Variable, value float64, unit Unit) float64 {
+	target, ok := metricUnit(v, unit)
+	if !ok {
+		return value
+	}
+	q, _ := Quantity{Value: value, Unit: unit}.Convert(target)
+	return q.Value
+}
+
+// metricValueAt returns the value of v at step i in the API's default unit of v, or NaN if
+// the variable is not present.
+func (s *Series) metricValueAt(v Variable, i int) float64 {
+	return toMetric(v, s.valueAt(v, i), Unit(s.Units[v]))
+}
+
+// metric returns s with all values in the API's default units, for helpers with metric
+// thresholds. s itself is returned when no variable needs converting, so the result must
+// not be modified.
+func (s *Series) metric() *Series {
+	var out *Series
+	for v, values := range s.Values {
+		unit := Unit(s.Units[v])
+		target, ok := metricUnit(v, unit)
+		if !ok {
+			continue
+		}
+		if out == nil {
+			copied := *s
+			copied.Values, copied.Units = maps.Clone(s.Values), maps.Clone(s.Units)
+			out = &copied
+		}
+		converted := make([]float64, len(values))
+		for i, value := range values {
+			converted[i] = toMetric(v, value, unit)
+		}
+		out.Values[v] = converted
+		out.Units[v] = string(target)
+	}
+	if out == nil {
+		return s
+	}
+	return out
+}
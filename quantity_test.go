@@ -0,0 +1,189 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestQuantity_String tests quantity formatting
+func TestQuantity_String(t *testing.T) {
+	testCases := []struct {
+		q        Quantity
+		expected string
+	}{
+		{Quantity{15.34, UnitCelsius}, "15.3°C"},
+		{Quantity{59.5, UnitFahrenheit}, "59.5°F"},
+		{Quantity{65, UnitPercent}, "65%"},
+		{Quantity{270, UnitDegree}, "270°"},
+		{Quantity{12.5, UnitKilometersPerHour}, "12.5 km/h"},
+		{Quantity{1013.25, UnitHectopascal}, "1013.2 hPa"},
+		{Quantity{3, ""}, "3.0"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.q.String(); got != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+// TestQuantity_Convert tests conversions between compatible units
+func TestQuantity_Convert(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity
+		to       Unit
+		expected float64
+	}{
+		{"Celsius to Fahrenheit", Quantity{100, UnitCelsius}, UnitFahrenheit, 212},
+		{"Fahrenheit to Celsius", Quantity{32, UnitFahrenheit}, UnitCelsius, 0},
+		{"Kelvin to Celsius", Quantity{273.15, UnitKelvin}, UnitCelsius, 0},
+		{"Fahrenheit to Kelvin", Quantity{-40, UnitFahrenheit}, UnitKelvin, 233.15},
+		{"km/h to m/s", Quantity{18, UnitKilometersPerHour}, UnitMetersPerSecond, 5},
+		{"Knots to mph", Quantity{10, UnitKnots}, UnitMilesPerHour, 11.5078},
+		{"Inch to mm", Quantity{1, UnitInch}, UnitMillimeter, 25.4},
+		{"cm to mm", Quantity{2, UnitCentimeter}, UnitMillimeter, 20},
+		{"inHg to hPa", Quantity{29.92, UnitInchesOfMercury}, UnitHectopascal, 1013.21},
+		{"Seconds to hours", Quantity{34200, UnitSecond}, UnitHour, 9.5},
+		{"Same unit", Quantity{7, UnitPercent}, UnitPercent, 7},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.from.Convert(tc.to)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got.Unit != tc.to {
+				t.Errorf("Expected unit %s, got %s", tc.to, got.Unit)
+			}
+			if math.Abs(got.Value-tc.expected) > 0.01 {
+				t.Errorf("Expected %.4f, got %.4f", tc.expected, got.Value)
+			}
+		})
+	}
+}
+
+// TestQuantity_ConvertErrors tests conversion failures
+func TestQuantity_ConvertErrors(t *testing.T) {
+	if _, err := (Quantity{1, UnitCelsius}).Convert(UnitKilometersPerHour); err == nil {
+		t.Error("Expected error for incompatible units")
+	}
+	if _, err := (Quantity{1, "furlong"}).Convert(UnitMillimeter); err == nil {
+		t.Error("Expected error for unknown source unit")
+	}
+	if _, err := (Quantity{1, UnitMillimeter}).Convert("furlong"); err == nil {
+		t.Error("Expected error for unknown target unit")
+	}
+	if _, err := (Quantity{50, UnitPercent}).Convert(UnitDegree); err == nil {
+		t.Error("Expected error converting percent to degrees")
+	}
+}
+
+// TestQuantity_Comparisons tests unit-aware threshold helpers
+func TestQuantity_Comparisons(t *testing.T) {
+	fiveMS := Quantity{5, UnitMetersPerSecond}
+	eighteenKMH := Quantity{18, UnitKilometersPerHour}
+
+	if c, err := fiveMS.Compare(eighteenKMH); err != nil || c != 0 {
+		t.Errorf("Expected 5 m/s to equal 18 km/h, got %d (%v)", c, err)
+	}
+	if !fiveMS.Above(Quantity{17, UnitKilometersPerHour}) {
+		t.Error("Expected 5 m/s above 17 km/h")
+	}
+	if !fiveMS.Below(Quantity{19, UnitKilometersPerHour}) {
+		t.Error("Expected 5 m/s below 19 km/h")
+	}
+	if fiveMS.Above(eighteenKMH) || fiveMS.Below(eighteenKMH) {
+		t.Error("Expected equal quantities to be neither above nor below")
+	}
+	if !fiveMS.Between(Quantity{9, UnitKnots}, Quantity{20, UnitKilometersPerHour}) {
+		t.Error("Expected 5 m/s between 9 kn and 20 km/h")
+	}
+
+	freezing := Quantity{32, UnitFahrenheit}
+	if !(Quantity{-1, UnitCelsius}).Below(freezing) {
+		t.Error("Expected -1°C below 32°F")
+	}
+	if !(Quantity{0, UnitCelsius}).Between(freezing, Quantity{5, UnitCelsius}) {
+		t.Error("Expected 0°C between 32°F and 5°C (inclusive)")
+	}
+
+	temp := Quantity{20, UnitCelsius}
+	if temp.Above(Quantity{1, UnitMillimeter}) || temp.Below(Quantity{1, UnitMillimeter}) || temp.Between(Quantity{1, UnitMillimeter}, Quantity{30, UnitCelsius}) {
+		t.Error("Expected comparisons with incompatible units to be false")
+	}
+	if temp.Between(Quantity{10, UnitCelsius}, Quantity{1, UnitMillimeter}) {
+		t.Error("Expected Between with incompatible upper bound to be false")
+	}
+
+	missing := Quantity{math.NaN(), UnitCelsius}
+	if missing.Above(temp) || missing.Below(temp) {
+		t.Error("Expected comparisons with missing values to be false")
+	}
+	if _, err := missing.Compare(temp); err == nil {
+		t.Error("Expected error comparing missing value")
+	}
+}
+
+// TestSeries_Quantity tests reading series values with their API units
+func TestSeries_Quantity(t *testing.T) {
+	s := dailySeries(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailySunshineDuration: {34200, math.NaN()},
+		DailyUVIndexMax:       {6.25, 7},
+	}, map[Variable]string{DailySunshineDuration: "s", DailyUVIndexMax: ""})
+
+	sunshine := s.Quantity(DailySunshineDuration, 0)
+	hours, err := sunshine.Convert(UnitHour)
+	if err != nil || hours.String() != "9.5 h" {
+		t.Errorf("Expected 9.5 h, got %v (err %v)", hours, err)
+	}
+	if got := s.Quantity(DailyUVIndexMax, 0).String(); got != "6.2" {
+		t.Errorf("Expected unitless UV index 6.2, got %q", got)
+	}
+	if q := s.Quantity(DailySunshineDuration, 1); !math.IsNaN(q.Value) || q.Unit != UnitSecond {
+		t.Errorf("Expected NaN seconds for a missing value, got %+v", q)
+	}
+	if q := s.Quantity(DailyUVIndexClearSkyMax, 0); !math.IsNaN(q.Value) {
+		t.Errorf("Expected NaN for a missing variable, got %+v", q)
+	}
+}
+
+// TestSeries_Metric tests the conversion of series in other units to the API's default units
+func TestSeries_Metric(t *testing.T) {
+	s := &Series{
+		Time: []time.Time{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Values: map[Variable][]float64{
+			HourlyTemperature2m: {32}, HourlyPrecipitation: {1}, HourlySnowfall: {1},
+			HourlyWindGusts10m: {10}, HourlyWindSpeed10m: {10}, HourlySnowDepth: {1}, HourlyCloudCover: {50},
+		},
+		Units: map[Variable]string{
+			HourlyTemperature2m: "°F", HourlyPrecipitation: "inch", HourlySnowfall: "inch",
+			HourlyWindGusts10m: "kn", HourlyWindSpeed10m: "mp/h", HourlySnowDepth: "ft", HourlyCloudCover: "%",
+		},
+	}
+	m := s.metric()
+	want := map[Variable]Quantity{
+		HourlyTemperature2m: {0, UnitCelsius},
+		HourlyPrecipitation: {25.4, UnitMillimeter},
+		HourlySnowfall:      {2.54, UnitCentimeter},
+		HourlyWindGusts10m:  {18.52, UnitKilometersPerHour},
+		HourlyWindSpeed10m:  {16.09344, UnitKilometersPerHour},
+		HourlySnowDepth:     {0.3048, UnitMeter},
+		HourlyCloudCover:    {50, UnitPercent},
+	}
+	for v, q := range want {
+		if got := m.Quantity(v, 0); math.Abs(got.Value-q.Value) > 1e-9 || got.Unit != q.Unit {
+			t.Errorf("%s: expected %v, got %v", v, q, got)
+		}
+	}
+	if s.Values[HourlyTemperature2m][0] != 32 || s.Units[HourlyTemperature2m] != "°F" {
+		t.Errorf("Expected the original series to be unchanged, got %+v", s)
+	}
+
+	metric := &Series{Values: map[Variable][]float64{HourlyTemperature2m: {20}}, Units: map[Variable]string{HourlyTemperature2m: "°C"}}
+	if metric.metric() != metric {
+		t.Error("Expected a metric series to be returned as is")
+	}
+}
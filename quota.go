@@ -0,0 +1,248 @@
+package openmeteo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrQuotaExceeded is the cause of the *Error returned when a call is rejected because it
+// would exceed a quota limit enforced by QuotaPolicy. Check for it with errors.Is.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// QuotaLimits defines the number of API call units allowed per time window.
+// A zero limit means the window is not limited.
+type QuotaLimits struct {
+	Minute float64
+	Hour   float64
+	Day    float64
+	Month  float64
+}
+
+// FreeTierQuota holds the limits of the Open Meteo free (non-commercial) tier.
+var FreeTierQuota = QuotaLimits{Minute: 600, Hour: 5000, Day: 10000, Month: 300000}
+
+// QuotaUsage holds the estimated API call units consumed in the current time windows.
+// Windows are calendar-aligned in UTC (the current minute, hour, day and month).
+type QuotaUsage struct {
+	Minute float64
+	Hour   float64
+	Day    float64
+	Month  float64
+}
+
+// QuotaWarning describes a time window whose usage crossed the warning threshold.
+type QuotaWarning struct {
+	// Window is the name of the window ("minute", "hour", "day" or "month")
+	Window string
+
+	// Used is the estimated number of call units consumed in the window
+	Used float64
+
+	// Limit is the configured limit of the window
+	Limit float64
+}
+
+// QuotaPolicy configures warnings and soft limits based on estimated API usage.
+type QuotaPolicy struct {
+	// Limits are the quota limits of the account (e.g., FreeTierQuota)
+	Limits QuotaLimits
+
+	// WarnAt is the fraction of a limit (0-1) at which OnWarning is called (0 means 0.8)
+	WarnAt float64
+
+	// OnWarning is called once per window when usage crosses the warning threshold (may be nil)
+	OnWarning func(QuotaWarning)
+
+	// Enforce rejects calls that would exceed a limit instead of sending them
+	Enforce bool
+}
+
+// WithQuotaPolicy enables warnings and optional soft limits based on estimated API usage.
+// Open Meteo weights calls by their size: a call requesting more than 10 variables or more
+// than 2 weeks of data (or several locations) counts as multiple call units. The client
+// estimates the weight of every call from its query and tracks usage per minute, hour, day
+// and month, so callers can back off before the account is throttled.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithQuotaPolicy(openmeteo.QuotaPolicy{
+//	    Limits:    openmeteo.FreeTierQuota,
+//	    OnWarning: func(w openmeteo.QuotaWarning) { log.Printf("quota: %s at %.0f/%.0f", w.Window, w.Used, w.Limit) },
+//	    Enforce:   true,
+//	}))
+func WithQuotaPolicy(policy QuotaPolicy) Option {
+	return func(c *Client) {
+		if policy.WarnAt <= 0 || policy.WarnAt > 1 {
+			policy.WarnAt = 0.8
+		}
+		c.quota.policy = &policy
+	}
+}
+
+// QuotaUsage returns the estimated API call units consumed in the current time windows.
+// Usage is tracked for every call sent, whether or not a QuotaPolicy is configured.
+func (c *Client) QuotaUsage() QuotaUsage {
+	return c.quota.usage()
+}
+
+// quotaWindowNames names the tracked windows in order of increasing length
+var quotaWindowNames = [4]string{"minute", "hour", "day", "month"}
+
+// quotaWindow accumulates usage in one calendar-aligned window.
+type quotaWindow struct {
+	name   string
+	start  time.Time
+	used   float64
+	warned bool
+}
+
+// quotaTracker estimates consumed API call units. It is safe for concurrent use.
+type quotaTracker struct {
+	mu      sync.Mutex
+	policy  *QuotaPolicy
+	now     func() time.Time
+	windows [4]quotaWindow
+}
+
+// windowStart returns the start of the window with index i containing t.
+func windowStart(i int, t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case 0:
+		return t.Truncate(time.Minute)
+	case 1:
+		return t.Truncate(time.Hour)
+	case 2:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// limits returns the configured limits in window order.
+func (p *QuotaPolicy) limits() [4]float64 {
+	return [4]float64{p.Limits.Minute, p.Limits.Hour, p.Limits.Day, p.Limits.Month}
+}
+
+// rollover resets windows that have ended. It must be called with mu held.
+func (q *quotaTracker) rollover() {
+	now := time.Now
+	if q.now != nil {
+		now = q.now
+	}
+	t := now()
+	for i := range q.windows {
+		if start := windowStart(i, t); !start.Equal(q.windows[i].start) {
+			q.windows[i] = quotaWindow{name: quotaWindowNames[i], start: start}
+		}
+	}
+}
+
+// acquire records a call of the given weight. When the policy enforces limits and the call
+// would exceed one, nothing is recorded and ErrQuotaExceeded is returned. Warnings are
+// delivered after the lock is released.
+func (q *quotaTracker) acquire(weight float64) error {
+	q.mu.Lock()
+	q.rollover()
+
+	var warnings []QuotaWarning
+	if q.policy != nil {
+		limits := q.policy.limits()
+		if q.policy.Enforce {
+			for i, w := range q.windows {
+				if limits[i] > 0 && w.used+weight > limits[i] {
+					q.mu.Unlock()
+					return fmt.Errorf("%w: %s limit of %.0f call units reached (%.1f used, call weighs %.1f)",
+						ErrQuotaExceeded, w.name, limits[i], w.used, weight)
+				}
+			}
+		}
+		for i := range q.windows {
+			w := &q.windows[i]
+			w.used += weight
+			if limits[i] > 0 && !w.warned && w.used >= limits[i]*q.policy.WarnAt {
+				w.warned = true
+				warnings = append(warnings, QuotaWarning{Window: w.name, Used: w.used, Limit: limits[i]})
+			}
+		}
+	} else {
+		for i := range q.windows {
+			q.windows[i].used += weight
+		}
+	}
+	var onWarning func(QuotaWarning)
+	if q.policy != nil {
+		onWarning = q.policy.OnWarning
+	}
+	q.mu.Unlock()
+
+	if onWarning != nil {
+		for _, w := range warnings {
+			onWarning(w)
+		}
+	}
+	return nil
+}
+
+// usage returns the usage of the current windows.
+func (q *quotaTracker) usage() QuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	return QuotaUsage{
+		Minute: q.windows[0].used,
+		Hour:   q.windows[1].used,
+		Day:    q.windows[2].used,
+		Month:  q.windows[3].used,
+	}
+}
+
+// callWeight estimates the number of API call units a request counts as, following the
+// Open Meteo pricing rules: every 10 variables and every 14 days of data count as one
+// unit (fractionally, minimum 1), multiplied by the number of locations.
+func callWeight(q url.Values) float64 {
+	locations := 1
+	if lat := q.Get("latitude"); lat != "" {
+		locations = len(strings.Split(lat, ","))
+	}
+
+	variables := 0
+	for _, key := range []string{"current", "minutely_15", "hourly", "daily"} {
+		if v := q.Get(key); v != "" {
+			variables += len(strings.Split(v, ","))
+		}
+	}
+
+	return float64(locations) * max(1, float64(variables)/10) * max(1, requestedDays(q)/14)
+}
+
+// requestedDays estimates the number of days of data a request covers.
+func requestedDays(q url.Values) float64 {
+	if start, err := time.Parse(apiDateLayout, q.Get("start_date")); err == nil {
+		if end, err := time.Parse(apiDateLayout, q.Get("end_date")); err == nil && !end.Before(start) {
+			return end.Sub(start).Hours()/24 + 1
+		}
+	}
+	if start, err := time.Parse(apiHourLayout, q.Get("start_hour")); err == nil {
+		if end, err := time.Parse(apiHourLayout, q.Get("end_hour")); err == nil && !end.Before(start) {
+			return end.Sub(start).Hours()/24 + 1.0/24
+		}
+	}
+
+	days := 7.0
+	if n, err := strconv.Atoi(q.Get("forecast_days")); err == nil {
+		days = float64(n)
+	}
+	if n, err := strconv.Atoi(q.Get("past_days")); err == nil {
+		days += float64(n)
+	}
+	if n, err := strconv.Atoi(q.Get("past_hours")); err == nil {
+		days += float64(n) / 24
+	}
+	return days
+}
@@ -0,0 +1,116 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestCallWeight tests estimation of API call units
+func TestCallWeight(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  float64
+	}{
+		{"geocoding", "name=Berlin", 1},
+		{"current weather", "latitude=52.52&longitude=13.41&current=" + currentVariables, 1.9},
+		{"few variables", "latitude=52.52&longitude=13.41&hourly=temperature_2m", 1},
+		{"many variables", "latitude=52.52&longitude=13.41&hourly=a,b,c,d,e,f,g,h,i,j,k,l,m,n,o,p,q,r,s,t", 2},
+		{"long range", "latitude=52.52&longitude=13.41&hourly=a&start_date=2024-01-01&end_date=2024-01-28", 2},
+		{"forecast days", "latitude=52.52&longitude=13.41&hourly=a&forecast_days=14&past_days=14", 2},
+		{"past hours", "latitude=52.52&longitude=13.41&hourly=a&forecast_days=14&past_hours=168", 1.5},
+		{"hour range", "latitude=52.52&longitude=13.41&hourly=a&start_hour=2024-01-01T00:00&end_hour=2024-01-02T23:00", 1},
+		{"multiple locations", "latitude=52.52,48.85&longitude=13.41,2.35&hourly=a", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, _ := url.ParseQuery(tt.query)
+			if got := callWeight(q); got != tt.want {
+				t.Errorf("Expected weight %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestQuotaTracker_Windows tests that usage is reset when a window ends
+func TestQuotaTracker_Windows(t *testing.T) {
+	now := time.Date(2025, 12, 31, 23, 59, 30, 0, time.UTC)
+	q := &quotaTracker{now: func() time.Time { return now }}
+
+	_ = q.acquire(2)
+	now = now.Add(10 * time.Second)
+	_ = q.acquire(1)
+
+	if got := q.usage(); got != (QuotaUsage{Minute: 3, Hour: 3, Day: 3, Month: 3}) {
+		t.Errorf("Unexpected usage %+v", got)
+	}
+
+	now = now.Add(30 * time.Second) // 2026-01-01T00:00:10
+	if got := q.usage(); got != (QuotaUsage{}) {
+		t.Errorf("Expected all windows to reset at new month, got %+v", got)
+	}
+}
+
+// TestWithQuotaPolicy tests warnings and soft limit enforcement
+func TestWithQuotaPolicy(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00"}}`)
+	}))
+	defer server.Close()
+
+	var warnings []QuotaWarning
+	client := NewClient(WithBaseURL(server.URL), WithQuotaPolicy(QuotaPolicy{
+		Limits:    QuotaLimits{Hour: 5},
+		OnWarning: func(w QuotaWarning) { warnings = append(warnings, w) },
+		Enforce:   true,
+	}))
+	ctx := context.Background()
+
+	// Each current weather call weighs 1.9 units
+	for i := range 2 {
+		if _, err := client.GetCurrentWeather(ctx, 52.52, 13.41); err != nil {
+			t.Fatalf("Call %d: expected no error, got %v", i, err)
+		}
+	}
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warning below 80%%, got %v", warnings)
+	}
+
+	_, err := client.GetCurrentWeather(ctx, 52.52, 13.41)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation || !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("Expected quota validation error, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected rejected call not to be sent, got %d requests", requests)
+	}
+	if got := client.QuotaUsage().Hour; math.Abs(got-3.8) > 1e-9 {
+		t.Errorf("Expected 3.8 units used, got %v", got)
+	}
+
+	// Without enforcement calls are always sent and each window warns only once
+	client = NewClient(WithBaseURL(server.URL), WithQuotaPolicy(QuotaPolicy{
+		Limits:    QuotaLimits{Hour: 5},
+		OnWarning: func(w QuotaWarning) { warnings = append(warnings, w) },
+	}))
+	for range 4 {
+		_, _ = client.GetCurrentWeather(ctx, 52.52, 13.41)
+	}
+	if len(warnings) != 1 || warnings[0].Window != "hour" || math.Abs(warnings[0].Used-5.7) > 1e-9 || warnings[0].Limit != 5 {
+		t.Errorf("Expected a single hour warning, got %+v", warnings)
+	}
+	if requests != 6 {
+		t.Errorf("Expected calls to be sent without enforcement, got %d requests", requests)
+	}
+}
@@ -0,0 +1,156 @@
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// wetStepPrecipitation is the precipitation (mm) from which a time step counts as wet
+	wetStepPrecipitation = 0.1
+
+	// Upper bounds of the rain intensity bands in mm/h (American Meteorological Society;
+	// violent rain as defined by the WMO)
+	lightRainRate    = 2.5
+	moderateRainRate = 7.6
+	heavyRainRate    = 50
+)
+
+// RainIntensity is a standard rainfall intensity band.
+type RainIntensity int
+
+const (
+	// RainNone is less than 0.1 mm/h
+	RainNone RainIntensity = iota
+
+	// RainLight is less than 2.5 mm/h
+	RainLight
+
+	// RainModerate is 2.5 to 7.6 mm/h
+	RainModerate
+
+	// RainHeavy is 7.6 to 50 mm/h
+	RainHeavy
+
+	// RainViolent is 50 mm/h or more
+	RainViolent
+)
+
+// String returns the name of the band ("none", "light", "moderate", "heavy" or "violent").
+func (r RainIntensity) String() string {
+	switch r {
+	case RainNone:
+		return "none"
+	case RainLight:
+		return "light"
+	case RainModerate:
+		return "moderate"
+	case RainHeavy:
+		return "heavy"
+	case RainViolent:
+		return "violent"
+	default:
+		return fmt.Sprintf("RainIntensity(%d)", int(r))
+	}
+}
+
+// ClassifyRainRate returns the intensity band of a precipitation rate in mm/h.
+// Missing values (NaN) are RainNone. Convert rates in inches first, e.g., with
+// Quantity.Convert; RainIntensities and RainEvents do so for series.
+func ClassifyRainRate(mmPerHour float64) RainIntensity {
+	switch {
+	case math.IsNaN(mmPerHour) || mmPerHour < wetStepPrecipitation:
+		return RainNone
+	case mmPerHour < lightRainRate:
+		return RainLight
+	case mmPerHour < moderateRainRate:
+		return RainModerate
+	case mmPerHour < heavyRainRate:
+		return RainHeavy
+	default:
+		return RainViolent
+	}
+}
+
+// RainIntensities classifies each step of an hourly series containing HourlyPrecipitation.
+// Amounts are converted to rates using the series interval, so 3-hourly data
+// (WithTemporalResolution) is classified by its average rate, and amounts in inches
+// (see WithUnits) are converted to mm.
+func RainIntensities(hourly *Series) []RainIntensity {
+	hourly = hourly.metric()
+	hours := stepHours(hourly)
+	intensities := make([]RainIntensity, hourly.Len())
+	for i := range intensities {
+		intensities[i] = ClassifyRainRate(hourly.valueAt(HourlyPrecipitation, i) / hours)
+	}
+	return intensities
+}
+
+// RainEvent is a period of contiguous wet time steps.
+type RainEvent struct {
+	// Start is the beginning of the first wet step
+	Start time.Time
+
+	// End is the end of the last wet step
+	End time.Time
+
+	// Total is the precipitation total of the event in mm
+	Total float64
+
+	// MaxRate is the highest precipitation rate of the event in mm/h
+	MaxRate float64
+
+	// Intensity is the intensity band of MaxRate
+	Intensity RainIntensity
+}
+
+// Duration returns the length of the event.
+func (e RainEvent) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// RainEvents groups the contiguous wet steps (at least 0.1 mm) of an hourly series containing
+// HourlyPrecipitation into events, with their total and maximum intensity. Amounts in
+// inches (see WithUnits) are converted to mm.
+//
+// Example:
+//
+//	f, err := client.GetHourlyForecast(ctx, 51.51, -0.13, []openmeteo.Variable{openmeteo.HourlyPrecipitation})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, e := range openmeteo.RainEvents(&f.Hourly) {
+//	    fmt.Printf("%s: %.1f mm over %s, up to %s\n", e.Start.Format("Mon 15:04"), e.Total, e.Duration(), e.Intensity)
+//	}
+func RainEvents(hourly *Series) []RainEvent {
+	hourly = hourly.metric()
+	hours := stepHours(hourly)
+	windows := windowsOf(hourly.Time, func(i int) bool {
+		return hourly.valueAt(HourlyPrecipitation, i) >= wetStepPrecipitation
+	})
+
+	events := make([]RainEvent, 0, len(windows))
+	for _, w := range windows {
+		event := RainEvent{Start: w.Start, End: w.End}
+		for i, t := range hourly.Time {
+			if t.Before(w.Start) || !t.Before(w.End) {
+				continue
+			}
+			amount := hourly.valueAt(HourlyPrecipitation, i)
+			event.Total += amount
+			event.MaxRate = math.Max(event.MaxRate, amount/hours)
+		}
+		event.Intensity = ClassifyRainRate(event.MaxRate)
+		events = append(events, event)
+	}
+	return events
+}
+
+// stepHours returns the interval of a series in hours (1 when unknown).
+func stepHours(s *Series) float64 {
+	if interval := s.Interval(); interval > 0 {
+		return interval.Hours()
+	}
+	return 1
+}
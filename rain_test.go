@@ -0,0 +1,93 @@
+package openmeteo
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestClassifyRainRate tests the intensity bands
+func TestClassifyRainRate(t *testing.T) {
+	tests := []struct {
+		rate float64
+		want RainIntensity
+	}{
+		{math.NaN(), RainNone}, {0, RainNone}, {0.05, RainNone}, {0.1, RainLight}, {2.4, RainLight},
+		{2.5, RainModerate}, {7.5, RainModerate}, {7.6, RainHeavy}, {49, RainHeavy}, {50, RainViolent},
+	}
+	for _, tt := range tests {
+		if got := ClassifyRainRate(tt.rate); got != tt.want {
+			t.Errorf("ClassifyRainRate(%v) = %s, want %s", tt.rate, got, tt.want)
+		}
+	}
+	if RainViolent.String() != "violent" || RainIntensity(9).String() != "RainIntensity(9)" {
+		t.Error("Unexpected intensity names")
+	}
+
+	// 0.2 inch/h is 5.08 mm/h once converted
+	rate, _ := Quantity{Value: 0.2, Unit: UnitInch}.Convert(UnitMillimeter)
+	if got := ClassifyRainRate(rate.Value); got != RainModerate {
+		t.Errorf("Expected 0.2 inch/h to be moderate, got %s", got)
+	}
+}
+
+// TestRainEvents tests classification and grouping of wet hours into events
+func TestRainEvents(t *testing.T) {
+	start := time.Date(2025, 8, 14, 12, 0, 0, 0, time.UTC)
+	s := hourlySeries(start, map[Variable][]float64{
+		HourlyPrecipitation: {0, 0.4, 3, 12, 0.8, 0, 0.05, 1.2, math.NaN(), 0.2},
+	})
+
+	want := []RainIntensity{RainNone, RainLight, RainModerate, RainHeavy, RainLight, RainNone, RainNone, RainLight, RainNone, RainLight}
+	if got := RainIntensities(&s); !slices.Equal(got, want) {
+		t.Errorf("Expected intensities %v, got %v", want, got)
+	}
+
+	events := RainEvents(&s)
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %+v", events)
+	}
+	storm := events[0]
+	if !storm.Start.Equal(start.Add(time.Hour)) || storm.Duration() != 4*time.Hour {
+		t.Errorf("Unexpected first event window %+v", storm)
+	}
+	if math.Abs(storm.Total-16.2) > 1e-9 || storm.MaxRate != 12 || storm.Intensity != RainHeavy {
+		t.Errorf("Unexpected first event totals %+v", storm)
+	}
+	if events[1].Total != 1.2 || events[1].Duration() != time.Hour || events[2].Intensity != RainLight {
+		t.Errorf("Unexpected later events %+v", events[1:])
+	}
+}
+
+// TestRainEvents_ThreeHourly tests rates computed from 3-hourly amounts
+func TestRainEvents_ThreeHourly(t *testing.T) {
+	start := time.Date(2025, 8, 14, 0, 0, 0, 0, time.UTC)
+	s := Series{
+		Time:   []time.Time{start, start.Add(3 * time.Hour), start.Add(6 * time.Hour)},
+		Values: map[Variable][]float64{HourlyPrecipitation: {0, 9, 0}},
+	}
+	if got := RainIntensities(&s)[1]; got != RainModerate {
+		t.Errorf("Expected 3 mm/h to be moderate, got %s", got)
+	}
+	events := RainEvents(&s)
+	if len(events) != 1 || events[0].MaxRate != 3 || events[0].Duration() != 3*time.Hour {
+		t.Errorf("Unexpected 3-hourly event %+v", events)
+	}
+}
+
+// TestRainEvents_Imperial tests that amounts in inches are classified and totalled in mm
+func TestRainEvents_Imperial(t *testing.T) {
+	start := time.Date(2025, 8, 14, 12, 0, 0, 0, time.UTC)
+	s := hourlySeries(start, map[Variable][]float64{HourlyPrecipitation: {0, 0.02, 0.2, 0.5}})
+	s.Units = map[Variable]string{HourlyPrecipitation: "inch"}
+
+	want := []RainIntensity{RainNone, RainLight, RainModerate, RainHeavy}
+	if got := RainIntensities(&s); !slices.Equal(got, want) {
+		t.Errorf("Expected intensities %v, got %v", want, got)
+	}
+	events := RainEvents(&s)
+	if len(events) != 1 || math.Abs(events[0].Total-18.288) > 1e-9 || events[0].Intensity != RainHeavy {
+		t.Errorf("Expected one event of 18.288 mm, got %+v", events)
+	}
+}
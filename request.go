@@ -0,0 +1,605 @@
+package openmeteo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// RequestOption is a functional option for configuring a single API call.
+// Request options are passed to individual methods such as GetCurrentWeather
+// and only affect that call; the Client itself is not modified.
+type RequestOption func(*requestConfig)
+
+// requestConfig holds the per-call settings assembled from RequestOptions.
+type requestConfig struct {
+	// header holds additional HTTP headers sent with the request
+	header http.Header
+
+	// temporalResolution overrides the time step of hourly data (empty means API default)
+	temporalResolution TemporalResolution
+
+	// cellSelection selects the grid cell for the coordinates (empty means API default "land")
+	cellSelection CellSelection
+
+	// tilt is the panel inclination for global_tilted_irradiance in degrees (nil means API default)
+	tilt *float64
+
+	// azimuth is the panel orientation for global_tilted_irradiance in degrees (nil means API default)
+	azimuth *float64
+
+	// timezone is the IANA time zone (or "auto") for returned timestamps (empty means GMT)
+	timezone string
+
+	// startDate and endDate restrict the returned period by calendar day (zero means API default)
+	startDate time.Time
+	endDate   time.Time
+
+	// startHour and endHour restrict hourly data to a time interval (zero means API default)
+	startHour time.Time
+	endHour   time.Time
+
+	// language is the geocoding result language (ISO 639-1, empty means API default "en")
+	language string
+
+	// count is the maximum number of geocoding results (0 means API default of 10)
+	count int
+
+	// countryCode restricts geocoding results to a country (ISO-3166-1 alpha-2)
+	countryCode string
+
+	// pastDays includes the given number of past days in forecast data (0 means API default)
+	pastDays int
+
+	// pastHours includes the given number of past hours in hourly data (0 means API default)
+	pastHours int
+
+	// forecastDays is the number of forecast days, including today (0 means API default of 7)
+	forecastDays int
+
+	// models pins the forecast models (see WithModels)
+	models []Model
+
+	// endpoint replaces the /forecast path of forecast calls (see WithModelEndpoint)
+	endpoint ModelEndpoint
+
+	// datasets are the reanalysis datasets of historical requests, in order of preference
+	datasets []Model
+
+	// domain is the air quality domain (empty means API default "auto")
+	domain AirQualityDomain
+
+	// ensemble requests all ensemble members of flood forecasts (see WithFloodEnsemble)
+	ensemble bool
+
+	// disableBiasCorrection requests raw climate model output (see WithoutBiasCorrection)
+	disableBiasCorrection bool
+
+	// format is the response format (empty means API default JSON)
+	format Format
+
+	// progress receives the progress of bulk operations (see WithProgress)
+	progress func(Progress)
+
+	// received counts the response bytes of a bulk operation (nil outside of one)
+	received *atomic.Int64
+
+	// units overrides the client's unit system for this request (see WithRequestUnits)
+	units *UnitSystem
+
+	// err records the first invalid option value; it is reported as a validation *Error
+	err error
+}
+
+// newRequestConfig applies the given options to a fresh request configuration.
+func newRequestConfig(opts []RequestOption) *requestConfig {
+	cfg := &requestConfig{
+		header: make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithHeader adds an HTTP header to a single request. This is useful for passing
+// tenant identifiers, tracing headers or auth tokens to proxies and self-hosted
+// instances. Setting the same key twice keeps the last value.
+// The X-Request-ID header is always controlled by the SDK (see WithRequestID).
+//
+// Example:
+//
+//	weather, err := client.GetCurrentWeather(ctx, 52.52, 13.41,
+//	    openmeteo.WithHeader("X-Tenant-ID", "acme"),
+//	)
+func WithHeader(key, value string) RequestOption {
+	return func(r *requestConfig) {
+		r.header.Set(key, value)
+	}
+}
+
+// WithRequestUnits overrides the client's unit system (see WithUnits) for a single forecast,
+// ensemble, historical or climate request. The units are recorded on the results like the
+// client's. Unsupported units are ignored and the metric default is kept, so UnitsMetric
+// requests metric data from a client configured for other units.
+//
+// Example:
+//
+//	history, err := client.GetHistoricalWeather(ctx, 52.52, 13.41, start, end, vars,
+//	    openmeteo.WithRequestUnits(openmeteo.UnitsImperial),
+//	)
+func WithRequestUnits(units UnitSystem) RequestOption {
+	return func(r *requestConfig) {
+		units = units.normalize()
+		r.units = &units
+	}
+}
+
+// location returns the time zone in which the API interprets hour parameters:
+// the requested timezone if it can be loaded, otherwise UTC ("auto" cannot be resolved
+// client-side before the response is received).
+func (r *requestConfig) location() *time.Location {
+	if r.timezone == "" || r.timezone == "auto" {
+		return time.UTC
+	}
+	if loc, err := time.LoadLocation(r.timezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// invalid records a validation failure for an option. Only the first failure is kept.
+func (r *requestConfig) invalid(format string, args ...any) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, args...)
+	}
+}
+
+// check returns the first invalid option value as a validation *Error, or nil.
+func (r *requestConfig) check(requestID string) error {
+	if r.err == nil {
+		return nil
+	}
+	return &Error{
+		Type:      ErrorTypeValidation,
+		Message:   r.err.Error(),
+		RequestID: requestID,
+	}
+}
+
+// applyHeaders copies the configured headers onto an outgoing request.
+func (r *requestConfig) applyHeaders(req *http.Request) {
+	for key, values := range r.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+}
+
+// applyQuery adds the query parameters derived from per-request settings.
+func (r *requestConfig) applyQuery(q url.Values) {
+	if r.temporalResolution != "" {
+		q.Set("temporal_resolution", string(r.temporalResolution))
+	}
+	if r.timezone != "" {
+		q.Set("timezone", r.timezone)
+	}
+	if r.cellSelection != "" {
+		q.Set("cell_selection", string(r.cellSelection))
+	}
+	if !r.startDate.IsZero() {
+		q.Set("start_date", formatDate(r.startDate))
+		q.Set("end_date", formatDate(r.endDate))
+	}
+	if r.pastDays > 0 {
+		q.Set("past_days", strconv.Itoa(r.pastDays))
+	}
+	if r.pastHours > 0 {
+		q.Set("past_hours", strconv.Itoa(r.pastHours))
+	}
+	if r.forecastDays > 0 {
+		q.Set("forecast_days", strconv.Itoa(r.forecastDays))
+	}
+	if !r.startHour.IsZero() {
+		loc := r.location()
+		q.Set("start_hour", formatHour(r.startHour, loc))
+		q.Set("end_hour", formatHour(r.endHour, loc))
+	}
+	if r.ensemble {
+		q.Set("ensemble", "true")
+	}
+	if r.disableBiasCorrection {
+		q.Set("disable_bias_correction", "true")
+	}
+	if r.format == FormatCSV {
+		q.Set("format", string(r.format))
+	}
+	if r.tilt != nil {
+		q.Set("tilt", strconv.FormatFloat(*r.tilt, 'f', -1, 64))
+	}
+	if r.azimuth != nil {
+		q.Set("azimuth", strconv.FormatFloat(*r.azimuth, 'f', -1, 64))
+	}
+}
+
+// TemporalResolution selects the time step of hourly data returned by the API.
+type TemporalResolution string
+
+const (
+	// TemporalResolutionNative returns data at the native resolution of the weather model
+	// (e.g., 15-minutely or 1-hourly depending on the model).
+	TemporalResolutionNative TemporalResolution = "native"
+
+	// TemporalResolutionHourly1 returns 1-hourly data (the API default).
+	TemporalResolutionHourly1 TemporalResolution = "hourly_1"
+
+	// TemporalResolutionHourly3 aggregates hourly data into 3-hourly steps.
+	TemporalResolutionHourly3 TemporalResolution = "hourly_3"
+
+	// TemporalResolutionHourly6 aggregates hourly data into 6-hourly steps.
+	TemporalResolutionHourly6 TemporalResolution = "hourly_6"
+)
+
+// WithTemporalResolution sets the temporal_resolution parameter for hourly data.
+// Coarser resolutions reduce response size for long-range requests; the returned
+// Series reports the resulting step via Interval().
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyTemperature2m},
+//	    openmeteo.WithTemporalResolution(openmeteo.TemporalResolutionHourly6),
+//	)
+func WithTemporalResolution(resolution TemporalResolution) RequestOption {
+	return func(r *requestConfig) {
+		r.temporalResolution = resolution
+	}
+}
+
+// CellSelection selects which grid cell the API uses for the requested coordinates.
+type CellSelection string
+
+const (
+	// CellSelectionLand prefers a nearby land cell with a similar elevation (the API default
+	// for forecast data)
+	CellSelectionLand CellSelection = "land"
+
+	// CellSelectionSea prefers a nearby sea cell (the API default for marine data)
+	CellSelectionSea CellSelection = "sea"
+
+	// CellSelectionNearest uses the nearest cell regardless of land or sea
+	CellSelectionNearest CellSelection = "nearest"
+)
+
+// WithCellSelection sets the cell_selection parameter, which decides the grid cell used for
+// the coordinates. For shoreline locations the default cell may lie on the wrong side of the
+// coast; CellSelectionLand or CellSelectionSea pins the intended surface. Unknown values
+// cause the call to fail with an ErrorTypeValidation error.
+//
+// Example:
+//
+//	weather, err := client.GetCurrentWeather(ctx, 43.70, 7.27,
+//	    openmeteo.WithCellSelection(openmeteo.CellSelectionLand),
+//	)
+func WithCellSelection(selection CellSelection) RequestOption {
+	return func(r *requestConfig) {
+		switch selection {
+		case CellSelectionLand, CellSelectionSea, CellSelectionNearest:
+			r.cellSelection = selection
+		default:
+			r.invalid("invalid cell selection: %q (must be land, sea or nearest)", selection)
+		}
+	}
+}
+
+// WithPanelOrientation sets the tilt and azimuth of a solar panel used to compute
+// global_tilted_irradiance (HourlyGlobalTiltedIrradiance), giving plane-of-array
+// irradiance directly from the API.
+//
+// Parameters:
+//   - tilt: Panel inclination in degrees (0 = horizontal, 90 = vertical)
+//   - azimuth: Panel orientation in degrees (0 = south, -90 = east, 90 = west, ±180 = north)
+//
+// Out-of-range values cause the call to fail with an ErrorTypeValidation error.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyGlobalTiltedIrradiance},
+//	    openmeteo.WithPanelOrientation(35, 0),
+//	)
+func WithPanelOrientation(tilt, azimuth float64) RequestOption {
+	return func(r *requestConfig) {
+		if tilt < 0 || tilt > 90 {
+			r.invalid("invalid tilt: %.1f (must be between 0 and 90)", tilt)
+			return
+		}
+		if azimuth < -180 || azimuth > 180 {
+			r.invalid("invalid azimuth: %.1f (must be between -180 and 180)", azimuth)
+			return
+		}
+		r.tilt = &tilt
+		r.azimuth = &azimuth
+	}
+}
+
+// WithLanguage sets the language of geocoding results (ISO 639-1 code, e.g., "de").
+// Place, country and administrative area names are returned translated into this language.
+// The default is English.
+func WithLanguage(language string) RequestOption {
+	return func(r *requestConfig) {
+		r.language = strings.ToLower(strings.TrimSpace(language))
+	}
+}
+
+// WithResultCount sets the maximum number of geocoding results (1 to 100, default 10).
+// Out-of-range values cause the call to fail with an ErrorTypeValidation error.
+func WithResultCount(count int) RequestOption {
+	return func(r *requestConfig) {
+		if count < 1 || count > 100 {
+			r.invalid("invalid result count: %d (must be between 1 and 100)", count)
+			return
+		}
+		r.count = count
+	}
+}
+
+// WithCountryCode restricts geocoding results to a single country,
+// given as an ISO-3166-1 alpha-2 code (e.g., "DE").
+// Codes that are not two letters cause the call to fail with an ErrorTypeValidation error.
+func WithCountryCode(code string) RequestOption {
+	return func(r *requestConfig) {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+			r.invalid("invalid country code: %q (must be an ISO-3166-1 alpha-2 code)", code)
+			return
+		}
+		r.countryCode = code
+	}
+}
+
+// WithReanalysis selects the datasets of the historical weather API used by
+// DownloadHistoricalHourly (e.g., ReanalysisERA5Land), in order of preference. By default
+// the API combines the best available datasets. With several datasets, each variable is
+// served by the first dataset that has data for it; HistoricalHourly.Sources records
+// which one. Empty dataset names cause the call to fail with an ErrorTypeValidation error.
+//
+// Example:
+//
+//	history, err := client.DownloadHistoricalHourly(ctx, 47.37, 8.54, vars, start, end,
+//	    openmeteo.WithReanalysis(openmeteo.ReanalysisCERRA, openmeteo.ReanalysisERA5))
+func WithReanalysis(datasets ...Model) RequestOption {
+	return func(r *requestConfig) {
+		for _, d := range datasets {
+			if strings.TrimSpace(string(d)) == "" {
+				r.invalid("invalid reanalysis dataset: empty name")
+				return
+			}
+		}
+		r.datasets = append([]Model(nil), datasets...)
+	}
+}
+
+// WithAirQualityDomain selects the domain of air quality data returned by GetAirQuality.
+// The default (AirQualityDomainAuto) combines both CAMS domains; AirQualityDomainEurope
+// gives markedly better data for locations in Europe thanks to its 11 km resolution.
+// Other values make the request fail with a validation error.
+//
+// Example:
+//
+//	aq, err := client.GetAirQuality(ctx, 48.85, 2.35, openmeteo.PollenVariables,
+//	    openmeteo.WithAirQualityDomain(openmeteo.AirQualityDomainEurope))
+func WithAirQualityDomain(domain AirQualityDomain) RequestOption {
+	return func(r *requestConfig) {
+		switch domain {
+		case AirQualityDomainAuto, AirQualityDomainEurope, AirQualityDomainGlobal:
+			r.domain = domain
+		default:
+			r.invalid("invalid air quality domain: %q (must be auto, cams_europe or cams_global)", domain)
+		}
+	}
+}
+
+// WithTimezone requests timestamps in the given IANA time zone (e.g., "Europe/Berlin"),
+// or "auto" to use the time zone of the requested coordinates. Daily aggregations are
+// computed over local days in this zone.
+//
+// The resolved *time.Location is attached to results (Location fields), and accessors such
+// as Series.TimesInLocal and CurrentWeather.TimeInLocal return local wall-clock times.
+// Time fields always hold the correct instant, expressed in UTC.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyTemperature2m},
+//	    openmeteo.WithTimezone("auto"),
+//	)
+//	local := forecast.Hourly.TimesInLocal()
+func WithTimezone(timezone string) RequestOption {
+	return func(r *requestConfig) {
+		timezone = strings.TrimSpace(timezone)
+		if timezone == "" {
+			r.invalid("timezone must not be empty")
+			return
+		}
+		r.timezone = timezone
+	}
+}
+
+// WithDateRange restricts the returned data to the calendar days from start to end (inclusive),
+// setting the start_date and end_date parameters. The calendar date of each time.Time is taken
+// in its own location, so time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) means June 1st.
+//
+// Dates are validated before sending: both must be set, end must not be before start,
+// and start must not be before 1940-01-01. The range must also lie within the period served
+// by the called API (for forecasts, 92 days ago to 16 days ahead). Invalid ranges fail with
+// an ErrorTypeValidation error.
+//
+// Example:
+//
+//	start := time.Now().AddDate(0, 0, -3)
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars,
+//	    openmeteo.WithDateRange(start, start.AddDate(0, 0, 6)),
+//	)
+func WithDateRange(start, end time.Time) RequestOption {
+	return func(r *requestConfig) {
+		if err := validateDateRange(start, end); err != nil {
+			r.invalid("%v", err)
+			return
+		}
+		r.startDate = start
+		r.endDate = end
+	}
+}
+
+// WithModels pins the weather models of forecast calls (models parameter) instead of the
+// API's automatic best_match selection. With a single model the response is unchanged;
+// with several models every hourly and daily variable is returned once per model, suffixed
+// with the model name (see Series.ForModel). Current conditions can only be requested for a
+// single model (see GetCurrentWeatherMultiModel to compare models). A pinned model takes
+// precedence over the client's WithModelFallback chain. An empty list or model name fails
+// with an ErrorTypeValidation error.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars,
+//	    openmeteo.WithModels(openmeteo.ModelECMWFIFS025),
+//	)
+func WithModels(models ...Model) RequestOption {
+	return func(r *requestConfig) {
+		if len(models) == 0 {
+			r.invalid("at least one model is required")
+			return
+		}
+		for _, m := range models {
+			if m == "" {
+				r.invalid("model names must not be empty")
+				return
+			}
+		}
+		r.models = append([]Model(nil), models...)
+	}
+}
+
+// WithModelEndpoint sends forecast calls to a model-specific endpoint (e.g., /v1/dwd-icon)
+// instead of /v1/forecast. Model endpoints serve the models of one provider, including
+// variables only those models provide, such as HourlyLightningPotential from ICON-D2; the
+// response is parsed as for /v1/forecast. Combine with WithModels to pick a model of the
+// provider. Endpoint names that are not a single lowercase path segment fail with an
+// ErrorTypeValidation error.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41,
+//	    []openmeteo.Variable{openmeteo.HourlyLightningPotential},
+//	    openmeteo.WithModelEndpoint(openmeteo.EndpointDWDICON),
+//	    openmeteo.WithModels(openmeteo.ModelICOND2),
+//	)
+func WithModelEndpoint(endpoint ModelEndpoint) RequestOption {
+	return func(r *requestConfig) {
+		if endpoint == "" || strings.Trim(string(endpoint), "abcdefghijklmnopqrstuvwxyz0123456789-_") != "" {
+			r.invalid("invalid model endpoint: %q (must be a path segment such as \"dwd-icon\")", endpoint)
+			return
+		}
+		r.endpoint = endpoint
+	}
+}
+
+// maxPastDays is the largest number of past days the forecast APIs return.
+const maxPastDays = 92
+
+// WithPastDays includes the given number of past days (0 to 92) before today in forecast data,
+// setting the past_days parameter. The past days come from the same models and are returned
+// in the same series as the forecast, which is convenient for "yesterday vs today" charts.
+// Out-of-range values and combinations with WithDateRange or WithHourRange cause the call
+// to fail with an ErrorTypeValidation error.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars,
+//	    openmeteo.WithPastDays(1),
+//	)
+func WithPastDays(days int) RequestOption {
+	return func(r *requestConfig) {
+		if days < 0 || days > maxPastDays {
+			r.invalid("invalid past days: %d (must be between 0 and %d)", days, maxPastDays)
+			return
+		}
+		r.pastDays = days
+	}
+}
+
+// WithPastHours includes the given number of past hours before the current hour in hourly
+// data, setting the past_hours parameter. Unlike WithPastDays it does not affect daily data.
+// Negative values, more than 92 days of hours, combinations with WithDateRange or
+// WithHourRange and requests without hourly data fail with an ErrorTypeValidation error.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars,
+//	    openmeteo.WithPastHours(6),
+//	)
+func WithPastHours(hours int) RequestOption {
+	return func(r *requestConfig) {
+		if hours < 0 || hours > maxPastDays*24 {
+			r.invalid("invalid past hours: %d (must be between 0 and %d)", hours, maxPastDays*24)
+			return
+		}
+		r.pastHours = hours
+	}
+}
+
+// WithForecastDays sets the number of forecast days, including today (1 to 16), setting the
+// forecast_days parameter. The API default is 7 days; use 16 for the full forecast horizon.
+// Out-of-range values cause the call to fail with an ErrorTypeValidation error, as do more days
+// than the called API forecasts (7 for air quality) and combinations with WithDateRange or
+// WithHourRange.
+//
+// Example:
+//
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars,
+//	    openmeteo.WithForecastDays(16),
+//	)
+func WithForecastDays(days int) RequestOption {
+	return func(r *requestConfig) {
+		if days < 1 || days > 16 {
+			r.invalid("invalid forecast days: %d (must be between 1 and 16)", days)
+			return
+		}
+		r.forecastDays = days
+	}
+}
+
+// WithHourRange restricts hourly data to the interval from start to end (inclusive, truncated
+// to full hours), setting the start_hour and end_hour parameters. The instants are converted
+// to the requested timezone (see WithTimezone) before formatting.
+//
+// Both times must be set, end must not be before start, and start must not be before
+// 1940-01-01. Invalid ranges fail with an ErrorTypeValidation error.
+//
+// Example:
+//
+//	now := time.Now()
+//	forecast, err := client.GetHourlyForecast(ctx, 52.52, 13.41, vars,
+//	    openmeteo.WithHourRange(now, now.Add(12*time.Hour)),
+//	)
+func WithHourRange(start, end time.Time) RequestOption {
+	return func(r *requestConfig) {
+		if start.IsZero() || end.IsZero() {
+			r.invalid("start and end hours must be set")
+			return
+		}
+		if end.Before(start) {
+			r.invalid("invalid hour range: end %s is before start %s", end.UTC().Format(apiHourLayout), start.UTC().Format(apiHourLayout))
+			return
+		}
+		if start.Before(earliestDate) {
+			r.invalid("invalid start hour %s (data is available from %s)", start.UTC().Format(apiHourLayout), formatDate(earliestDate))
+			return
+		}
+		r.startHour = start
+		r.endHour = end
+	}
+}
@@ -0,0 +1,267 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestWithHeader tests that WithHeader populates the request configuration
+func TestWithHeader(t *testing.T) {
+	cfg := newRequestConfig([]RequestOption{
+		WithHeader("X-Tenant-ID", "acme"),
+		WithHeader("Authorization", "Bearer one"),
+		WithHeader("Authorization", "Bearer two"),
+	})
+
+	if cfg.header.Get("X-Tenant-ID") != "acme" {
+		t.Errorf("Expected X-Tenant-ID acme, got %q", cfg.header.Get("X-Tenant-ID"))
+	}
+	if got := cfg.header.Values("Authorization"); len(got) != 1 || got[0] != "Bearer two" {
+		t.Errorf("Expected last Authorization value to win, got %v", got)
+	}
+}
+
+// TestGetCurrentWeather_WithHeader tests that per-request headers are sent only on that request
+func TestGetCurrentWeather_WithHeader(t *testing.T) {
+	var tenants []string
+	var requestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Header.Get("X-Tenant-ID"))
+		requestIDs = append(requestIDs, r.Header.Get(RequestIDHeader))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := WithRequestID(context.Background(), "fixed-id")
+
+	_, err := client.GetCurrentWeather(ctx, 52.52, 13.41,
+		WithHeader("X-Tenant-ID", "acme"),
+		WithHeader(RequestIDHeader, "overridden"),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	_, err = client.GetCurrentWeather(ctx, 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if tenants[0] != "acme" {
+		t.Errorf("Expected X-Tenant-ID acme on first request, got %q", tenants[0])
+	}
+	if tenants[1] != "" {
+		t.Errorf("Expected no X-Tenant-ID on second request, got %q", tenants[1])
+	}
+	if requestIDs[0] != "fixed-id" {
+		t.Errorf("Expected SDK-controlled request ID, got %q", requestIDs[0])
+	}
+}
+
+// TestWithPanelOrientation tests tilt and azimuth query parameters and validation
+func TestWithPanelOrientation(t *testing.T) {
+	cfg := newRequestConfig([]RequestOption{WithPanelOrientation(35, -90)})
+	if cfg.err != nil {
+		t.Fatalf("Expected no error, got %v", cfg.err)
+	}
+
+	q := url.Values{}
+	cfg.applyQuery(q)
+	if q.Get("tilt") != "35" || q.Get("azimuth") != "-90" {
+		t.Errorf("Expected tilt=35 azimuth=-90, got tilt=%s azimuth=%s", q.Get("tilt"), q.Get("azimuth"))
+	}
+
+	testCases := []struct {
+		name    string
+		tilt    float64
+		azimuth float64
+	}{
+		{"Negative tilt", -1, 0},
+		{"Tilt above vertical", 91, 0},
+		{"Azimuth too small", 30, -181},
+		{"Azimuth too large", 30, 181},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newRequestConfig([]RequestOption{WithPanelOrientation(tc.tilt, tc.azimuth)})
+			var apiErr *Error
+			if !errors.As(cfg.check("id"), &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", cfg.check("id"))
+			}
+		})
+	}
+}
+
+// TestGetHourlyForecast_SolarRadiation tests requesting all irradiance components for a tilted panel
+func TestGetHourlyForecast_SolarRadiation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		want := "shortwave_radiation,direct_radiation,direct_normal_irradiance,diffuse_radiation,global_tilted_irradiance"
+		if q.Get("hourly") != want || q.Get("tilt") != "30" || q.Get("azimuth") != "-45" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"latitude": 48.1, "longitude": 11.6, "hourly": {"time": ["2025-06-21T12:00"],
+			"shortwave_radiation": [850], "direct_radiation": [700], "direct_normal_irradiance": [820],
+			"diffuse_radiation": [150], "global_tilted_irradiance": [930]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	f, err := client.GetHourlyForecast(context.Background(), 48.14, 11.58, SolarRadiationVariables, WithPanelOrientation(30, -45))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if f.Hourly.Get(HourlyGlobalTiltedIrradiance)[0] != 930 || f.Hourly.Get(HourlyDiffuseRadiation)[0] != 150 {
+		t.Errorf("Unexpected values %v", f.Hourly.Values)
+	}
+
+	// An orientation without a tilted irradiance variable has no effect and is rejected
+	_, err = client.GetHourlyForecast(context.Background(), 48.14, 11.58,
+		[]Variable{HourlyShortwaveRadiationInstant}, WithPanelOrientation(30, -45))
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error for orientation without GTI, got %v", err)
+	}
+}
+
+// TestWithForecastDays tests the forecast_days query parameter and validation
+func TestWithForecastDays(t *testing.T) {
+	cfg := newRequestConfig([]RequestOption{WithForecastDays(16)})
+	q := url.Values{}
+	cfg.applyQuery(q)
+	if cfg.err != nil || q.Get("forecast_days") != "16" {
+		t.Errorf("Expected forecast_days=16, got %q (err %v)", q.Get("forecast_days"), cfg.err)
+	}
+
+	q = url.Values{}
+	newRequestConfig(nil).applyQuery(q)
+	if q.Has("forecast_days") {
+		t.Errorf("Expected no forecast_days by default, got %q", q.Get("forecast_days"))
+	}
+
+	for _, days := range []int{0, -1, 17} {
+		cfg := newRequestConfig([]RequestOption{WithForecastDays(days)})
+		var apiErr *Error
+		if !errors.As(cfg.check("id"), &apiErr) || apiErr.Type != ErrorTypeValidation {
+			t.Errorf("Expected validation error for %d days, got %v", days, cfg.check("id"))
+		}
+	}
+}
+
+// TestWithCellSelection tests the cell_selection query parameter and validation
+func TestWithCellSelection(t *testing.T) {
+	for _, selection := range []CellSelection{CellSelectionLand, CellSelectionSea, CellSelectionNearest} {
+		cfg := newRequestConfig([]RequestOption{WithCellSelection(selection)})
+		q := url.Values{}
+		cfg.applyQuery(q)
+		if cfg.err != nil || q.Get("cell_selection") != string(selection) {
+			t.Errorf("Expected cell_selection=%s, got %q (err %v)", selection, q.Get("cell_selection"), cfg.err)
+		}
+	}
+
+	q := url.Values{}
+	newRequestConfig(nil).applyQuery(q)
+	if q.Has("cell_selection") {
+		t.Errorf("Expected no cell_selection by default, got %q", q.Get("cell_selection"))
+	}
+
+	cfg := newRequestConfig([]RequestOption{WithCellSelection("coast")})
+	var apiErr *Error
+	if !errors.As(cfg.check("id"), &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error for unknown selection, got %v", cfg.check("id"))
+	}
+}
+
+// TestWithPastDays tests the past_days and past_hours query parameters and validation
+func TestWithPastDays(t *testing.T) {
+	cfg := newRequestConfig([]RequestOption{WithPastDays(92), WithPastHours(6)})
+	q := url.Values{}
+	cfg.applyQuery(q)
+	if cfg.err != nil || q.Get("past_days") != "92" || q.Get("past_hours") != "6" {
+		t.Errorf("Expected past_days=92 and past_hours=6, got %q (err %v)", q.Encode(), cfg.err)
+	}
+
+	q = url.Values{}
+	newRequestConfig([]RequestOption{WithPastDays(0), WithPastHours(0)}).applyQuery(q)
+	if q.Has("past_days") || q.Has("past_hours") {
+		t.Errorf("Expected no past parameters for zero values, got %q", q.Encode())
+	}
+
+	invalid := []RequestOption{WithPastDays(-1), WithPastDays(93), WithPastHours(-1), WithPastHours(92*24 + 1)}
+	for i, opt := range invalid {
+		cfg := newRequestConfig([]RequestOption{opt})
+		var apiErr *Error
+		if !errors.As(cfg.check("id"), &apiErr) || apiErr.Type != ErrorTypeValidation {
+			t.Errorf("Case %d: expected validation error, got %v", i, cfg.check("id"))
+		}
+	}
+}
+
+// TestGetHourlyForecast_PanelOrientation tests that invalid options fail before any HTTP call
+func TestGetHourlyForecast_PanelOrientation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	_, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyGlobalTiltedIrradiance}, WithPanelOrientation(120, 0))
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+	if called {
+		t.Error("Expected no HTTP request for invalid options")
+	}
+}
+
+// TestWithRequestUnits tests that per-request units override the client's units in the query
+// and on the results of historical and multi-model requests
+func TestWithRequestUnits(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/archive" {
+			_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41,
+				"hourly": {"time": ["2024-07-01T00:00"], "temperature_2m": [65.3]}}`)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {
+			"time": "2025-12-29T10:00", "temperature_2m": 4.5}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithArchiveBaseURL(server.URL), WithUnits(UnitsImperial))
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := client.GetHistoricalWeather(context.Background(), 52.52, 13.41, start, start,
+		[]Variable{HourlyTemperature2m}, WithRequestUnits(UnitSystem{Temperature: UnitFahrenheit})); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	byModel, err := client.GetCurrentWeatherMultiModel(context.Background(), 52.52, 13.41,
+		[]Model{ModelGFSSeamless}, WithRequestUnits(UnitsMetric))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if q := queries[0]; q.Get("temperature_unit") != "fahrenheit" || q.Has("wind_speed_unit") || q.Has("precipitation_unit") {
+		t.Errorf("Expected only the per-request temperature unit, got %v", q)
+	}
+	if q := queries[1]; q.Has("temperature_unit") || q.Has("wind_speed_unit") || q.Has("precipitation_unit") {
+		t.Errorf("Expected metric defaults, got %v", q)
+	}
+	weather := byModel[ModelGFSSeamless]
+	if weather == nil || weather.Units != UnitsMetric || weather.QuantityOfTemperature() != "4.5°C" {
+		t.Errorf("Expected metric units on the result, got %+v", weather)
+	}
+}
@@ -0,0 +1,48 @@
+package openmeteo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDHeader is the HTTP header used to send the request ID with every API call.
+// Self-hosted Open Meteo instances (or proxies in front of them) can log this header
+// to correlate server-side entries with client-side failures.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDKey is the context key under which a caller-supplied request ID is stored.
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the given request ID.
+// The SDK sends it in the X-Request-ID header and attaches it to any *Error returned
+// for that call. When no request ID is present in the context, one is generated.
+//
+// Example:
+//
+//	ctx := openmeteo.WithRequestID(context.Background(), "checkout-1234")
+//	weather, err := client.GetCurrentWeather(ctx, 52.52, 13.41)
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx by WithRequestID, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// requestIDFor returns the request ID from ctx or generates a new random one.
+func requestIDFor(ctx context.Context) string {
+	if id, ok := RequestIDFromContext(ctx); ok {
+		return id
+	}
+	return newRequestID()
+}
+
+// newRequestID generates a random 128-bit request ID encoded as 32 hex characters.
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,103 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRequestIDFromContext tests storing and retrieving a request ID in a context
+func TestRequestIDFromContext(t *testing.T) {
+	if _, ok := RequestIDFromContext(context.Background()); ok {
+		t.Error("Expected no request ID in empty context")
+	}
+
+	ctx := WithRequestID(context.Background(), "abc-123")
+	id, ok := RequestIDFromContext(ctx)
+	if !ok || id != "abc-123" {
+		t.Errorf("Expected request ID abc-123, got %q (ok=%v)", id, ok)
+	}
+
+	if _, ok := RequestIDFromContext(WithRequestID(context.Background(), "")); ok {
+		t.Error("Expected empty request ID to be treated as absent")
+	}
+}
+
+// TestNewRequestID tests that generated request IDs are unique hex strings
+func TestNewRequestID(t *testing.T) {
+	a, b := newRequestID(), newRequestID()
+	if len(a) != 32 {
+		t.Errorf("Expected 32-character request ID, got %q", a)
+	}
+	if a == b {
+		t.Error("Expected generated request IDs to differ")
+	}
+}
+
+// TestGetCurrentWeather_RequestIDHeader tests that the request ID from context is sent and reported in errors
+func TestGetCurrentWeather_RequestIDHeader(t *testing.T) {
+	var gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get(RequestIDHeader)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprint(w, "boom")
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := WithRequestID(context.Background(), "trace-42")
+	_, err := client.GetCurrentWeather(ctx, 52.52, 13.41)
+
+	if gotHeader != "trace-42" {
+		t.Errorf("Expected X-Request-ID trace-42, got %q", gotHeader)
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if apiErr.RequestID != "trace-42" {
+		t.Errorf("Expected error request ID trace-42, got %q", apiErr.RequestID)
+	}
+}
+
+// TestGetCurrentWeather_GeneratedRequestID tests that a request ID is generated when none is supplied
+func TestGetCurrentWeather_GeneratedRequestID(t *testing.T) {
+	var gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get(RequestIDHeader)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	_, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if gotHeader == "" {
+		t.Error("Expected a generated X-Request-ID header")
+	}
+	if apiErr.RequestID != gotHeader {
+		t.Errorf("Expected error request ID %q to match header, got %q", gotHeader, apiErr.RequestID)
+	}
+}
+
+// TestGetCurrentWeather_ValidationErrorRequestID tests that validation errors carry the request ID
+func TestGetCurrentWeather_ValidationErrorRequestID(t *testing.T) {
+	client := NewClient()
+	ctx := WithRequestID(context.Background(), "invalid-coords")
+	_, err := client.GetCurrentWeather(ctx, 91, 0)
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if apiErr.RequestID != "invalid-coords" {
+		t.Errorf("Expected request ID invalid-coords, got %q", apiErr.RequestID)
+	}
+}
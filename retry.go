@@ -0,0 +1,146 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Defaults applied to zero fields of a RetryPolicy.
+const (
+	defaultRetryAttempts   = 3
+	defaultRetryBackoff    = 200 * time.Millisecond
+	defaultRetryMaxElapsed = 30 * time.Second
+	defaultRetriesPerMin   = 60
+)
+
+// RetryPolicy configures retries of failed calls (see WithRetry). Zero fields use defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per call, including the first (default 3)
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry; it doubles with every further retry
+	// and is randomized by up to half to avoid synchronized retries (default 200ms)
+	InitialBackoff time.Duration
+
+	// MaxElapsed bounds the total time of a call across all attempts and waits; no retry is
+	// started that would end its wait past this limit (default 30s)
+	MaxElapsed time.Duration
+
+	// RetriesPerMinute is the client-wide retry budget: once this many retries were made in
+	// the last minute, failed calls are returned without retrying until the budget recovers,
+	// so retries cannot amplify an upstream outage (default 60; negative means unlimited)
+	RetriesPerMinute int
+}
+
+// WithRetry retries calls that fail with a network error, HTTP 429 or a 5xx status, with
+// exponential backoff, a maximum elapsed time per call and a client-wide retry budget.
+// Validation errors and other API errors are never retried. Without this option calls are
+// not retried.
+//
+// Example:
+//
+//	client := openmeteo.NewClient(openmeteo.WithRetry(openmeteo.RetryPolicy{
+//	    MaxAttempts:      4,
+//	    MaxElapsed:       10 * time.Second,
+//	    RetriesPerMinute: 20,
+//	}))
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = defaultRetryAttempts
+		}
+		if policy.InitialBackoff <= 0 {
+			policy.InitialBackoff = defaultRetryBackoff
+		}
+		if policy.MaxElapsed <= 0 {
+			policy.MaxElapsed = defaultRetryMaxElapsed
+		}
+		if policy.RetriesPerMinute == 0 {
+			policy.RetriesPerMinute = defaultRetriesPerMin
+		}
+		c.retry = &retrier{policy: policy}
+	}
+}
+
+// retrier applies a RetryPolicy and tracks the client-wide retry budget. It is safe for
+// concurrent use.
+type retrier struct {
+	policy RetryPolicy
+
+	mu      sync.Mutex
+	retries []time.Time // times of the retries in the last minute
+}
+
+// do calls fetch until it succeeds, fails with a non-retryable error, or the attempts, the
+// elapsed time or the retry budget are exhausted. It returns the last error. notify, if not
+// nil, is called before each retry with the failed attempt, the wait and its error.
+func (r *retrier) do(ctx context.Context, fetch func() error, notify func(attempt int, wait time.Duration, err error)) error {
+	start := time.Now()
+	backoff := r.policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fetch()
+		if err == nil || attempt >= r.policy.MaxAttempts || !retryable(ctx, err) {
+			return err
+		}
+
+		wait := backoff/2 + rand.N(backoff/2+1)
+		if time.Since(start)+wait > r.policy.MaxElapsed || !r.spend(time.Now()) {
+			return err
+		}
+		if notify != nil {
+			notify(attempt, wait, err)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// spend takes one retry from the budget, reporting false if it is exhausted.
+func (r *retrier) spend(now time.Time) bool {
+	if r.policy.RetriesPerMinute < 0 {
+		return true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recent := r.retries[:0]
+	for _, t := range r.retries {
+		if now.Sub(t) < time.Minute {
+			recent = append(recent, t)
+		}
+	}
+	r.retries = recent
+	if len(r.retries) >= r.policy.RetriesPerMinute {
+		return false
+	}
+	r.retries = append(r.retries, now)
+	return true
+}
+
+// retryable reports whether err is a transient failure worth retrying.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var sdkErr *Error
+	if !errors.As(err, &sdkErr) {
+		return false
+	}
+	switch sdkErr.Type {
+	case ErrorTypeNetwork:
+		return true
+	case ErrorTypeAPI:
+		return sdkErr.statusCode == http.StatusTooManyRequests || sdkErr.statusCode >= 500
+	default:
+		return false
+	}
+}
@@ -0,0 +1,134 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyServer fails the first failures calls with status, then serves current weather
+func flakyServer(t *testing.T, failures int32, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00", "temperature_2m": 15.3}}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+// TestWithRetry tests which failures are retried
+func TestWithRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int32
+		status    int
+		wantCalls int32
+		wantErr   bool
+	}{
+		{"recovers from 503", 2, http.StatusServiceUnavailable, 3, false},
+		{"recovers from 429", 1, http.StatusTooManyRequests, 2, false},
+		{"gives up after max attempts", 5, http.StatusBadGateway, 3, true},
+		{"does not retry 400", 1, http.StatusBadRequest, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := flakyServer(t, tt.failures, tt.status)
+			client := NewClient(WithBaseURL(server.URL), WithRetry(RetryPolicy{InitialBackoff: time.Millisecond}))
+
+			_, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("Expected %d calls, got %d", tt.wantCalls, calls.Load())
+			}
+		})
+	}
+}
+
+// TestWithRetry_NetworkError tests retries of transport failures
+func TestWithRetry_NetworkError(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		_ = conn.Close()
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL), WithRetry(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+
+	_, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeNetwork || calls.Load() != 2 {
+		t.Errorf("Expected a network error after 2 calls, got %v after %d", err, calls.Load())
+	}
+}
+
+// TestWithRetry_MaxElapsed tests that no retry waits past the elapsed time limit
+func TestWithRetry_MaxElapsed(t *testing.T) {
+	server, calls := flakyServer(t, 10, http.StatusInternalServerError)
+	client := NewClient(WithBaseURL(server.URL),
+		WithRetry(RetryPolicy{MaxAttempts: 10, InitialBackoff: 40 * time.Millisecond, MaxElapsed: 100 * time.Millisecond}))
+
+	start := time.Now()
+	if _, err := client.GetCurrentWeather(context.Background(), 52.52, 13.41); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("Expected the call to stop within the elapsed limit, took %s", elapsed)
+	}
+	if calls.Load() < 2 || calls.Load() > 3 {
+		t.Errorf("Expected 2-3 calls within 100ms, got %d", calls.Load())
+	}
+}
+
+// TestWithRetry_Budget tests that the client-wide budget stops retry storms
+func TestWithRetry_Budget(t *testing.T) {
+	server, calls := flakyServer(t, 100, http.StatusServiceUnavailable)
+	client := NewClient(WithBaseURL(server.URL),
+		WithRetry(RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, RetriesPerMinute: 3}))
+
+	for range 3 {
+		_, _ = client.GetCurrentWeather(context.Background(), 52.52, 13.41)
+	}
+	// 3 first attempts plus the 3 retries of the budget
+	if calls.Load() != 6 {
+		t.Errorf("Expected 6 calls, got %d", calls.Load())
+	}
+
+	r := &retrier{policy: RetryPolicy{RetriesPerMinute: 1}}
+	now := time.Now()
+	if !r.spend(now) || r.spend(now.Add(30*time.Second)) || !r.spend(now.Add(61*time.Second)) {
+		t.Error("Expected the budget to recover after a minute")
+	}
+	if unlimited := (&retrier{policy: RetryPolicy{RetriesPerMinute: -1}}); !unlimited.spend(now) || !unlimited.spend(now) {
+		t.Error("Expected a negative budget to be unlimited")
+	}
+}
+
+// TestWithRetry_ContextCanceled tests that cancellation stops retrying
+func TestWithRetry_ContextCanceled(t *testing.T) {
+	server, calls := flakyServer(t, 10, http.StatusServiceUnavailable)
+	client := NewClient(WithBaseURL(server.URL), WithRetry(RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.GetCurrentWeather(ctx, 52.52, 13.41); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if time.Since(start) > 500*time.Millisecond || calls.Load() != 1 {
+		t.Errorf("Expected the wait to end with the context after 1 call, got %d calls", calls.Load())
+	}
+}
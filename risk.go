@@ -0,0 +1,71 @@
+package openmeteo
+
+import (
+	"fmt"
+	"time"
+)
+
+// RiskLevel grades a weather-related risk reported by the helpers in this package.
+type RiskLevel int
+
+const (
+	// RiskLow indicates no or minimal risk
+	RiskLow RiskLevel = iota
+
+	// RiskMedium indicates a risk worth monitoring
+	RiskMedium
+
+	// RiskHigh indicates a risk that likely requires action
+	RiskHigh
+)
+
+// String returns the name of the risk level ("low", "medium" or "high").
+func (r RiskLevel) String() string {
+	switch r {
+	case RiskLow:
+		return "low"
+	case RiskMedium:
+		return "medium"
+	case RiskHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("RiskLevel(%d)", int(r))
+	}
+}
+
+// TimeWindow is a period [Start, End) flagged by a helper (e.g., hours with black ice risk).
+type TimeWindow struct {
+	// Start is the beginning of the window
+	Start time.Time
+
+	// End is the end of the window (exclusive)
+	End time.Time
+}
+
+// Duration returns the length of the window.
+func (w TimeWindow) Duration() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// windowsOf merges consecutive flagged steps into windows. Each step lasts until the next
+// one; the last step lasts one step interval.
+func windowsOf(times []time.Time, flagged func(i int) bool) []TimeWindow {
+	var windows []TimeWindow
+	for i, t := range times {
+		if !flagged(i) {
+			continue
+		}
+		end := t.Add(time.Hour)
+		if i+1 < len(times) {
+			end = times[i+1]
+		} else if i > 0 {
+			end = t.Add(t.Sub(times[i-1]))
+		}
+		if n := len(windows); n > 0 && windows[n-1].End.Equal(t) {
+			windows[n-1].End = end
+			continue
+		}
+		windows = append(windows, TimeWindow{Start: t, End: end})
+	}
+	return windows
+}
@@ -0,0 +1,184 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+// RoadVariables lists the hourly variables used by RoadRisk.
+var RoadVariables = []Variable{
+	HourlyTemperature2m,
+	HourlyPrecipitation,
+	HourlySnowfall,
+	HourlyWeatherCode,
+	HourlyVisibility,
+	HourlyWindGusts10m,
+}
+
+// RoadFactor names a condition contributing to the road risk of an hour.
+type RoadFactor string
+
+const (
+	// RoadFactorFreezingRain is freezing rain or drizzle (WMO codes 56, 57, 66, 67)
+	RoadFactorFreezingRain RoadFactor = "freezing_rain"
+
+	// RoadFactorWetNearFreezing is a wet road surface (precipitation within the last
+	// three hours) at temperatures around freezing
+	RoadFactorWetNearFreezing RoadFactor = "wet_near_freezing"
+
+	// RoadFactorNearFreezing is an air temperature between -3 °C and 2 °C
+	RoadFactorNearFreezing RoadFactor = "near_freezing"
+
+	// RoadFactorSnow is falling snow
+	RoadFactorSnow RoadFactor = "snow"
+
+	// RoadFactorHeavySnow is snowfall of at least 1 cm per hour
+	RoadFactorHeavySnow RoadFactor = "heavy_snow"
+
+	// RoadFactorLowVisibility is visibility below 1000 m
+	RoadFactorLowVisibility RoadFactor = "low_visibility"
+
+	// RoadFactorVeryLowVisibility is visibility below 200 m
+	RoadFactorVeryLowVisibility RoadFactor = "very_low_visibility"
+
+	// RoadFactorStrongGusts is wind gusts of at least 60 km/h
+	RoadFactorStrongGusts RoadFactor = "strong_gusts"
+)
+
+// roadFactorScores is the score each factor adds to an hour's road risk (0-100).
+var roadFactorScores = map[RoadFactor]int{
+	RoadFactorFreezingRain:      50,
+	RoadFactorWetNearFreezing:   35,
+	RoadFactorNearFreezing:      10,
+	RoadFactorSnow:              20,
+	RoadFactorHeavySnow:         15,
+	RoadFactorLowVisibility:     15,
+	RoadFactorVeryLowVisibility: 20,
+	RoadFactorStrongGusts:       15,
+}
+
+// RoadHour is the road risk assessment of one time step.
+type RoadHour struct {
+	// Time is the start of the time step
+	Time time.Time
+
+	// Score is the combined risk score from 0 (no risk) to 100
+	Score int
+
+	// Risk grades the score (medium from 30, high from 60, or when black ice or a whiteout is likely)
+	Risk RiskLevel
+
+	// BlackIce flags likely black ice (freezing rain, or a wet road at or below 1 °C)
+	BlackIce bool
+
+	// Whiteout flags a likely whiteout (falling snow with very low visibility or gusts of 50 km/h or more)
+	Whiteout bool
+
+	// Factors lists the conditions contributing to the score
+	Factors []RoadFactor
+}
+
+// RoadReport is the result of RoadRisk.
+type RoadReport struct {
+	// Hours holds the assessment of every time step
+	Hours []RoadHour
+
+	// BlackIce lists the periods with likely black ice
+	BlackIce []TimeWindow
+
+	// Whiteout lists the periods with likely whiteouts
+	Whiteout []TimeWindow
+}
+
+// RoadRisk scores the road risk of every step of an hourly series containing RoadVariables
+// from precipitation type, temperature around freezing, visibility and wind gusts, and flags
+// likely black ice and whiteout windows. Values in other units (see WithUnits) are converted
+// to the API's default units first. Missing variables do not contribute to the score.
+//
+// Example:
+//
+//	f, err := client.GetHourlyForecast(ctx, 59.33, 18.07, openmeteo.RoadVariables)
+//	if err != nil {
+//	    return err
+//	}
+//	report := openmeteo.RoadRisk(&f.Hourly)
+//	for _, w := range report.BlackIce {
+//	    fmt.Printf("black ice likely from %s for %s\n", w.Start.Format("15:04"), w.Duration())
+//	}
+func RoadRisk(hourly *Series) RoadReport {
+	hourly = hourly.metric()
+	temp := hourly.Values[HourlyTemperature2m]
+	precipitation := hourly.Values[HourlyPrecipitation]
+	snowfall := hourly.Values[HourlySnowfall]
+	codes := hourly.Values[HourlyWeatherCode]
+	visibility := hourly.Values[HourlyVisibility]
+	gusts := hourly.Values[HourlyWindGusts10m]
+
+	at := func(values []float64, i int) float64 {
+		if i < 0 || i >= len(values) {
+			return math.NaN()
+		}
+		return values[i]
+	}
+
+	report := RoadReport{Hours: make([]RoadHour, len(hourly.Time))}
+	for i, t := range hourly.Time {
+		h := RoadHour{Time: t}
+		add := func(f RoadFactor) {
+			h.Factors = append(h.Factors, f)
+			h.Score += roadFactorScores[f]
+		}
+
+		tc := at(temp, i)
+		wet := false
+		for j := i - 3; j <= i; j++ {
+			if at(precipitation, j) > 0 {
+				wet = true
+			}
+		}
+		code := at(codes, i)
+		snowing := at(snowfall, i) > 0 || code == 71 || code == 73 || code == 75 || code == 77 || code == 85 || code == 86
+
+		if code == 56 || code == 57 || code == 66 || code == 67 {
+			add(RoadFactorFreezingRain)
+			h.BlackIce = true
+		}
+		if wet && tc <= 1 && tc >= -6 {
+			add(RoadFactorWetNearFreezing)
+			h.BlackIce = true
+		} else if tc >= -3 && tc <= 2 {
+			add(RoadFactorNearFreezing)
+		}
+		if snowing {
+			add(RoadFactorSnow)
+			if at(snowfall, i) >= 1 {
+				add(RoadFactorHeavySnow)
+			}
+		}
+		vis := at(visibility, i)
+		if vis < 200 {
+			add(RoadFactorVeryLowVisibility)
+		} else if vis < 1000 {
+			add(RoadFactorLowVisibility)
+		}
+		if at(gusts, i) >= 60 {
+			add(RoadFactorStrongGusts)
+		}
+		h.Whiteout = snowing && (vis < 200 || at(gusts, i) >= 50)
+
+		h.Score = min(h.Score, 100)
+		switch {
+		case h.Score >= 60 || h.BlackIce || h.Whiteout:
+			h.Risk = RiskHigh
+		case h.Score >= 30:
+			h.Risk = RiskMedium
+		}
+		report.Hours[i] = h
+	}
+
+	report.BlackIce = windowsOf(hourly.Time, func(i int) bool { return report.Hours[i].BlackIce })
+	report.Whiteout = windowsOf(hourly.Time, func(i int) bool { return report.Hours[i].Whiteout })
+	return report
+}
@@ -0,0 +1,83 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestRoadRisk tests hourly scoring and black ice/whiteout windows
+func TestRoadRisk(t *testing.T) {
+	start := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	nan := math.NaN()
+	s := hourlySeries(start, map[Variable][]float64{
+		//                      0    1    2     3     4     5     6     7
+		HourlyTemperature2m: {8, 0.5, 3, 3, 3, -4, -4, 12},
+		HourlyPrecipitation: {0, 0.4, 0, 0, 0, 0, 0, 0},
+		HourlySnowfall:      {0, 0, 0, 0, 0, 1.5, 0.4, 0},
+		HourlyWeatherCode:   {0, 61, 66, 3, 3, 75, 73, 0},
+		HourlyVisibility:    {20000, 8000, 900, 20000, 20000, 150, 5000, nan},
+		HourlyWindGusts10m:  {20, 20, 20, 20, 20, 40, 65, 20},
+	})
+
+	report := RoadRisk(&s)
+	if len(report.Hours) != 8 {
+		t.Fatalf("Expected 8 hours, got %d", len(report.Hours))
+	}
+
+	clear := report.Hours[0]
+	if clear.Score != 0 || clear.Risk != RiskLow || len(clear.Factors) != 0 {
+		t.Errorf("Hour 0: expected no risk, got %+v", clear)
+	}
+
+	wet := report.Hours[1]
+	if !wet.BlackIce || wet.Risk != RiskHigh || !slices.Contains(wet.Factors, RoadFactorWetNearFreezing) {
+		t.Errorf("Hour 1: expected black ice from wet road near freezing, got %+v", wet)
+	}
+
+	freezingRain := report.Hours[2]
+	want := []RoadFactor{RoadFactorFreezingRain, RoadFactorLowVisibility}
+	if !freezingRain.BlackIce || !slices.Equal(freezingRain.Factors, want) || freezingRain.Score != 65 {
+		t.Errorf("Hour 2: expected freezing rain with low visibility, got %+v", freezingRain)
+	}
+
+	if report.Hours[4].Risk != RiskLow {
+		t.Errorf("Hour 4: expected dry road above freezing to be low risk, got %+v", report.Hours[4])
+	}
+
+	blizzard := report.Hours[5]
+	if !blizzard.Whiteout || !slices.Contains(blizzard.Factors, RoadFactorHeavySnow) || !slices.Contains(blizzard.Factors, RoadFactorVeryLowVisibility) {
+		t.Errorf("Hour 5: expected whiteout in heavy snow, got %+v", blizzard)
+	}
+	if !report.Hours[6].Whiteout || !slices.Contains(report.Hours[6].Factors, RoadFactorStrongGusts) {
+		t.Errorf("Hour 6: expected whiteout from blowing snow, got %+v", report.Hours[6])
+	}
+
+	wantBlackIce := []TimeWindow{{Start: start.Add(time.Hour), End: start.Add(3 * time.Hour)}}
+	if !slices.Equal(report.BlackIce, wantBlackIce) {
+		t.Errorf("Expected black ice windows %v, got %v", wantBlackIce, report.BlackIce)
+	}
+	wantWhiteout := []TimeWindow{{Start: start.Add(5 * time.Hour), End: start.Add(7 * time.Hour)}}
+	if !slices.Equal(report.Whiteout, wantWhiteout) || report.Whiteout[0].Duration() != 2*time.Hour {
+		t.Errorf("Expected whiteout windows %v, got %v", wantWhiteout, report.Whiteout)
+	}
+}
+
+// TestWindowsOf tests merging of flagged steps into windows
+func TestWindowsOf(t *testing.T) {
+	start := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{start, start.Add(3 * time.Hour), start.Add(6 * time.Hour), start.Add(9 * time.Hour)}
+	flags := []bool{true, false, true, true}
+
+	got := windowsOf(times, func(i int) bool { return flags[i] })
+	want := []TimeWindow{
+		{Start: start, End: start.Add(3 * time.Hour)},
+		{Start: start.Add(6 * time.Hour), End: start.Add(12 * time.Hour)},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
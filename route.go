@@ -0,0 +1,184 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// earthRadiusKm is the mean radius of the Earth used for great-circle distances.
+const earthRadiusKm = 6371.0
+
+// RouteVariables are the hourly variables sampled at each waypoint by GetRouteWeather.
+var RouteVariables = []Variable{
+	HourlyTemperature2m,
+	HourlyPrecipitation,
+	HourlyWeatherCode,
+	HourlyWindSpeed10m,
+	HourlyWindGusts10m,
+	HourlyVisibility,
+}
+
+// RouteWaypoint holds the forecast conditions at one waypoint of a route.
+type RouteWaypoint struct {
+	// Coordinates are the waypoint coordinates
+	Coordinates Coordinates
+
+	// Distance is the distance from the departure point along the route in km,
+	// measured as great-circle legs between consecutive waypoints
+	Distance float64
+
+	// Arrival is the estimated arrival time at the waypoint
+	Arrival time.Time
+
+	// Time is the forecast step used for the waypoint: Arrival rounded to the nearest hour
+	Time time.Time
+
+	// Values maps each of RouteVariables to its forecast value at Time (NaN if missing)
+	Values map[Variable]float64
+
+	// Units maps each variable to its unit as reported by the API (e.g., "°C")
+	Units map[Variable]string
+
+	// Err is the error of this waypoint (nil on success)
+	Err error
+}
+
+// Get returns the value of variable v at the waypoint, or NaN if it is missing.
+func (w *RouteWaypoint) Get(v Variable) float64 {
+	if value, ok := w.Values[v]; ok {
+		return value
+	}
+	return math.NaN()
+}
+
+// GetRouteWeather estimates the arrival time at each waypoint of a route, travelling at a
+// constant speed (in km/h) from departure, and samples the hourly forecast (RouteVariables) at
+// each waypoint for the hour of arrival. Distances are great-circle legs, so use waypoints
+// close enough together to follow the road. Waypoints are fetched concurrently within the
+// client's concurrency limit; each waypoint carries its own error (e.g., an arrival beyond
+// the forecast horizon), so one failing waypoint does not fail the route.
+//
+// Invalid arguments (no waypoints, invalid coordinates, a zero departure time or a speed that
+// is not positive) fail the whole call with an ErrorTypeValidation error. Request options
+// apply to every waypoint; date and hour ranges are replaced by the arrival hour.
+//
+// Example:
+//
+//	route := []openmeteo.Coordinates{
+//	    {Latitude: 52.52, Longitude: 13.41}, // Berlin
+//	    {Latitude: 51.34, Longitude: 12.37}, // Leipzig
+//	    {Latitude: 50.11, Longitude: 8.68},  // Frankfurt
+//	}
+//	waypoints, err := client.GetRouteWeather(ctx, route, time.Now(), 90)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, w := range waypoints {
+//	    if w.Err != nil {
+//	        continue
+//	    }
+//	    fmt.Printf("km %.0f at %s: %.1f mm\n", w.Distance, w.Arrival.Format("15:04"), w.Get(openmeteo.HourlyPrecipitation))
+//	}
+func (c *Client) GetRouteWeather(ctx context.Context, waypoints []Coordinates, departure time.Time, speed float64, opts ...RequestOption) ([]RouteWaypoint, error) {
+	requestID := requestIDFor(ctx)
+	ctx = WithRequestID(ctx, requestID)
+
+	invalid := func(format string, args ...any) error {
+		return &Error{
+			Type:      ErrorTypeValidation,
+			Message:   fmt.Sprintf(format, args...),
+			RequestID: requestID,
+		}
+	}
+	if len(waypoints) == 0 {
+		return nil, invalid("at least one waypoint is required")
+	}
+	for _, p := range waypoints {
+		if err := validateCoordinates(p.Latitude, p.Longitude, requestID); err != nil {
+			return nil, err
+		}
+	}
+	if departure.IsZero() {
+		return nil, invalid("departure time must be set")
+	}
+	if !(speed > 0) || math.IsInf(speed, 0) {
+		return nil, invalid("invalid speed: %v km/h (must be positive)", speed)
+	}
+
+	results := make([]RouteWaypoint, len(waypoints))
+	distance := 0.0
+	for i, p := range waypoints {
+		if i > 0 {
+			distance += greatCircleKm(waypoints[i-1], p)
+		}
+		arrival := departure.Add(time.Duration(distance / speed * float64(time.Hour)))
+		results[i] = RouteWaypoint{
+			Coordinates: p,
+			Distance:    distance,
+			Arrival:     arrival,
+			Time:        arrival.Round(time.Hour),
+		}
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(c.parallelism(), len(waypoints)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				c.sampleWaypoint(ctx, &results[i], opts)
+			}
+		}()
+	}
+	for i := range waypoints {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, nil
+}
+
+// sampleWaypoint fetches the forecast step at the waypoint's arrival hour.
+func (c *Client) sampleWaypoint(ctx context.Context, w *RouteWaypoint, opts []RequestOption) {
+	opts = append(opts[:len(opts):len(opts)], func(r *requestConfig) {
+		r.startDate, r.endDate = time.Time{}, time.Time{}
+	}, WithHourRange(w.Time, w.Time))
+
+	forecast, err := c.GetHourlyForecast(ctx, w.Coordinates.Latitude, w.Coordinates.Longitude, RouteVariables, opts...)
+	if err != nil {
+		w.Err = err
+		return
+	}
+	i := forecast.Hourly.indexAt(w.Time)
+	if i < 0 {
+		w.Err = &Error{
+			Type:      ErrorTypeAPI,
+			Message:   "no forecast data for " + w.Time.UTC().Format(apiHourLayout),
+			RequestID: requestIDFor(ctx),
+		}
+		return
+	}
+
+	w.Values = make(map[Variable]float64, len(RouteVariables))
+	w.Units = make(map[Variable]string, len(RouteVariables))
+	for _, v := range RouteVariables {
+		w.Values[v] = forecast.Hourly.valueAt(v, i)
+		if unit := forecast.Hourly.Unit(v); unit != "" {
+			w.Units[v] = unit
+		}
+	}
+}
+
+// greatCircleKm returns the great-circle distance between two points in km (haversine formula).
+func greatCircleKm(a, b Coordinates) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
@@ -0,0 +1,93 @@
+package openmeteo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestGetRouteWeather tests arrival time estimation and per-waypoint sampling
+func TestGetRouteWeather(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("start_hour") != q.Get("end_hour") || q.Get("hourly") != joinVariables(RouteVariables) {
+			t.Errorf("Unexpected query %q", r.URL.RawQuery)
+		}
+		if q.Get("latitude") == "53.8" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintln(w, `{"error": true, "reason": "Parameter 'start_hour' is out of allowed range"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"latitude": %s, "longitude": 13,
+			"hourly_units": {"temperature_2m": "°C"},
+			"hourly": {"time": [%q], "temperature_2m": [%s], "precipitation": [0.4], "weather_code": [61],
+				"wind_speed_10m": [12], "wind_gusts_10m": [25], "visibility": [null]}}`,
+			q.Get("latitude"), q.Get("start_hour"), q.Get("latitude"))
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	departure := time.Now().UTC().Truncate(time.Hour).Add(24 * time.Hour)
+	route := []Coordinates{{Latitude: 52, Longitude: 13}, {Latitude: 52.9, Longitude: 13}, {Latitude: 53.8, Longitude: 13}}
+	waypoints, err := client.GetRouteWeather(context.Background(), route, departure, 50)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(waypoints) != 3 {
+		t.Fatalf("Expected 3 waypoints, got %d", len(waypoints))
+	}
+
+	first, second := waypoints[0], waypoints[1]
+	if first.Distance != 0 || !first.Arrival.Equal(departure) || first.Err != nil || first.Get(HourlyTemperature2m) != 52 {
+		t.Errorf("Unexpected first waypoint %+v", first)
+	}
+	if math.Abs(second.Distance-100.08) > 0.1 {
+		t.Errorf("Expected about 100 km to the second waypoint, got %.2f", second.Distance)
+	}
+	if !second.Time.Equal(departure.Add(2*time.Hour)) || second.Arrival.Sub(departure) <= 2*time.Hour {
+		t.Errorf("Expected arrival just after 12:00, got %v (step %v)", second.Arrival, second.Time)
+	}
+	if second.Get(HourlyTemperature2m) != 52.9 || second.Units[HourlyTemperature2m] != "°C" || !math.IsNaN(second.Get(HourlyVisibility)) {
+		t.Errorf("Unexpected second waypoint values %v", second.Values)
+	}
+
+	var apiErr *Error
+	if !errors.As(waypoints[2].Err, &apiErr) || apiErr.Type != ErrorTypeAPI {
+		t.Errorf("Expected an API error for the last waypoint only, got %v", waypoints[2].Err)
+	}
+}
+
+// TestGetRouteWeather_Validation tests argument validation
+func TestGetRouteWeather_Validation(t *testing.T) {
+	client := NewClient(WithBaseURL("http://127.0.0.1:0"))
+	now := time.Now()
+	route := []Coordinates{{Latitude: 52, Longitude: 13}}
+
+	testCases := []struct {
+		name      string
+		waypoints []Coordinates
+		departure time.Time
+		speed     float64
+	}{
+		{"no waypoints", nil, now, 50},
+		{"invalid coordinates", []Coordinates{{Latitude: 91}}, now, 50},
+		{"no departure", route, time.Time{}, 50},
+		{"zero speed", route, now, 0},
+		{"NaN speed", route, now, math.NaN()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.GetRouteWeather(context.Background(), tc.waypoints, tc.departure, tc.speed)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Type != ErrorTypeValidation {
+				t.Errorf("Expected validation error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDialect is the JSON Schema version emitted by JSONSchema.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema returns a JSON Schema (draft 2020-12) describing the JSON encoding of v's type,
+// as produced by encoding/json. It is intended for teams exposing SDK results (CurrentWeather,
+// HourlyForecast, Location, ...) over their own APIs, to validate and document contracts.
+// Named struct types are emitted under "$defs" and referenced with "$ref".
+//
+// Example:
+//
+//	schema, err := openmeteo.JSONSchema(openmeteo.CurrentWeather{})
+//	if err != nil {
+//	    return err
+//	}
+//	os.WriteFile("current_weather.schema.json", schema, 0o644)
+func JSONSchema(v any) ([]byte, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, fmt.Errorf("cannot generate schema for nil")
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	g := &schemaGenerator{defs: make(map[string]any)}
+	root := g.schemaFor(t)
+
+	schema := map[string]any{
+		"$schema": jsonSchemaDialect,
+		"title":   t.Name(),
+	}
+	// Inline the root definition so the document describes the type directly
+	if ref, ok := root["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/$defs/")
+		for k, val := range g.defs[name].(map[string]any) {
+			schema[k] = val
+		}
+		delete(g.defs, name)
+	} else {
+		for k, val := range root {
+			schema[k] = val
+		}
+	}
+	if len(g.defs) > 0 {
+		schema["$defs"] = g.defs
+	}
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// schemaGenerator builds schemas for Go types, collecting named structs as definitions.
+type schemaGenerator struct {
+	defs map[string]any
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	seriesType   = reflect.TypeOf(Series{})
+)
+
+// schemaFor returns the schema of a type.
+func (g *schemaGenerator) schemaFor(t reflect.Type) map[string]any {
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case durationType:
+		return map[string]any{"type": "integer", "description": "duration in nanoseconds"}
+	case seriesType:
+		return g.define(t, seriesSchema)
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schemaFor(t.Elem())
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return g.define(t, g.structSchema)
+	default:
+		return map[string]any{}
+	}
+}
+
+// define registers a named type under $defs (once) and returns a reference to it.
+func (g *schemaGenerator) define(t reflect.Type, build func(reflect.Type) map[string]any) map[string]any {
+	name := t.Name()
+	if _, ok := g.defs[name]; !ok {
+		g.defs[name] = map[string]any{} // placeholder for recursive types
+		g.defs[name] = build(t)
+	}
+	return map[string]any{"$ref": "#/$defs/" + name}
+}
+
+// structSchema describes a struct following encoding/json field naming rules.
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	required := []string{}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, omitempty, skip := jsonFieldName(f)
+		if skip || !jsonEncodable(f.Type) {
+			continue
+		}
+		properties[name] = g.schemaFor(f.Type)
+		if !omitempty {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// seriesSchema describes the custom JSON encoding of Series.
+func seriesSchema(reflect.Type) map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"Time": map[string]any{
+				"type":  "array",
+				"items": map[string]any{"type": "string", "format": "date-time"},
+			},
+			"Values": map[string]any{
+				"type": "object",
+				"additionalProperties": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": []string{"number", "null"}},
+				},
+			},
+			"Units": map[string]any{
+				"type":                 "object",
+				"additionalProperties": map[string]any{"type": "string"},
+			},
+		},
+		"required":             []string{"Time", "Values", "Units"},
+		"additionalProperties": false,
+	}
+}
+
+// jsonFieldName returns the JSON name of a struct field and whether it is omitted when empty
+// or skipped entirely.
+func jsonFieldName(f reflect.StructField) (name string, omitempty, skip bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" || opt == "omitzero" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
+
+// jsonEncodable reports whether values of t can be represented in JSON.
+func jsonEncodable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Func, reflect.Chan, reflect.Complex64, reflect.Complex128, reflect.UnsafePointer:
+		return false
+	}
+	return true
+}
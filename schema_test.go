@@ -0,0 +1,163 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+// decodeSchema generates and decodes the schema of v
+func decodeSchema(t *testing.T, v any) map[string]any {
+	t.Helper()
+	raw, err := JSONSchema(v)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		t.Fatalf("Schema is not valid JSON: %v", err)
+	}
+	return schema
+}
+
+// assertMatchesSchema checks that the JSON encoding of v only uses declared properties
+// and contains every required property
+func assertMatchesSchema(t *testing.T, schema map[string]any, v any) {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to marshal value: %v", err)
+	}
+	var encoded map[string]any
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		t.Fatalf("Failed to decode value: %v", err)
+	}
+
+	properties := schema["properties"].(map[string]any)
+	for key := range encoded {
+		if _, ok := properties[key]; !ok {
+			t.Errorf("Encoded property %q is not declared in schema", key)
+		}
+	}
+	for _, key := range schema["required"].([]any) {
+		if _, ok := encoded[key.(string)]; !ok {
+			t.Errorf("Required property %q missing from encoding", key)
+		}
+	}
+}
+
+// TestJSONSchema_CurrentWeather tests the schema of CurrentWeather
+func TestJSONSchema_CurrentWeather(t *testing.T) {
+	schema := decodeSchema(t, CurrentWeather{})
+
+	if schema["$schema"] != jsonSchemaDialect {
+		t.Errorf("Expected dialect %s, got %v", jsonSchemaDialect, schema["$schema"])
+	}
+	if schema["title"] != "CurrentWeather" || schema["type"] != "object" {
+		t.Errorf("Unexpected title/type %v/%v", schema["title"], schema["type"])
+	}
+
+	properties := schema["properties"].(map[string]any)
+	if properties["Temperature"].(map[string]any)["type"] != "number" {
+		t.Error("Expected Temperature to be a number")
+	}
+	if properties["IsDay"].(map[string]any)["type"] != "boolean" {
+		t.Error("Expected IsDay to be a boolean")
+	}
+	if properties["WeatherCode"].(map[string]any)["type"] != "integer" {
+		t.Error("Expected WeatherCode to be an integer")
+	}
+	if properties["Time"].(map[string]any)["format"] != "date-time" {
+		t.Error("Expected Time to be a date-time string")
+	}
+	if _, ok := properties["Location"]; ok {
+		t.Error("Expected Location to be excluded from the schema")
+	}
+	if properties["DisplayUnits"].(map[string]any)["$ref"] != "#/$defs/DisplayUnits" {
+		t.Errorf("Expected DisplayUnits reference, got %v", properties["DisplayUnits"])
+	}
+
+	loc := time.FixedZone("CET", 3600)
+	assertMatchesSchema(t, schema, &CurrentWeather{Temperature: 4, Time: time.Now(), Location: loc})
+}
+
+// TestJSONSchema_HourlyForecast tests the schema of a forecast containing a Series
+func TestJSONSchema_HourlyForecast(t *testing.T) {
+	schema := decodeSchema(t, &HourlyForecast{})
+
+	defs := schema["$defs"].(map[string]any)
+	series, ok := defs["Series"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected Series definition")
+	}
+	values := series["properties"].(map[string]any)["Values"].(map[string]any)
+	items := values["additionalProperties"].(map[string]any)["items"].(map[string]any)
+	if types, ok := items["type"].([]any); !ok || len(types) != 2 {
+		t.Errorf("Expected nullable numbers in Series values, got %v", items["type"])
+	}
+
+	forecast := HourlyForecast{
+		Hourly: Series{
+			Time:   []time.Time{time.Now()},
+			Values: map[Variable][]float64{HourlyTemperature2m: {math.NaN()}},
+		},
+	}
+	assertMatchesSchema(t, schema, forecast)
+	assertMatchesSchema(t, series, forecast.Hourly)
+}
+
+// TestJSONSchema_Location tests the schema of geocoding results
+func TestJSONSchema_Location(t *testing.T) {
+	schema := decodeSchema(t, []Location{})
+
+	if schema["type"] != "array" {
+		t.Fatalf("Expected array schema, got %v", schema["type"])
+	}
+	if schema["items"].(map[string]any)["$ref"] != "#/$defs/Location" {
+		t.Errorf("Expected Location reference, got %v", schema["items"])
+	}
+	postcodes := schema["$defs"].(map[string]any)["Location"].(map[string]any)["properties"].(map[string]any)["Postcodes"].(map[string]any)
+	if postcodes["type"] != "array" {
+		t.Errorf("Expected Postcodes array, got %v", postcodes)
+	}
+}
+
+// TestJSONSchema_Tags tests json tag handling and unsupported types
+func TestJSONSchema_Tags(t *testing.T) {
+	type sample struct {
+		Renamed  string         `json:"renamed"`
+		Optional int            `json:"optional,omitempty"`
+		Skipped  string         `json:"-"`
+		Callback func()         // not encodable
+		Duration time.Duration  // integer nanoseconds
+		Extra    map[string]any // arbitrary values
+		hidden   string
+	}
+	_ = sample{}.hidden
+
+	schema := decodeSchema(t, sample{})
+	properties := schema["properties"].(map[string]any)
+
+	for _, name := range []string{"renamed", "optional", "Duration", "Extra"} {
+		if _, ok := properties[name]; !ok {
+			t.Errorf("Expected property %s", name)
+		}
+	}
+	for _, name := range []string{"Renamed", "Skipped", "Callback", "hidden"} {
+		if _, ok := properties[name]; ok {
+			t.Errorf("Expected property %s to be excluded", name)
+		}
+	}
+	for _, name := range schema["required"].([]any) {
+		if name == "optional" {
+			t.Error("Expected omitempty field not to be required")
+		}
+	}
+
+	if _, err := JSONSchema(nil); err == nil {
+		t.Error("Expected error for nil value")
+	}
+}
@@ -0,0 +1,241 @@
+package openmeteo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Series is a block of time-indexed values, as returned by the API for hourly data.
+// Values[v][i] is the value of variable v at Time[i].
+// Missing values (JSON null) are represented as NaN; use math.IsNaN to detect them.
+type Series struct {
+	// Time holds the timestamp of each step in UTC (see TimesInLocal for local times)
+	Time []time.Time
+
+	// Values maps each returned variable to its values, aligned with Time
+	Values map[Variable][]float64
+
+	// Units maps each returned variable to its unit as reported by the API (e.g., "°C"), with
+	// the API's "mp/h" stored as UnitMilesPerHour ("mph")
+	Units map[Variable]string
+
+	// Location is the time zone the data was requested in (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+}
+
+// Len returns the number of time steps in the series.
+func (s *Series) Len() int {
+	return len(s.Time)
+}
+
+// Get returns the values of variable v, or nil if the variable was not returned.
+func (s *Series) Get(v Variable) []float64 {
+	return s.Values[v]
+}
+
+// Unit returns the unit of variable v as reported by the API, or "" if unknown.
+func (s *Series) Unit(v Variable) string {
+	return s.Units[v]
+}
+
+// valueAt returns the value of v at step i, or NaN if the variable is not present.
+func (s *Series) valueAt(v Variable, i int) float64 {
+	values, ok := s.Values[v]
+	if !ok || i < 0 || i >= len(values) {
+		return math.NaN()
+	}
+	return values[i]
+}
+
+// TimeAt returns the value of a time-valued variable (e.g., DailySunrise) at step i in the
+// series' Location. ok is false if the value is missing or v is not time-valued.
+func (s *Series) TimeAt(v Variable, i int) (time.Time, bool) {
+	value := s.valueAt(v, i)
+	if math.IsNaN(value) || s.Units[v] != unitUnixTime {
+		return time.Time{}, false
+	}
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Unix(int64(value), 0).In(loc), true
+}
+
+// indexAt returns the index of the last step at or before t, or -1 if there is none.
+func (s *Series) indexAt(t time.Time) int {
+	idx := -1
+	for i, ts := range s.Time {
+		if ts.After(t) {
+			break
+		}
+		idx = i
+	}
+	return idx
+}
+
+// TimesInLocal returns the timestamps converted to the series' Location,
+// i.e., the local wall-clock times of the requested timezone.
+func (s *Series) TimesInLocal() []time.Time {
+	return timesIn(s.Time, s.Location)
+}
+
+// Interval returns the spacing between consecutive time steps
+// (e.g., 1h for native hourly data, 3h for WithTemporalResolution(TemporalResolutionHourly3)).
+// It returns 0 if the series has fewer than two steps.
+func (s *Series) Interval() time.Duration {
+	if len(s.Time) < 2 {
+		return 0
+	}
+	return s.Time[1].Sub(s.Time[0])
+}
+
+// parseSeries converts a raw JSON data block (e.g., the "hourly" object) and its
+// matching units block into a Series. Timestamps are interpreted in loc (UTC when nil).
+func parseSeries(block map[string]json.RawMessage, units map[string]string, loc *time.Location) (Series, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	s := Series{
+		Values:   make(map[Variable][]float64),
+		Units:    make(map[Variable]string),
+		Location: loc,
+	}
+
+	if raw, ok := block["time"]; ok {
+		var times []apiTimestamp
+		if err := json.Unmarshal(raw, &times); err != nil {
+			return s, fmt.Errorf("invalid time array: %w", err)
+		}
+		s.Time = make([]time.Time, len(times))
+		for i, ts := range times {
+			t, err := parseAPITime(string(ts), loc)
+			if err != nil {
+				return s, fmt.Errorf("invalid timestamp %q: %w", ts, err)
+			}
+			// When DST ends, a local hour occurs twice; keep the series monotonic by
+			// picking the first occurrence after the previous step.
+			var prev time.Time
+			if i > 0 {
+				prev = s.Time[i-1]
+			}
+			s.Time[i] = occurrenceAfter(t, prev, loc)
+		}
+	}
+
+	for key, raw := range block {
+		if key == "time" {
+			continue
+		}
+		var values []*float64
+		if err := json.Unmarshal(raw, &values); err != nil {
+			// Time-valued variables (e.g., sunrise) are ISO 8601 strings; store them as Unix seconds
+			times, timeErr := parseTimeValues(raw, loc)
+			if timeErr != nil {
+				return s, fmt.Errorf("invalid values for %s: %w", key, err)
+			}
+			if len(times) != len(s.Time) {
+				return s, fmt.Errorf("variable %s has %d values for %d timestamps", key, len(times), len(s.Time))
+			}
+			s.Values[Variable(key)] = times
+			s.Units[Variable(key)] = unitUnixTime
+			continue
+		}
+		if len(values) != len(s.Time) {
+			return s, fmt.Errorf("variable %s has %d values for %d timestamps", key, len(values), len(s.Time))
+		}
+		s.Values[Variable(key)] = nullsToNaN(values)
+		if unit, ok := units[key]; ok {
+			s.Units[Variable(key)] = string(parseUnit(unit))
+		}
+	}
+
+	return s, nil
+}
+
+// unitUnixTime is the unit of time-valued variables, stored as seconds since the Unix epoch.
+const unitUnixTime = "unixtime"
+
+// parseTimeValues converts a JSON array of local ISO 8601 timestamps into Unix seconds,
+// using NaN for nulls.
+func parseTimeValues(raw json.RawMessage, loc *time.Location) ([]float64, error) {
+	var values []*string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(values))
+	for i, v := range values {
+		if v == nil {
+			out[i] = math.NaN()
+			continue
+		}
+		t, err := parseAPITime(*v, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp %q: %w", *v, err)
+		}
+		out[i] = float64(t.Unix())
+	}
+	return out, nil
+}
+
+// nullsToNaN converts nullable values into a float slice using NaN for nulls.
+func nullsToNaN(values []*float64) []float64 {
+	out := make([]float64, len(values))
+	for i, v := range values {
+		if v == nil {
+			out[i] = math.NaN()
+		} else {
+			out[i] = *v
+		}
+	}
+	return out
+}
+
+// seriesJSON is the JSON representation of a Series, with missing values encoded as null.
+type seriesJSON struct {
+	Time   []time.Time
+	Values map[Variable][]*float64
+	Units  map[Variable]string
+}
+
+// MarshalJSON encodes the series with missing (NaN) values as null, since JSON has no NaN.
+// The Location is not encoded; timestamps carry their instant.
+func (s Series) MarshalJSON() ([]byte, error) {
+	out := seriesJSON{
+		Time:   s.Time,
+		Values: make(map[Variable][]*float64, len(s.Values)),
+		Units:  s.Units,
+	}
+	if out.Time == nil {
+		out.Time = []time.Time{}
+	}
+	if out.Units == nil {
+		out.Units = map[Variable]string{}
+	}
+	for v, values := range s.Values {
+		nullable := make([]*float64, len(values))
+		for i := range values {
+			if !math.IsNaN(values[i]) {
+				nullable[i] = &values[i]
+			}
+		}
+		out.Values[v] = nullable
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a series encoded by MarshalJSON, restoring null values as NaN.
+func (s *Series) UnmarshalJSON(data []byte) error {
+	var in seriesJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	s.Time = in.Time
+	s.Units = in.Units
+	s.Values = make(map[Variable][]float64, len(in.Values))
+	for v, values := range in.Values {
+		s.Values[v] = nullsToNaN(values)
+	}
+	return nil
+}
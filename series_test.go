@@ -0,0 +1,126 @@
+package openmeteo
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+// TestParseSeries tests parsing of an hourly data block with units and nulls
+func TestParseSeries(t *testing.T) {
+	var block map[string]json.RawMessage
+	err := json.Unmarshal([]byte(`{
+		"time": ["2025-12-29T00:00", "2025-12-29T03:00", "2025-12-29T06:00"],
+		"temperature_2m": [1.5, null, 3.0],
+		"weather_code": [0, 3, 61]
+	}`), &block)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal block: %v", err)
+	}
+
+	s, err := parseSeries(block, map[string]string{"time": "iso8601", "temperature_2m": "°C"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if s.Len() != 3 {
+		t.Fatalf("Expected 3 time steps, got %d", s.Len())
+	}
+	if !s.Time[0].Equal(time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected first timestamp %v", s.Time[0])
+	}
+	if s.Interval() != 3*time.Hour {
+		t.Errorf("Expected 3h interval, got %v", s.Interval())
+	}
+
+	temps := s.Get(HourlyTemperature2m)
+	if temps[0] != 1.5 || !math.IsNaN(temps[1]) || temps[2] != 3.0 {
+		t.Errorf("Unexpected temperature values %v", temps)
+	}
+	if s.Unit(HourlyTemperature2m) != "°C" {
+		t.Errorf("Expected unit °C, got %q", s.Unit(HourlyTemperature2m))
+	}
+	if s.Unit(HourlyWeatherCode) != "" {
+		t.Errorf("Expected empty unit for weather_code, got %q", s.Unit(HourlyWeatherCode))
+	}
+	if s.Get(HourlyRain) != nil {
+		t.Error("Expected nil for variable not returned")
+	}
+}
+
+// TestParseSeries_Errors tests parsing failures for malformed blocks
+func TestParseSeries_Errors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		block string
+	}{
+		{"Invalid time array", `{"time": "2025-12-29T00:00"}`},
+		{"Invalid timestamp", `{"time": ["yesterday"]}`},
+		{"Invalid values", `{"time": ["2025-12-29T00:00"], "temperature_2m": ["warm"]}`},
+		{"Length mismatch", `{"time": ["2025-12-29T00:00"], "temperature_2m": [1, 2]}`},
+		{"Time values length mismatch", `{"time": ["2025-12-29"], "sunrise": ["2025-12-29T08:16", "2025-12-30T08:16"]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var block map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(tc.block), &block); err != nil {
+				t.Fatalf("Failed to unmarshal block: %v", err)
+			}
+			if _, err := parseSeries(block, nil, nil); err == nil {
+				t.Error("Expected parse error")
+			}
+		})
+	}
+}
+
+// TestSeries_IntervalShort tests Interval on series with fewer than two steps
+func TestSeries_IntervalShort(t *testing.T) {
+	s := Series{Time: []time.Time{time.Now()}}
+	if s.Interval() != 0 {
+		t.Errorf("Expected 0 interval, got %v", s.Interval())
+	}
+}
+
+// TestSeries_JSONRoundTrip tests that missing values survive JSON encoding as null
+func TestSeries_JSONRoundTrip(t *testing.T) {
+	s := Series{
+		Time:   []time.Time{time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 29, 1, 0, 0, 0, time.UTC)},
+		Values: map[Variable][]float64{HourlyTemperature2m: {1.5, math.NaN()}},
+		Units:  map[Variable]string{HourlyTemperature2m: "°C"},
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var decoded Series
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	temps := decoded.Get(HourlyTemperature2m)
+	if len(temps) != 2 || temps[0] != 1.5 || !math.IsNaN(temps[1]) {
+		t.Errorf("Unexpected decoded values %v", temps)
+	}
+	if decoded.Unit(HourlyTemperature2m) != "°C" || !decoded.Time[1].Equal(s.Time[1]) {
+		t.Errorf("Unexpected decoded series %+v", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Values": 3}`), &decoded); err == nil {
+		t.Error("Expected error for invalid series JSON")
+	}
+}
+
+// hourlySeries builds an hourly UTC series starting at start with the given values
+func hourlySeries(start time.Time, values map[Variable][]float64) Series {
+	s := Series{Values: values, Units: map[Variable]string{}, Location: time.UTC}
+	for _, v := range values {
+		for i := range v {
+			s.Time = append(s.Time, start.Add(time.Duration(i)*time.Hour))
+		}
+		break
+	}
+	return s
+}
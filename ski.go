@@ -0,0 +1,129 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// SkiVariables lists the hourly variables used by SkiConditions. Request them together with
+// past data (e.g., WithDateRange starting three days ago) to get fresh snow totals.
+var SkiVariables = []Variable{
+	HourlySnowfall,
+	HourlySnowDepth,
+	HourlyFreezingLevelHeight,
+	HourlyWindSpeed10m,
+	HourlyWindGusts10m,
+}
+
+const (
+	// snowLineBelowFreezingLevel is the typical distance between the freezing level and the
+	// altitude down to which precipitation falls as snow, in meters
+	snowLineBelowFreezingLevel = 300
+
+	// windHoldGustsMedium and windHoldGustsHigh are gust speeds (km/h) at which lifts are
+	// commonly slowed down or closed
+	windHoldGustsMedium = 50
+	windHoldGustsHigh   = 70
+)
+
+// SkiReport summarizes resort-oriented snow and wind conditions at a point in time.
+// Values are NaN when the underlying variable was not requested or is missing.
+type SkiReport struct {
+	// Time is the time step the report refers to (the last step at or before the requested time)
+	Time time.Time
+
+	// FreshSnow24h, FreshSnow48h and FreshSnow72h are the snowfall totals in centimeters over
+	// the 24, 48 and 72 hours up to Time (computed over the available steps)
+	FreshSnow24h float64
+	FreshSnow48h float64
+	FreshSnow72h float64
+
+	// SnowDepth is the snow depth on the ground in centimeters
+	SnowDepth float64
+
+	// FreezingLevel is the altitude of the 0 °C isotherm in meters
+	FreezingLevel float64
+
+	// SnowLine is the estimated altitude in meters down to which precipitation falls as snow
+	SnowLine float64
+
+	// MaxGusts24h is the highest wind gust speed in km/h over the 24 hours from Time
+	MaxGusts24h float64
+
+	// WindHold is the risk of lifts being slowed or closed because of wind
+	// (medium from 50 km/h gusts, high from 70 km/h)
+	WindHold RiskLevel
+}
+
+// SkiConditions builds a ski conditions report from an hourly series containing
+// SkiVariables for the time at, converting values in other units (see WithUnits) to the
+// metric units of the report. It returns an error if the series has no time step at or
+// before at.
+//
+// Example:
+//
+//	now := time.Now()
+//	f, err := client.GetHourlyForecast(ctx, 47.13, 10.27, openmeteo.SkiVariables,
+//	    openmeteo.WithHourRange(now.Add(-72*time.Hour), now.Add(24*time.Hour)))
+//	if err != nil {
+//	    return err
+//	}
+//	report, err := openmeteo.SkiConditions(&f.Hourly, now)
+//	fmt.Printf("%.0f cm fresh snow, wind hold risk %s\n", report.FreshSnow24h, report.WindHold)
+func SkiConditions(hourly *Series, at time.Time) (*SkiReport, error) {
+	hourly = hourly.metric()
+	idx := hourly.indexAt(at)
+	if idx < 0 {
+		return nil, fmt.Errorf("series has no data at or before %s", at.UTC().Format(apiTimeLayout))
+	}
+	t := hourly.Time[idx]
+
+	report := &SkiReport{
+		Time:          t,
+		FreshSnow24h:  hourly.sumWindow(HourlySnowfall, t.Add(-24*time.Hour), t),
+		FreshSnow48h:  hourly.sumWindow(HourlySnowfall, t.Add(-48*time.Hour), t),
+		FreshSnow72h:  hourly.sumWindow(HourlySnowfall, t.Add(-72*time.Hour), t),
+		SnowDepth:     hourly.valueAt(HourlySnowDepth, idx) * 100,
+		FreezingLevel: hourly.valueAt(HourlyFreezingLevelHeight, idx),
+		MaxGusts24h:   math.NaN(),
+	}
+	report.SnowLine = max(0, report.FreezingLevel-snowLineBelowFreezingLevel)
+
+	if gusts, ok := hourly.Values[HourlyWindGusts10m]; ok {
+		var window []float64
+		for i := idx; i < len(hourly.Time) && hourly.Time[i].Before(t.Add(24*time.Hour)); i++ {
+			window = append(window, gusts[i])
+		}
+		report.MaxGusts24h = aggregateMax(window)
+	}
+	switch {
+	case report.MaxGusts24h >= windHoldGustsHigh:
+		report.WindHold = RiskHigh
+	case report.MaxGusts24h >= windHoldGustsMedium:
+		report.WindHold = RiskMedium
+	}
+
+	return report, nil
+}
+
+// sumWindow adds up the values of v for the steps in (from, to], skipping missing values.
+// It returns NaN if the variable is not present.
+func (s *Series) sumWindow(v Variable, from, to time.Time) float64 {
+	values, ok := s.Values[v]
+	if !ok {
+		return math.NaN()
+	}
+	var window []float64
+	for i, t := range s.Time {
+		if t.After(from) && !t.After(to) {
+			window = append(window, values[i])
+		}
+	}
+	if len(window) == 0 {
+		return 0
+	}
+	return aggregateSum(window)
+}
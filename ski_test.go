@@ -0,0 +1,83 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestSkiConditions tests fresh snow totals, snow line and wind hold risk
+func TestSkiConditions(t *testing.T) {
+	start := time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)
+	n := 96 // 72 hours of history and 24 hours of forecast
+	snowfall := make([]float64, n)
+	gusts := make([]float64, n)
+	depth := make([]float64, n)
+	freezing := make([]float64, n)
+	for i := range n {
+		snowfall[i] = 0.5
+		gusts[i] = 30
+		depth[i] = 0.8
+		freezing[i] = 1500
+	}
+	snowfall[60] = math.NaN()
+	gusts[80] = 55
+
+	s := hourlySeries(start, map[Variable][]float64{
+		HourlySnowfall:            snowfall,
+		HourlyWindGusts10m:        gusts,
+		HourlySnowDepth:           depth,
+		HourlyFreezingLevelHeight: freezing,
+	})
+
+	at := start.Add(71*time.Hour + 30*time.Minute)
+	report, err := SkiConditions(&s, at)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !report.Time.Equal(start.Add(71 * time.Hour)) {
+		t.Errorf("Expected report at last step before %s, got %s", at, report.Time)
+	}
+	if report.FreshSnow24h != 11.5 || report.FreshSnow48h != 23.5 || report.FreshSnow72h != 35.5 {
+		t.Errorf("Unexpected fresh snow %v/%v/%v", report.FreshSnow24h, report.FreshSnow48h, report.FreshSnow72h)
+	}
+	if report.SnowDepth != 80 || report.FreezingLevel != 1500 || report.SnowLine != 1200 {
+		t.Errorf("Unexpected snow depth/freezing level/snow line %v/%v/%v", report.SnowDepth, report.FreezingLevel, report.SnowLine)
+	}
+	if report.MaxGusts24h != 55 || report.WindHold != RiskMedium {
+		t.Errorf("Expected medium wind hold from 55 km/h gusts, got %v (%s)", report.MaxGusts24h, report.WindHold)
+	}
+
+	if _, err := SkiConditions(&s, start.Add(-time.Hour)); err == nil {
+		t.Error("Expected error before the first step")
+	}
+}
+
+// TestSkiConditions_MissingVariables tests reports from partial series
+func TestSkiConditions_MissingVariables(t *testing.T) {
+	start := time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)
+	s := hourlySeries(start, map[Variable][]float64{HourlyWindGusts10m: {80, 20}})
+
+	report, err := SkiConditions(&s, start)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !math.IsNaN(report.FreshSnow24h) || !math.IsNaN(report.SnowDepth) || !math.IsNaN(report.SnowLine) {
+		t.Errorf("Expected NaN for missing variables, got %+v", report)
+	}
+	if report.WindHold != RiskHigh {
+		t.Errorf("Expected high wind hold risk, got %s", report.WindHold)
+	}
+}
+
+// TestRiskLevel_String tests risk level names
+func TestRiskLevel_String(t *testing.T) {
+	for level, want := range map[RiskLevel]string{RiskLow: "low", RiskMedium: "medium", RiskHigh: "high", RiskLevel(7): "RiskLevel(7)"} {
+		if got := level.String(); got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+// SnowpackVariables lists the daily variables used by TrackSnowpack.
+var SnowpackVariables = []Variable{
+	DailySnowfallSum,
+	DailyTemperature2mMax,
+	DailyTemperature2mMin,
+}
+
+const (
+	// snowWaterPerCentimeter is the water equivalent (mm) of 1 cm of fresh snow as used by
+	// the API (7 cm of snow correspond to 10 mm of precipitation)
+	snowWaterPerCentimeter = 10.0 / 7
+
+	// degreeDayFactor is the snowmelt (mm water equivalent) per day and °C of mean air
+	// temperature above freezing, a typical value for seasonal snowpacks
+	degreeDayFactor = 3.0
+
+	// settledSnowDensity is the density of a settled snowpack relative to water, used to
+	// convert water equivalent to depth
+	settledSnowDensity = 0.3
+
+	// snowpackTrendDays is the number of days over which the snowpack trend is measured
+	snowpackTrendDays = 7
+
+	// snowpackSteadyChange is the depth change (cm) over the trend period below which the
+	// snowpack is steady
+	snowpackSteadyChange = 2.0
+)
+
+// SnowpackDay is the estimated snowpack at the end of one day.
+type SnowpackDay struct {
+	// Date is the start of the day in the series' Location
+	Date time.Time
+
+	// Snowfall is the day's snowfall in cm
+	Snowfall float64
+
+	// Melt is the estimated melt in mm water equivalent
+	Melt float64
+
+	// WaterEquivalent is the estimated snow water equivalent of the snowpack in mm
+	WaterEquivalent float64
+
+	// Depth is the approximate snowpack depth in cm
+	Depth float64
+}
+
+// Snowpack is the result of TrackSnowpack.
+type Snowpack struct {
+	// Days holds the estimate for every day of the season
+	Days []SnowpackDay
+
+	// Trend is the direction of the depth change over the last 7 days (steady below 2 cm)
+	Trend Trend
+}
+
+// TrackSnowpack estimates the snowpack over a season by accumulating snowfall and subtracting
+// melt with a degree-day model (3 mm water equivalent per °C of mean daily temperature above
+// freezing). It takes one or more daily series containing SnowpackVariables in chronological
+// order, e.g. the season so far from DownloadHistoricalDaily followed by GetForecast daily
+// data; days covered by an earlier series are skipped in later ones, and values in other
+// units (see WithUnits) are converted to cm and °C. Missing snowfall counts as none and days
+// without temperatures have no melt.
+//
+// The result is an approximation for trends (settling, rain-on-snow and wind drift are not
+// modelled); use HourlySnowDepth where modelled snow depth is available.
+//
+// Example:
+//
+//	season, err := client.DownloadHistoricalDaily(ctx, 46.80, 9.84, openmeteo.SnowpackVariables,
+//	    time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), time.Now().AddDate(0, 0, -7), openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	forecast, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude: 46.80, Longitude: 9.84, Daily: openmeteo.SnowpackVariables,
+//	}, openmeteo.WithTimezone("auto"), openmeteo.WithDateRange(time.Now().AddDate(0, 0, -7), time.Now().AddDate(0, 0, 7)))
+//	if err != nil {
+//	    return err
+//	}
+//	snowpack := openmeteo.TrackSnowpack(&season.Daily, &forecast.Daily)
+//	last := snowpack.Days[len(snowpack.Days)-1]
+//	fmt.Printf("about %.0f cm of snow, %s\n", last.Depth, snowpack.Trend)
+func TrackSnowpack(daily ...*Series) Snowpack {
+	chunks := make([]historicalChunk, len(daily))
+	var loc *time.Location
+	for i, s := range daily {
+		chunks[i].series = *s.metric()
+		if loc == nil {
+			loc = s.Location
+		}
+	}
+	season := mergeSeries(chunks, SnowpackVariables, loc)
+
+	var snowpack Snowpack
+	swe := 0.0
+	for i, day := range season.TimesInLocal() {
+		snowfall := season.valueAt(DailySnowfallSum, i)
+		if math.IsNaN(snowfall) {
+			snowfall = 0
+		}
+		swe += snowfall * snowWaterPerCentimeter
+
+		melt := 0.0
+		temp := (season.valueAt(DailyTemperature2mMax, i) + season.valueAt(DailyTemperature2mMin, i)) / 2
+		if temp > 0 {
+			melt = math.Min(swe, degreeDayFactor*temp)
+		}
+		swe -= melt
+
+		snowpack.Days = append(snowpack.Days, SnowpackDay{
+			Date:            day,
+			Snowfall:        snowfall,
+			Melt:            melt,
+			WaterEquivalent: swe,
+			Depth:           swe / settledSnowDensity / 10,
+		})
+	}
+
+	if n := len(snowpack.Days); n > 1 {
+		change := snowpack.Days[n-1].Depth - snowpack.Days[max(0, n-1-snowpackTrendDays)].Depth
+		switch {
+		case change >= snowpackSteadyChange:
+			snowpack.Trend = TrendRising
+		case change <= -snowpackSteadyChange:
+			snowpack.Trend = TrendFalling
+		}
+	}
+	return snowpack
+}
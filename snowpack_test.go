@@ -0,0 +1,60 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestTrackSnowpack tests accumulation, melt and the merge of history and forecast
+func TestTrackSnowpack(t *testing.T) {
+	start := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	nan := math.NaN()
+	history := dailySeries(start, map[Variable][]float64{
+		DailySnowfallSum:      {21, 0, nan, 7},
+		DailyTemperature2mMax: {-2, 0, 4, -1},
+		DailyTemperature2mMin: {-8, -4, 0, -5},
+	}, map[Variable]string{})
+	forecast := dailySeries(start.AddDate(0, 0, 3), map[Variable][]float64{
+		DailySnowfallSum:      {100, 0, 0},
+		DailyTemperature2mMax: {100, 20, nan},
+		DailyTemperature2mMin: {100, 16, -3},
+	}, map[Variable]string{})
+
+	snowpack := TrackSnowpack(&history, &forecast)
+	if len(snowpack.Days) != 6 {
+		t.Fatalf("Expected 6 days, got %d", len(snowpack.Days))
+	}
+
+	// 21 cm of snow = 30 mm water equivalent = 10 cm settled depth
+	if day := snowpack.Days[0]; math.Abs(day.WaterEquivalent-30) > 1e-9 || math.Abs(day.Depth-10) > 1e-9 || day.Melt != 0 {
+		t.Errorf("Unexpected first day %+v", day)
+	}
+	// Mean of 2 °C melts 6 mm
+	if day := snowpack.Days[2]; day.Melt != 6 || math.Abs(day.WaterEquivalent-24) > 1e-9 || day.Snowfall != 0 {
+		t.Errorf("Expected 6 mm of melt, got %+v", day)
+	}
+	// The overlapping forecast day is skipped in favour of history
+	if day := snowpack.Days[3]; day.Snowfall != 7 || math.Abs(day.WaterEquivalent-34) > 1e-9 {
+		t.Errorf("Expected history to win on overlapping days, got %+v", day)
+	}
+	// Melt cannot exceed the snowpack
+	if day := snowpack.Days[4]; math.Abs(day.Melt-34) > 1e-9 || day.WaterEquivalent != 0 || day.Depth != 0 {
+		t.Errorf("Expected snowpack to have melted, got %+v", day)
+	}
+	if day := snowpack.Days[5]; day.Melt != 0 || snowpack.Trend != TrendFalling {
+		t.Errorf("Expected no melt without temperatures and a falling trend, got %+v (%s)", day, snowpack.Trend)
+	}
+
+	growing := dailySeries(start, map[Variable][]float64{
+		DailySnowfallSum:      {0, 5, 5},
+		DailyTemperature2mMax: {-5, -5, -5},
+		DailyTemperature2mMin: {-9, -9, -9},
+	}, map[Variable]string{})
+	if got := TrackSnowpack(&growing).Trend; got != TrendRising {
+		t.Errorf("Expected rising trend, got %s", got)
+	}
+	if got := TrackSnowpack(); len(got.Days) != 0 || got.Trend != TrendSteady {
+		t.Errorf("Expected empty steady snowpack, got %+v", got)
+	}
+}
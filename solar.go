@@ -0,0 +1,72 @@
+package openmeteo
+
+import "math"
+
+// megajoulesPerKilowattHour converts radiation sums (MJ/m²) to kWh/m²
+const megajoulesPerKilowattHour = 3.6
+
+// Constants of the Makkink reference evapotranspiration formula
+const (
+	// latentHeat is the latent heat of vaporization in MJ/kg
+	latentHeat = 2.45
+
+	// psychrometricConstant is the psychrometric constant at sea level in kPa/°C
+	psychrometricConstant = 0.066
+
+	// makkinkCoefficient is the empirical Makkink coefficient used by the KNMI
+	makkinkCoefficient = 0.65
+)
+
+// PVEnergy estimates the daily energy yield in kWh of a photovoltaic system with a peak
+// power of peakKW (kWp) from DailyShortwaveRadiationSum, using the standard yield formula
+// E = H × kWp × PR, where H is the radiation in kWh/m² and PR the performance ratio
+// (typically 0.75-0.85, covering inverter, temperature and wiring losses). The radiation
+// is measured on a horizontal plane, so tilted panels usually yield somewhat more in
+// winter. Days with missing data are NaN.
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{
+//	    Latitude:  48.14,
+//	    Longitude: 11.58,
+//	    Daily:     []openmeteo.Variable{openmeteo.DailyShortwaveRadiationSum},
+//	}, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	for i, kwh := range openmeteo.PVEnergy(&f.Daily, 9.6, 0.8) {
+//	    fmt.Printf("%s: %.1f kWh\n", f.Daily.TimesInLocal()[i].Format("Mon"), kwh)
+//	}
+func PVEnergy(daily *Series, peakKW, performanceRatio float64) []float64 {
+	energy := make([]float64, daily.Len())
+	for i := range energy {
+		radiation := daily.valueAt(DailyShortwaveRadiationSum, i)
+		energy[i] = radiation / megajoulesPerKilowattHour * peakKW * performanceRatio
+	}
+	return energy
+}
+
+// ReferenceEvapotranspiration estimates the daily reference evapotranspiration in mm with
+// the Makkink formula, from DailyShortwaveRadiationSum and the mean of
+// DailyTemperature2mMax and DailyTemperature2mMin (in °C, converted from °F, see WithUnits).
+// Makkink only needs radiation and temperature, which makes it suitable for irrigation
+// planning where humidity and wind are not available; the API's FAO-56 Penman-Monteith value
+// is more accurate when they are.
+// Days with missing data are NaN.
+func ReferenceEvapotranspiration(daily *Series) []float64 {
+	daily = daily.metric()
+	et0 := make([]float64, daily.Len())
+	for i := range et0 {
+		radiation := daily.valueAt(DailyShortwaveRadiationSum, i)
+		temp := (daily.valueAt(DailyTemperature2mMax, i) + daily.valueAt(DailyTemperature2mMin, i)) / 2
+		if math.IsNaN(radiation) || math.IsNaN(temp) {
+			et0[i] = math.NaN()
+			continue
+		}
+		// Slope of the saturation vapour pressure curve in kPa/°C
+		es := 0.6108 * math.Exp(17.27*temp/(temp+237.3))
+		slope := 4098 * es / ((temp + 237.3) * (temp + 237.3))
+		et0[i] = math.Max(0, makkinkCoefficient*slope/(slope+psychrometricConstant)*radiation/latentHeat)
+	}
+	return et0
+}
@@ -0,0 +1,60 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestPVEnergy tests the daily PV yield estimate from the shortwave radiation sum
+func TestPVEnergy(t *testing.T) {
+	s := dailySeries(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailyShortwaveRadiationSum: {18, 3.6, math.NaN()},
+	}, map[Variable]string{DailyShortwaveRadiationSum: "MJ/m²"})
+
+	got := PVEnergy(&s, 10, 0.8)
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 days, got %d", len(got))
+	}
+	// 18 MJ/m² = 5 kWh/m² -> 5 × 10 kWp × 0.8
+	if math.Abs(got[0]-40) > 1e-9 || math.Abs(got[1]-8) > 1e-9 {
+		t.Errorf("Expected 40 and 8 kWh, got %v and %v", got[0], got[1])
+	}
+	if !math.IsNaN(got[2]) {
+		t.Errorf("Expected NaN for missing radiation, got %v", got[2])
+	}
+}
+
+// TestReferenceEvapotranspiration tests the Makkink estimate against a reference value
+func TestReferenceEvapotranspiration(t *testing.T) {
+	s := dailySeries(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailyShortwaveRadiationSum: {20, 20, 0},
+		DailyTemperature2mMax:      {25, math.NaN(), 5},
+		DailyTemperature2mMin:      {15, 10, -5},
+	}, map[Variable]string{})
+
+	got := ReferenceEvapotranspiration(&s)
+	// At 20 °C, Δ ≈ 0.1447 kPa/°C: 0.65 × 0.1447/(0.1447+0.066) × 20/2.45 ≈ 3.65 mm
+	if math.Abs(got[0]-3.65) > 0.01 {
+		t.Errorf("Expected about 3.65 mm, got %.3f", got[0])
+	}
+	if !math.IsNaN(got[1]) {
+		t.Errorf("Expected NaN for missing temperature, got %v", got[1])
+	}
+	if got[2] != 0 {
+		t.Errorf("Expected 0 mm without radiation, got %v", got[2])
+	}
+}
+
+// TestReferenceEvapotranspiration_Imperial tests that temperatures in °F are converted to °C
+func TestReferenceEvapotranspiration_Imperial(t *testing.T) {
+	s := dailySeries(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailyShortwaveRadiationSum: {20},
+		DailyTemperature2mMax:      {77},
+		DailyTemperature2mMin:      {59},
+	}, map[Variable]string{DailyTemperature2mMax: "°F", DailyTemperature2mMin: "°F"})
+
+	if got := ReferenceEvapotranspiration(&s); math.Abs(got[0]-3.65) > 0.01 {
+		t.Errorf("Expected about 3.65 mm at a mean of 68 °F, got %.3f", got[0])
+	}
+}
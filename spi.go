@@ -0,0 +1,277 @@
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// spiMaxMissingDays is the number of missing days from which a month's precipitation
+	// total is considered missing
+	spiMaxMissingDays = 3
+
+	// spiLimit bounds SPI values; the fitted distribution is unreliable beyond ±3
+	spiLimit = 3
+)
+
+// SPIValue is the Standardized Precipitation Index of one month.
+type SPIValue struct {
+	// Month is the first day of the last month of the window, in the series' Location
+	Month time.Time
+
+	// Precipitation is the precipitation total over the window in mm (NaN if incomplete)
+	Precipitation float64
+
+	// SPI is the index: 0 is the median for the time of year, negative values are drier and
+	// positive values wetter, in standard deviations (NaN if unavailable)
+	SPI float64
+}
+
+// Category returns the drought or wetness class of the value following McKee et al. (1993):
+// "extremely dry" (-2 and below), "severely dry", "moderately dry", "near normal" (-1 to 1),
+// "moderately wet", "very wet" and "extremely wet" (2 and above), or "" if unavailable.
+func (v SPIValue) Category() string {
+	switch spi := v.SPI; {
+	case math.IsNaN(spi):
+		return ""
+	case spi <= -2:
+		return "extremely dry"
+	case spi <= -1.5:
+		return "severely dry"
+	case spi <= -1:
+		return "moderately dry"
+	case spi < 1:
+		return "near normal"
+	case spi < 1.5:
+		return "moderately wet"
+	case spi < 2:
+		return "very wet"
+	default:
+		return "extremely wet"
+	}
+}
+
+// SPI computes the Standardized Precipitation Index over windows of the given number of
+// months (commonly 1, 3 or 6) from a daily series containing DailyPrecipitationSum, such as
+// the result of DownloadHistoricalDaily. Daily values are summed into calendar months (months
+// missing more than 3 days are treated as missing), rolling window totals are fitted to a
+// gamma distribution per calendar month and transformed to a standard normal distribution.
+//
+// The fit needs a long record: use at least 30 years of data for meaningful values. One value
+// is returned per month; the first months-1 values are NaN because their window is incomplete.
+//
+// Example:
+//
+//	history, err := client.DownloadHistoricalDaily(ctx, 40.42, -3.70,
+//	    []openmeteo.Variable{openmeteo.DailyPrecipitationSum}, start, end, openmeteo.WithTimezone("auto"))
+//	if err != nil {
+//	    return err
+//	}
+//	spi3, err := openmeteo.SPI(&history.Daily, 3)
+//	if err != nil {
+//	    return err
+//	}
+//	latest := spi3[len(spi3)-1]
+//	fmt.Printf("SPI-3 for %s: %.2f (%s)\n", latest.Month.Format("Jan 2006"), latest.SPI, latest.Category())
+func SPI(daily *Series, months int) ([]SPIValue, error) {
+	if months < 1 {
+		return nil, fmt.Errorf("invalid SPI window: %d months (must be at least 1)", months)
+	}
+	if _, ok := daily.Values[DailyPrecipitationSum]; !ok {
+		return nil, fmt.Errorf("series has no %s values", DailyPrecipitationSum)
+	}
+
+	starts, totals := monthlyTotals(daily)
+	values := make([]SPIValue, len(totals))
+	for i := range values {
+		values[i] = SPIValue{Month: starts[i], Precipitation: math.NaN(), SPI: math.NaN()}
+		if i < months-1 {
+			continue
+		}
+		sum := 0.0
+		for _, total := range totals[i-months+1 : i+1] {
+			sum += total
+		}
+		values[i].Precipitation = sum
+	}
+
+	// Fit a distribution per calendar month of the window end
+	for month := time.January; month <= time.December; month++ {
+		var sample []float64
+		for _, v := range values {
+			if v.Month.Month() == month && !math.IsNaN(v.Precipitation) {
+				sample = append(sample, v.Precipitation)
+			}
+		}
+		fit, ok := fitGamma(sample)
+		if !ok {
+			continue
+		}
+		for i, v := range values {
+			if v.Month.Month() == month && !math.IsNaN(v.Precipitation) {
+				values[i].SPI = fit.spi(v.Precipitation)
+			}
+		}
+	}
+	return values, nil
+}
+
+// monthlyTotals sums the daily precipitation of a series into consecutive calendar months.
+// Months missing more than spiMaxMissingDays days are NaN.
+func monthlyTotals(daily *Series) ([]time.Time, []float64) {
+	var starts []time.Time
+	var totals []float64
+	var missing []int
+
+	for i, day := range daily.TimesInLocal() {
+		start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+		for len(starts) == 0 || starts[len(starts)-1].Before(start) {
+			next := start
+			if n := len(starts); n > 0 {
+				next = starts[n-1].AddDate(0, 1, 0)
+			}
+			starts = append(starts, next)
+			totals = append(totals, 0)
+			missing = append(missing, daysIn(next))
+		}
+		n := len(starts) - 1
+		if value := daily.valueAt(DailyPrecipitationSum, i); !math.IsNaN(value) {
+			totals[n] += value
+			missing[n]--
+		}
+	}
+	for i := range totals {
+		if missing[i] > spiMaxMissingDays {
+			totals[i] = math.NaN()
+		}
+	}
+	return starts, totals
+}
+
+// daysIn returns the number of days of the month starting at start.
+func daysIn(start time.Time) int {
+	return start.AddDate(0, 1, -1).Day()
+}
+
+// gammaFit is a gamma distribution fitted to precipitation totals, mixed with the
+// probability of zero precipitation.
+type gammaFit struct {
+	alpha, beta float64
+	zeros       float64
+}
+
+// fitGamma fits a gamma distribution to the non-zero values of sample using Thom's maximum
+// likelihood approximation. It reports false when the sample is too small or constant.
+func fitGamma(sample []float64) (gammaFit, bool) {
+	var sum, logSum float64
+	nonZero := 0
+	for _, x := range sample {
+		if x > 0 {
+			sum += x
+			logSum += math.Log(x)
+			nonZero++
+		}
+	}
+	if nonZero < 2 {
+		return gammaFit{}, false
+	}
+	mean := sum / float64(nonZero)
+	a := math.Log(mean) - logSum/float64(nonZero)
+	if a <= 0 {
+		return gammaFit{}, false
+	}
+	alpha := (1 + math.Sqrt(1+4*a/3)) / (4 * a)
+	return gammaFit{
+		alpha: alpha,
+		beta:  mean / alpha,
+		zeros: float64(len(sample)-nonZero) / float64(len(sample)),
+	}, true
+}
+
+// spi transforms a precipitation total into the standard normal distribution.
+func (g gammaFit) spi(x float64) float64 {
+	p := g.zeros
+	if x > 0 {
+		p += (1 - g.zeros) * regularizedGammaP(g.alpha, x/g.beta)
+	}
+	return math.Max(-spiLimit, math.Min(spiLimit, normalQuantile(p)))
+}
+
+// regularizedGammaP returns the regularized lower incomplete gamma function P(a, x), using
+// its series expansion for x < a+1 and a continued fraction otherwise (Numerical Recipes).
+func regularizedGammaP(a, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	lgamma, _ := math.Lgamma(a)
+	prefix := math.Exp(-x + a*math.Log(x) - lgamma)
+
+	if x < a+1 {
+		term := 1 / a
+		sum := term
+		for n := 1; n < 500; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*1e-14 {
+				break
+			}
+		}
+		return sum * prefix
+	}
+
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1; n < 500; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-14 {
+			break
+		}
+	}
+	return 1 - prefix*h
+}
+
+// normalQuantile returns the quantile function (inverse CDF) of the standard normal
+// distribution at p, using Acklam's rational approximation (relative error below 1.2e-9).
+func normalQuantile(p float64) float64 {
+	switch {
+	case p <= 0:
+		return math.Inf(-1)
+	case p >= 1:
+		return math.Inf(1)
+	}
+
+	a := [6]float64{-3.969683028665376e+01, 2.209460984245205e+02, -2.759285104469687e+02, 1.383577518672690e+02, -3.066479806614716e+01, 2.506628277459239e+00}
+	b := [5]float64{-5.447609879822406e+01, 1.615858368580409e+02, -1.556989798598866e+02, 6.680131188771972e+01, -1.328068155288572e+01}
+	c := [6]float64{-7.784894002430293e-03, -3.223964580411365e-01, -2.400758277161838e+00, -2.549732539343734e+00, 4.374664141464968e+00, 2.938163982698783e+00}
+	d := [4]float64{7.784695709041462e-03, 3.224671290700398e-01, 2.445134137142996e+00, 3.754408661907416e+00}
+
+	const low = 0.02425
+	switch {
+	case p < low:
+		q := math.Sqrt(-2 * math.Log(p))
+		return (((((c[0]*q+c[1])*q+c[2])*q+c[3])*q+c[4])*q + c[5]) / ((((d[0]*q+d[1])*q+d[2])*q+d[3])*q + 1)
+	case p > 1-low:
+		q := math.Sqrt(-2 * math.Log(1-p))
+		return -(((((c[0]*q+c[1])*q+c[2])*q+c[3])*q+c[4])*q + c[5]) / ((((d[0]*q+d[1])*q+d[2])*q+d[3])*q + 1)
+	default:
+		q := p - 0.5
+		r := q * q
+		return (((((a[0]*r+a[1])*r+a[2])*r+a[3])*r+a[4])*r + a[5]) * q / (((((b[0]*r+b[1])*r+b[2])*r+b[3])*r+b[4])*r + 1)
+	}
+}
@@ -0,0 +1,142 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestRegularizedGammaP tests the incomplete gamma function against closed forms
+func TestRegularizedGammaP(t *testing.T) {
+	for _, x := range []float64{0.1, 0.5, 1, 2, 5, 10} {
+		if got, want := regularizedGammaP(1, x), 1-math.Exp(-x); math.Abs(got-want) > 1e-12 {
+			t.Errorf("P(1, %v) = %v, want %v", x, got, want)
+		}
+		if got, want := regularizedGammaP(2, x), 1-math.Exp(-x)*(1+x); math.Abs(got-want) > 1e-12 {
+			t.Errorf("P(2, %v) = %v, want %v", x, got, want)
+		}
+	}
+	if regularizedGammaP(3, 0) != 0 {
+		t.Error("Expected P(a, 0) = 0")
+	}
+}
+
+// TestNormalQuantile tests the inverse normal CDF at well-known points
+func TestNormalQuantile(t *testing.T) {
+	tests := map[float64]float64{0.5: 0, 0.975: 1.959963985, 0.025: -1.959963985, 0.8413447461: 1, 0.001: -3.090232306}
+	for p, want := range tests {
+		if got := normalQuantile(p); math.Abs(got-want) > 1e-6 {
+			t.Errorf("normalQuantile(%v) = %v, want %v", p, got, want)
+		}
+	}
+	if !math.IsInf(normalQuantile(0), -1) || !math.IsInf(normalQuantile(1), 1) {
+		t.Error("Expected infinite quantiles at 0 and 1")
+	}
+}
+
+// precipitationHistory builds a daily precipitation series over the given years where the
+// daily amount of each year is given by amount(year)
+func precipitationHistory(startYear, years int, amount func(year int) float64) Series {
+	start := time.Date(startYear, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(years, 0, 0)
+	var values []float64
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		values = append(values, amount(d.Year()))
+	}
+	return dailySeries(start, map[Variable][]float64{DailyPrecipitationSum: values}, map[Variable]string{DailyPrecipitationSum: "mm"})
+}
+
+// TestSPI tests SPI values, windows and categories
+func TestSPI(t *testing.T) {
+	// Daily amounts from 1 to 3 mm over 30 years, wettest in the last year
+	s := precipitationHistory(1991, 30, func(year int) float64 { return 1 + float64((year*7)%29)/14 })
+
+	spi, err := SPI(&s, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(spi) != 360 {
+		t.Fatalf("Expected 360 months, got %d", len(spi))
+	}
+	if !math.IsNaN(spi[0].SPI) || !math.IsNaN(spi[1].Precipitation) || math.IsNaN(spi[2].SPI) {
+		t.Errorf("Expected the first 2 windows to be incomplete, got %+v", spi[:3])
+	}
+	if !spi[2].Month.Equal(time.Date(1991, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected window ending in March 1991, got %v", spi[2].Month)
+	}
+
+	var sum float64
+	n := 0
+	for _, v := range spi {
+		if !math.IsNaN(v.SPI) {
+			sum += v.SPI
+			n++
+		}
+	}
+	if mean := sum / float64(n); math.Abs(mean) > 0.2 {
+		t.Errorf("Expected SPI values to be centred on 0, got mean %.3f", mean)
+	}
+
+	// Wetter years have higher SPI for the same month
+	wet, dry := -1, -1
+	for i, v := range spi {
+		if v.Month.Month() != time.June {
+			continue
+		}
+		if wet < 0 || v.Precipitation > spi[wet].Precipitation {
+			wet = i
+		}
+		if dry < 0 || v.Precipitation < spi[dry].Precipitation {
+			dry = i
+		}
+	}
+	if spi[wet].SPI < 1 || spi[dry].SPI > -1 {
+		t.Errorf("Expected wettest June to be wet and driest dry, got %.2f and %.2f", spi[wet].SPI, spi[dry].SPI)
+	}
+	if spi[wet].Category() == "near normal" || spi[dry].Category() == "near normal" {
+		t.Errorf("Unexpected categories %q and %q", spi[wet].Category(), spi[dry].Category())
+	}
+}
+
+// TestSPI_MissingData tests incomplete months and invalid input
+func TestSPI_MissingData(t *testing.T) {
+	s := precipitationHistory(2001, 5, func(year int) float64 { return float64(year - 2000) })
+	for i := 31; i < 40; i++ { // 9 missing days in February 2001
+		s.Values[DailyPrecipitationSum][i] = math.NaN()
+	}
+
+	spi, err := SPI(&s, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !math.IsNaN(spi[1].Precipitation) || !math.IsNaN(spi[1].SPI) {
+		t.Errorf("Expected February 2001 to be missing, got %+v", spi[1])
+	}
+	if math.IsNaN(spi[0].SPI) || spi[0].Precipitation != 31 {
+		t.Errorf("Expected January 2001 total of 31 mm, got %+v", spi[0])
+	}
+
+	if _, err := SPI(&s, 0); err == nil {
+		t.Error("Expected error for an empty window")
+	}
+	empty := Series{Values: map[Variable][]float64{}}
+	if _, err := SPI(&empty, 3); err == nil {
+		t.Error("Expected error without precipitation data")
+	}
+}
+
+// TestSPIValue_Category tests the McKee classes
+func TestSPIValue_Category(t *testing.T) {
+	tests := []struct {
+		spi  float64
+		want string
+	}{
+		{-2.5, "extremely dry"}, {-1.7, "severely dry"}, {-1.2, "moderately dry"}, {0, "near normal"},
+		{1.2, "moderately wet"}, {1.7, "very wet"}, {2.5, "extremely wet"}, {math.NaN(), ""},
+	}
+	for _, tt := range tests {
+		if got := (SPIValue{SPI: tt.spi}).Category(); got != tt.want {
+			t.Errorf("Category(%v) = %q, want %q", tt.spi, got, tt.want)
+		}
+	}
+}
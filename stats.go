@@ -0,0 +1,133 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"path"
+	"slices"
+	"sync"
+	"time"
+)
+
+// latencyWindow is the number of most recent latencies kept per endpoint for percentiles
+const latencyWindow = 1024
+
+// Stats is a point-in-time snapshot of the client's request statistics.
+type Stats struct {
+	// Endpoints holds statistics keyed by endpoint name (e.g., "forecast", "search")
+	Endpoints map[string]EndpointStats
+}
+
+// EndpointStats summarizes the requests made to a single API endpoint.
+// Latency percentiles are computed over the most recent 1024 requests.
+type EndpointStats struct {
+	// Requests is the total number of requests sent
+	Requests int
+
+	// Errors is the number of requests that failed (network, HTTP status or decoding errors)
+	Errors int
+
+	// P50 is the median request latency
+	P50 time.Duration
+
+	// P90 is the 90th percentile request latency
+	P90 time.Duration
+
+	// P99 is the 99th percentile request latency
+	P99 time.Duration
+
+	// Max is the highest latency in the window
+	Max time.Duration
+}
+
+// statsRecorder accumulates per-endpoint request statistics. It is safe for concurrent use.
+type statsRecorder struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointRecorder
+}
+
+// endpointRecorder holds the counters and latency ring buffer of one endpoint.
+type endpointRecorder struct {
+	requests  int
+	errors    int
+	latencies []time.Duration
+	next      int
+}
+
+// endpointName derives the endpoint name from a request URL path.
+func endpointName(urlPath string) string {
+	return path.Base(urlPath)
+}
+
+// record adds a completed request to the statistics of endpoint.
+func (s *statsRecorder) record(endpoint string, latency time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.endpoints == nil {
+		s.endpoints = make(map[string]*endpointRecorder)
+	}
+	e := s.endpoints[endpoint]
+	if e == nil {
+		e = &endpointRecorder{}
+		s.endpoints[endpoint] = e
+	}
+
+	e.requests++
+	if failed {
+		e.errors++
+	}
+	if len(e.latencies) < latencyWindow {
+		e.latencies = append(e.latencies, latency)
+	} else {
+		e.latencies[e.next] = latency
+		e.next = (e.next + 1) % latencyWindow
+	}
+}
+
+// snapshot returns a copy of the current statistics.
+func (s *statsRecorder) snapshot() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := Stats{Endpoints: make(map[string]EndpointStats, len(s.endpoints))}
+	for name, e := range s.endpoints {
+		sorted := slices.Clone(e.latencies)
+		slices.Sort(sorted)
+		es := EndpointStats{
+			Requests: e.requests,
+			Errors:   e.errors,
+			P50:      percentile(sorted, 0.50),
+			P90:      percentile(sorted, 0.90),
+			P99:      percentile(sorted, 0.99),
+		}
+		if len(sorted) > 0 {
+			es.Max = sorted[len(sorted)-1]
+		}
+		stats.Endpoints[name] = es
+	}
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p (0-1) of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.5) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+	return sorted[rank]
+}
+
+// Stats returns a snapshot of per-endpoint request counts, error counts and latency
+// percentiles recorded since the client was created. It does not depend on any
+// metrics backend; export the values to your own monitoring as needed.
+//
+// Example:
+//
+//	for endpoint, s := range client.Stats().Endpoints {
+//	    log.Printf("%s: %d requests, %d errors, p99 %s", endpoint, s.Requests, s.Errors, s.P99)
+//	}
+func (c *Client) Stats() Stats {
+	return c.stats.snapshot()
+}
@@ -0,0 +1,95 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestClient_Stats tests that requests and errors are counted per endpoint
+func TestClient_Stats(t *testing.T) {
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/search" {
+			_, _ = fmt.Fprintln(w, `{"results": []}`)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"latitude": 52.52, "longitude": 13.41, "current": {"time": "2025-12-29T10:00"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithGeocodingBaseURL(server.URL))
+	ctx := context.Background()
+
+	if len(client.Stats().Endpoints) != 0 {
+		t.Error("Expected empty stats for a new client")
+	}
+
+	for range 3 {
+		if _, err := client.GetCurrentWeather(ctx, 52.52, 13.41); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if _, err := client.SearchLocations(ctx, "Berlin"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	fail = true
+	if _, err := client.GetCurrentWeather(ctx, 52.52, 13.41); err == nil {
+		t.Fatal("Expected error")
+	}
+	// Validation failures never reach the network and are not counted
+	if _, err := client.GetCurrentWeather(ctx, 91, 13.41); err == nil {
+		t.Fatal("Expected validation error")
+	}
+
+	stats := client.Stats()
+	forecast := stats.Endpoints["forecast"]
+	if forecast.Requests != 4 || forecast.Errors != 1 {
+		t.Errorf("Expected 4 forecast requests with 1 error, got %+v", forecast)
+	}
+	search := stats.Endpoints["search"]
+	if search.Requests != 1 || search.Errors != 0 {
+		t.Errorf("Expected 1 search request, got %+v", search)
+	}
+	if forecast.Max <= 0 || forecast.P50 > forecast.P99 || forecast.P99 > forecast.Max {
+		t.Errorf("Unexpected latency percentiles %+v", forecast)
+	}
+}
+
+// TestStatsRecorder_Percentiles tests percentile calculation and the latency window
+func TestStatsRecorder_Percentiles(t *testing.T) {
+	var s statsRecorder
+	for i := 1; i <= 100; i++ {
+		s.record("forecast", time.Duration(i)*time.Millisecond, false)
+	}
+
+	es := s.snapshot().Endpoints["forecast"]
+	if es.P50 != 50*time.Millisecond || es.P90 != 90*time.Millisecond || es.P99 != 99*time.Millisecond || es.Max != 100*time.Millisecond {
+		t.Errorf("Unexpected percentiles %+v", es)
+	}
+
+	for range latencyWindow {
+		s.record("forecast", time.Second, true)
+	}
+	es = s.snapshot().Endpoints["forecast"]
+	if es.Requests != 100+latencyWindow || es.Errors != latencyWindow {
+		t.Errorf("Unexpected counters %+v", es)
+	}
+	if es.P50 != time.Second {
+		t.Errorf("Expected old latencies to leave the window, got P50 %s", es.P50)
+	}
+
+	if percentile(nil, 0.5) != 0 {
+		t.Error("Expected zero percentile for no samples")
+	}
+}
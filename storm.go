@@ -0,0 +1,186 @@
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+// StormVariables lists the hourly variables used by StormApproaching.
+var StormVariables = []Variable{
+	HourlyPressureMSL,
+	HourlyWindSpeed10m,
+	HourlyWindGusts10m,
+	HourlyCAPE,
+	HourlyWeatherCode,
+}
+
+const (
+	// stormPressureDrop and stormPressureDropRapid are 3-hour pressure changes (hPa) that
+	// contribute to the storm score
+	stormPressureDrop      = -2.0
+	stormPressureDropRapid = -rapidPressureChange
+
+	// stormGustRatio is the gust to mean wind ratio indicating gusty, squally conditions,
+	// stormGustRatioIncrease the increase over 3 hours that counts as rising, and
+	// stormMinGusts the gust speed (km/h) below which the ratio is ignored
+	stormGustRatio         = 1.5
+	stormGustRatioIncrease = 0.2
+	stormMinGusts          = 30
+
+	// stormCAPE and stormCAPEHigh are instability thresholds in J/kg
+	stormCAPE     = 1000
+	stormCAPEHigh = 2500
+
+	// stormScoreApproaching and stormScoreHigh are the step scores from which a storm is
+	// considered approaching and the risk high
+	stormScoreApproaching = 3
+	stormScoreHigh        = 5
+)
+
+// StormSignal names an indicator contributing to a storm outlook.
+type StormSignal string
+
+const (
+	// StormSignalFallingPressure is a pressure drop of at least 2 hPa in 3 hours
+	StormSignalFallingPressure StormSignal = "falling_pressure"
+
+	// StormSignalRisingGusts is a rising gust to wind ratio of at least 1.5 with gusts of 30 km/h or more
+	StormSignalRisingGusts StormSignal = "rising_gusts"
+
+	// StormSignalInstability is a CAPE of at least 1000 J/kg
+	StormSignalInstability StormSignal = "instability"
+
+	// StormSignalThunderstorm is a forecast thunderstorm (WMO codes 95, 96, 99)
+	StormSignalThunderstorm StormSignal = "thunderstorm"
+)
+
+// stormSignals lists the signals in reporting order
+var stormSignals = []StormSignal{StormSignalFallingPressure, StormSignalRisingGusts, StormSignalInstability, StormSignalThunderstorm}
+
+// StormOutlook is the result of StormApproaching.
+type StormOutlook struct {
+	// Approaching reports that a storm is likely within the horizon
+	Approaching bool
+
+	// Risk grades the strongest combination of signals within the horizon
+	Risk RiskLevel
+
+	// Onset is the estimated window of the first storm period (zero when not approaching)
+	Onset TimeWindow
+
+	// Signals lists the indicators present during the storm periods
+	Signals []StormSignal
+}
+
+// StormApproaching combines falling pressure, a rising gust to wind ratio, instability
+// (CAPE) and thunderstorm weather codes over the hours from from to from+horizon into a
+// simple "storm approaching" indicator. It works on an hourly series containing
+// StormVariables in any units (see WithUnits); include the 3 hours before from so that
+// pressure and gust trends can be computed from the first step. Missing variables do not
+// contribute.
+//
+// Each step is scored: 1 for a pressure drop of 2 hPa in 3 hours (2 from 3.6 hPa), 1 for
+// rising gustiness, 1 for CAPE from 1000 J/kg (2 from 2500 J/kg) and 3 for a forecast
+// thunderstorm. A storm is approaching when a step scores 3 or more; the risk is high
+// from a score of 5. This is a heuristic, not a substitute for official warnings.
+//
+// Example:
+//
+//	now := time.Now()
+//	f, err := client.GetHourlyForecast(ctx, 48.14, 11.58, openmeteo.StormVariables,
+//	    openmeteo.WithHourRange(now.Add(-3*time.Hour), now.Add(12*time.Hour)))
+//	if err != nil {
+//	    return err
+//	}
+//	outlook := openmeteo.StormApproaching(&f.Hourly, now, 12*time.Hour)
+//	if outlook.Approaching {
+//	    fmt.Printf("storm likely from %s (%s risk)\n", outlook.Onset.Start.Format("15:04"), outlook.Risk)
+//	}
+func StormApproaching(hourly *Series, from time.Time, horizon time.Duration) StormOutlook {
+	hourly = hourly.metric()
+	var outlook StormOutlook
+	seen := make(map[StormSignal]bool)
+	maxScore := 0
+	flagged := make([]bool, hourly.Len())
+
+	for i, t := range hourly.Time {
+		if t.Before(from) || t.After(from.Add(horizon)) {
+			continue
+		}
+		score, signals := hourly.stormScore(i)
+		maxScore = max(maxScore, score)
+		if score >= stormScoreApproaching {
+			flagged[i] = true
+			for _, s := range signals {
+				seen[s] = true
+			}
+		}
+	}
+
+	if windows := windowsOf(hourly.Time, func(i int) bool { return flagged[i] }); len(windows) > 0 {
+		outlook.Approaching = true
+		outlook.Onset = windows[0]
+	}
+	switch {
+	case maxScore >= stormScoreHigh:
+		outlook.Risk = RiskHigh
+	case maxScore >= stormScoreApproaching:
+		outlook.Risk = RiskMedium
+	}
+	for _, s := range stormSignals {
+		if seen[s] {
+			outlook.Signals = append(outlook.Signals, s)
+		}
+	}
+	return outlook
+}
+
+// stormScore scores the storm signals of step i.
+func (s *Series) stormScore(i int) (int, []StormSignal) {
+	score := 0
+	var signals []StormSignal
+
+	if prev := s.indexAt(s.Time[i].Add(-pressureTendencyPeriod)); prev >= 0 && prev < i {
+		hours := s.Time[i].Sub(s.Time[prev]).Hours()
+		change := (s.valueAt(HourlyPressureMSL, i) - s.valueAt(HourlyPressureMSL, prev)) / hours * pressureTendencyPeriod.Hours()
+		switch {
+		case change <= stormPressureDropRapid:
+			score += 2
+			signals = append(signals, StormSignalFallingPressure)
+		case change <= stormPressureDrop:
+			score++
+			signals = append(signals, StormSignalFallingPressure)
+		}
+
+		ratio, prevRatio := s.gustRatio(i), s.gustRatio(prev)
+		if ratio >= stormGustRatio && ratio-prevRatio >= stormGustRatioIncrease && s.valueAt(HourlyWindGusts10m, i) >= stormMinGusts {
+			score++
+			signals = append(signals, StormSignalRisingGusts)
+		}
+	}
+
+	switch cape := s.valueAt(HourlyCAPE, i); {
+	case cape >= stormCAPEHigh:
+		score += 2
+		signals = append(signals, StormSignalInstability)
+	case cape >= stormCAPE:
+		score++
+		signals = append(signals, StormSignalInstability)
+	}
+
+	switch s.valueAt(HourlyWeatherCode, i) {
+	case 95, 96, 99:
+		score += 3
+		signals = append(signals, StormSignalThunderstorm)
+	}
+	return score, signals
+}
+
+// gustRatio returns the ratio of gusts to mean wind speed at step i (NaN without wind).
+func (s *Series) gustRatio(i int) float64 {
+	wind := s.valueAt(HourlyWindSpeed10m, i)
+	if wind <= 0 {
+		return math.NaN()
+	}
+	return s.valueAt(HourlyWindGusts10m, i) / wind
+}
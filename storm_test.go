@@ -0,0 +1,67 @@
+package openmeteo
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestStormApproaching tests the storm score, onset window and signals
+func TestStormApproaching(t *testing.T) {
+	start := time.Date(2025, 7, 20, 9, 0, 0, 0, time.UTC)
+	nan := math.NaN()
+	s := hourlySeries(start, map[Variable][]float64{
+		//                     0     1     2     3     4     5     6     7     8     9
+		HourlyPressureMSL:  {1012, 1012, 1011.8, 1011.5, 1011, 1009, 1007, 1006, 1007, 1009},
+		HourlyWindSpeed10m: {10, 10, 10, 12, 12, 15, 20, 20, 15, 10},
+		HourlyWindGusts10m: {15, 15, 15, 18, 20, 35, 55, 45, 25, 15},
+		HourlyCAPE:         {300, 400, 600, 900, 1200, 1800, 2600, 1500, 500, nan},
+		HourlyWeatherCode:  {1, 1, 2, 2, 3, 80, 95, 95, 61, 3},
+	})
+
+	outlook := StormApproaching(&s, start.Add(3*time.Hour), 6*time.Hour)
+	if !outlook.Approaching {
+		t.Fatalf("Expected storm approaching, got %+v", outlook)
+	}
+	if outlook.Risk != RiskHigh {
+		t.Errorf("Expected high risk, got %s", outlook.Risk)
+	}
+	wantOnset := TimeWindow{Start: start.Add(5 * time.Hour), End: start.Add(8 * time.Hour)}
+	if outlook.Onset != wantOnset {
+		t.Errorf("Expected onset %v, got %v", wantOnset, outlook.Onset)
+	}
+	wantSignals := []StormSignal{StormSignalFallingPressure, StormSignalRisingGusts, StormSignalInstability, StormSignalThunderstorm}
+	if !slices.Equal(outlook.Signals, wantSignals) {
+		t.Errorf("Expected signals %v, got %v", wantSignals, outlook.Signals)
+	}
+
+	// The horizon ends before the storm
+	calm := StormApproaching(&s, start, 3*time.Hour)
+	if calm.Approaching || calm.Risk != RiskLow || calm.Signals != nil || !calm.Onset.Start.IsZero() {
+		t.Errorf("Expected no storm in the first hours, got %+v", calm)
+	}
+
+	// Rapidly falling pressure, gusts and strong instability without forecast thunderstorms
+	s.Values[HourlyWeatherCode] = []float64{1, 1, 2, 2, 3, 3, 3, 3, 3, 3}
+	outlook = StormApproaching(&s, start, 12*time.Hour)
+	wantOnset = TimeWindow{Start: start.Add(5 * time.Hour), End: start.Add(8 * time.Hour)}
+	if !outlook.Approaching || outlook.Risk != RiskHigh || outlook.Onset != wantOnset || slices.Contains(outlook.Signals, StormSignalThunderstorm) {
+		t.Errorf("Expected storm without thunderstorm signal, got %+v", outlook)
+	}
+}
+
+// TestStormApproaching_MissingData tests that missing variables do not contribute
+func TestStormApproaching_MissingData(t *testing.T) {
+	start := time.Date(2025, 7, 20, 9, 0, 0, 0, time.UTC)
+	s := hourlySeries(start, map[Variable][]float64{HourlyWeatherCode: {0, 95, 0}})
+
+	outlook := StormApproaching(&s, start, 3*time.Hour)
+	want := TimeWindow{Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)}
+	if !outlook.Approaching || outlook.Onset != want || !slices.Equal(outlook.Signals, []StormSignal{StormSignalThunderstorm}) {
+		t.Errorf("Expected thunderstorm onset at hour 1, got %+v", outlook)
+	}
+	if outlook.Risk != RiskMedium {
+		t.Errorf("Expected medium risk from weather code alone, got %s", outlook.Risk)
+	}
+}
@@ -0,0 +1,100 @@
+package openmeteo
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// apiTimeLayout is the ISO 8601 layout (without seconds) used by the Open Meteo API for timestamps.
+const apiTimeLayout = "2006-01-02T15:04"
+
+// apiTimestamp is a timestamp as returned by the API: an ISO 8601 string, or Unix seconds
+// when requested with timeformat=unixtime (see WithUnixTime), kept in decimal form.
+type apiTimestamp string
+
+// UnmarshalJSON accepts both JSON strings and numbers.
+func (t *apiTimestamp) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = apiTimestamp(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*t = apiTimestamp(n)
+	return nil
+}
+
+// parseAPITime parses an API timestamp expressed in loc (UTC when loc is nil)
+// and returns the instant in UTC. Dates without a time (daily data) are parsed
+// as local midnight. Unix seconds (timeformat=unixtime) denote the instant itself.
+func parseAPITime(s string, loc *time.Location) (time.Time, error) {
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(secs, 0).UTC(), nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	layout := apiTimeLayout
+	if len(s) == len(apiDateLayout) {
+		layout = apiDateLayout
+	}
+	t, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+// resolveLocation returns the *time.Location for the timezone reported by the API.
+// It loads the IANA zone when the system time zone database knows it, and otherwise
+// falls back to a fixed zone built from the reported offset and abbreviation.
+func resolveLocation(name, abbreviation string, offsetSeconds int) *time.Location {
+	if (name == "" || name == "GMT" || name == "UTC") && offsetSeconds == 0 {
+		return time.UTC
+	}
+	if loc, err := time.LoadLocation(name); err == nil {
+		return loc
+	}
+	if abbreviation == "" {
+		abbreviation = name
+	}
+	return time.FixedZone(abbreviation, offsetSeconds)
+}
+
+// timesIn converts timestamps to loc (UTC when loc is nil).
+func timesIn(times []time.Time, loc *time.Location) []time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	out := make([]time.Time, len(times))
+	for i, t := range times {
+		out[i] = t.In(loc)
+	}
+	return out
+}
+
+// occurrenceAfter resolves a local wall-clock time that may be ambiguous because it occurs
+// twice when daylight saving time ends. Given t, one of the instants with that wall-clock time
+// in loc, it returns the earliest such instant after prev (or the earliest one overall when prev
+// is zero). Unambiguous times are returned unchanged.
+func occurrenceAfter(t, prev time.Time, loc *time.Location) time.Time {
+	wall := t.In(loc).Format(apiTimeLayout)
+	best := time.Time{}
+	for _, shift := range []time.Duration{-2 * time.Hour, -time.Hour, -30 * time.Minute, 0, 30 * time.Minute, time.Hour, 2 * time.Hour} {
+		c := t.Add(shift)
+		if c.In(loc).Format(apiTimeLayout) != wall || (!prev.IsZero() && !c.After(prev)) {
+			continue
+		}
+		if best.IsZero() || c.Before(best) {
+			best = c
+		}
+	}
+	if best.IsZero() {
+		return t
+	}
+	return best
+}
@@ -0,0 +1,186 @@
+package openmeteo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestResolveLocation tests time zone resolution with fallbacks
+func TestResolveLocation(t *testing.T) {
+	if loc := resolveLocation("", "", 0); loc != time.UTC {
+		t.Errorf("Expected UTC for empty timezone, got %v", loc)
+	}
+	if loc := resolveLocation("GMT", "GMT", 0); loc != time.UTC {
+		t.Errorf("Expected UTC for GMT, got %v", loc)
+	}
+
+	loc := resolveLocation("Mars/Olympus_Mons", "MST", 7200)
+	_, offset := time.Date(2025, 1, 1, 0, 0, 0, 0, loc).Zone()
+	if offset != 7200 {
+		t.Errorf("Expected fixed offset 7200 for unknown zone, got %d", offset)
+	}
+	if loc.String() != "MST" {
+		t.Errorf("Expected fallback zone named after abbreviation, got %s", loc)
+	}
+}
+
+// TestParseAPITime tests parsing timestamps in a location
+func TestParseAPITime(t *testing.T) {
+	loc := time.FixedZone("CET", 3600)
+	got, err := parseAPITime("2025-12-29T11:00", loc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !got.Equal(time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 10:00 UTC, got %v", got)
+	}
+	if got.Location() != time.UTC {
+		t.Errorf("Expected result in UTC, got %v", got.Location())
+	}
+
+	if got, err := parseAPITime("1767002400", loc); err != nil || !got.Equal(time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Unix seconds to denote the instant, got %v, %v", got, err)
+	}
+
+	if _, err := parseAPITime("not a time", nil); err == nil {
+		t.Error("Expected error for invalid timestamp")
+	}
+}
+
+// TestGetHourlyForecast_Timezone tests timestamps requested in a local time zone
+func TestGetHourlyForecast_Timezone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("timezone") != "auto" {
+			t.Errorf("Expected timezone=auto, got %s", r.URL.Query().Get("timezone"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 52.52,
+			"longitude": 13.41,
+			"utc_offset_seconds": 3600,
+			"timezone": "Europe/Berlin",
+			"timezone_abbreviation": "CET",
+			"hourly": {"time": ["2025-12-29T11:00", "2025-12-29T12:00"], "temperature_2m": [1, 2]}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	forecast, err := client.GetHourlyForecast(context.Background(), 52.52, 13.41,
+		[]Variable{HourlyTemperature2m}, WithTimezone("auto"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !forecast.Hourly.Time[0].Equal(time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected first step at 10:00 UTC, got %v", forecast.Hourly.Time[0])
+	}
+	local := forecast.Hourly.TimesInLocal()
+	if local[0].Hour() != 11 {
+		t.Errorf("Expected local hour 11, got %d", local[0].Hour())
+	}
+	if forecast.Location == nil || forecast.Hourly.Location != forecast.Location {
+		t.Error("Expected location attached to forecast and series")
+	}
+	if forecast.Timezone != "Europe/Berlin" || forecast.UTCOffsetSeconds != 3600 {
+		t.Errorf("Expected Europe/Berlin at +3600s, got %q at %ds", forecast.Timezone, forecast.UTCOffsetSeconds)
+	}
+}
+
+// TestGetCurrentWeather_Timezone tests current weather in a local time zone
+func TestGetCurrentWeather_Timezone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("timezone") != "America/New_York" {
+			t.Errorf("Expected timezone=America/New_York, got %s", r.URL.Query().Get("timezone"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 40.71,
+			"longitude": -74.01,
+			"utc_offset_seconds": -18000,
+			"timezone": "America/New_York",
+			"timezone_abbreviation": "EST",
+			"current": {"time": "2025-12-29T05:00", "temperature_2m": -2.0}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	weather, err := client.GetCurrentWeather(context.Background(), 40.71, -74.01, WithTimezone("America/New_York"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !weather.Time.Equal(time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 10:00 UTC, got %v", weather.Time)
+	}
+	if weather.TimeInLocal().Hour() != 5 {
+		t.Errorf("Expected local hour 5, got %d", weather.TimeInLocal().Hour())
+	}
+	if weather.Timezone != "America/New_York" || weather.UTCOffsetSeconds != -18000 {
+		t.Errorf("Expected America/New_York at -18000s, got %q at %ds", weather.Timezone, weather.UTCOffsetSeconds)
+	}
+}
+
+// TestCurrentWeather_TimeInLocalWithoutLocation tests TimeInLocal when no location is attached
+func TestCurrentWeather_TimeInLocalWithoutLocation(t *testing.T) {
+	ts := time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)
+	w := &CurrentWeather{Time: ts}
+	if !w.TimeInLocal().Equal(ts) {
+		t.Errorf("Expected %v, got %v", ts, w.TimeInLocal())
+	}
+}
+
+// TestWithTimezone_Empty tests validation of an empty timezone
+func TestWithTimezone_Empty(t *testing.T) {
+	cfg := newRequestConfig([]RequestOption{WithTimezone(" ")})
+	if cfg.check("id") == nil {
+		t.Error("Expected validation error for empty timezone")
+	}
+}
+
+// TestWithUnixTime tests requesting and parsing Unix timestamps
+func TestWithUnixTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("timeformat") != "unixtime" {
+			t.Errorf("Expected timeformat=unixtime, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintln(w, `{
+			"latitude": 52.52,
+			"longitude": 13.41,
+			"utc_offset_seconds": 3600,
+			"timezone": "Europe/Berlin",
+			"timezone_abbreviation": "CET",
+			"current": {"time": 1767002400, "temperature_2m": 1.5},
+			"hourly": {"time": [1767002400, 1767006000], "temperature_2m": [1, 2]},
+			"daily_units": {"sunrise": "unixtime"},
+			"daily": {"time": [1766962800], "sunrise": [1766992560]}
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithUnixTime())
+	forecast, err := client.GetForecast(context.Background(), ForecastRequest{
+		Latitude: 52.52, Longitude: 13.41, Current: true,
+		Hourly: []Variable{HourlyTemperature2m}, Daily: []Variable{DailySunrise},
+	}, WithTimezone("auto"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tenUTC := time.Date(2025, 12, 29, 10, 0, 0, 0, time.UTC)
+	if !forecast.Current.Time.Equal(tenUTC) || !forecast.Hourly.Time[0].Equal(tenUTC) {
+		t.Errorf("Expected 10:00 UTC, got %v and %v", forecast.Current.Time, forecast.Hourly.Time[0])
+	}
+	if day := forecast.Daily.TimesInLocal()[0]; day.Day() != 29 || day.Hour() != 0 {
+		t.Errorf("Expected local midnight of Dec 29, got %v", day)
+	}
+	if sunrise, ok := forecast.Daily.TimeAt(DailySunrise, 0); !ok || !sunrise.Equal(time.Date(2025, 12, 29, 7, 16, 0, 0, time.UTC)) {
+		t.Errorf("Expected sunrise at 07:16 UTC, got %v", sunrise)
+	}
+}
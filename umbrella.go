@@ -0,0 +1,92 @@
+package openmeteo
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// umbrellaPrecipitation is the precipitation (mm per step) from which rain is meaningful
+	umbrellaPrecipitation = 0.2
+
+	// umbrellaProbability is the precipitation probability (%) required for a rainy step
+	umbrellaProbability = 40
+
+	// umbrellaLikelyProbability is the probability (%) from which a step is rainy even if the
+	// forecast amount is below umbrellaPrecipitation
+	umbrellaLikelyProbability = 70
+)
+
+// UmbrellaVariables are the hourly variables used by NeedUmbrella.
+var UmbrellaVariables = []Variable{HourlyPrecipitation, HourlyPrecipitationProbability}
+
+// UmbrellaAdvice is the result of NeedUmbrella.
+type UmbrellaAdvice struct {
+	// Needed reports whether meaningful rain is expected in the window
+	Needed bool
+
+	// Rain is the first rainy period in the window (zero if Needed is false). Its start is
+	// clamped to the current time when it is already raining.
+	Rain TimeWindow
+
+	// Precipitation is the expected precipitation total in the window in mm
+	Precipitation float64
+
+	// Probability is the highest precipitation probability in the window in % (NaN if the
+	// series has no probabilities)
+	Probability float64
+}
+
+// NeedUmbrella reports whether meaningful rain is expected from now until now+within, and when
+// it starts and ends, from an hourly series containing UmbrellaVariables.
+//
+// A step is rainy when at least 0.2 mm are forecast with a probability of at least 40%, or
+// when the probability reaches 70%. Without HourlyPrecipitationProbability only the amount
+// is used. Precipitation in inches (see WithUnits) is converted to mm.
+//
+// Example:
+//
+//	f, err := client.GetHourlyForecast(ctx, 51.51, -0.13, openmeteo.UmbrellaVariables)
+//	if err != nil {
+//	    return err
+//	}
+//	if advice := openmeteo.NeedUmbrella(&f.Hourly, 3*time.Hour); advice.Needed {
+//	    fmt.Printf("Take an umbrella: rain from %s to %s\n", advice.Rain.Start.Format("15:04"), advice.Rain.End.Format("15:04"))
+//	}
+func NeedUmbrella(hourly *Series, within time.Duration) UmbrellaAdvice {
+	return umbrellaAdvice(hourly, time.Now(), within)
+}
+
+// umbrellaAdvice implements NeedUmbrella for the window [from, from+within).
+func umbrellaAdvice(hourly *Series, from time.Time, within time.Duration) UmbrellaAdvice {
+	hourly = hourly.metric()
+	advice := UmbrellaAdvice{Probability: math.NaN()}
+	until := from.Add(within)
+	step := time.Duration(stepHours(hourly) * float64(time.Hour))
+	rainy := make([]bool, hourly.Len())
+
+	for i, t := range hourly.Time {
+		if !t.Add(step).After(from) || !t.Before(until) {
+			continue
+		}
+		amount := hourly.valueAt(HourlyPrecipitation, i)
+		probability := hourly.valueAt(HourlyPrecipitationProbability, i)
+		if !math.IsNaN(amount) {
+			advice.Precipitation += amount
+		}
+		if !math.IsNaN(probability) && !(probability <= advice.Probability) {
+			advice.Probability = probability
+		}
+		likely := math.IsNaN(probability) || probability >= umbrellaProbability
+		rainy[i] = (amount >= umbrellaPrecipitation && likely) || probability >= umbrellaLikelyProbability
+	}
+
+	if windows := windowsOf(hourly.Time, func(i int) bool { return rainy[i] }); len(windows) > 0 {
+		advice.Needed = true
+		advice.Rain = windows[0]
+		if advice.Rain.Start.Before(from) {
+			advice.Rain.Start = from
+		}
+	}
+	return advice
+}
@@ -0,0 +1,55 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestNeedUmbrella tests rain detection within the window
+func TestNeedUmbrella(t *testing.T) {
+	start := time.Date(2025, 9, 3, 6, 0, 0, 0, time.UTC)
+	s := hourlySeries(start, map[Variable][]float64{
+		HourlyPrecipitation:            {0, 0.1, 0, 0.6, 1.2, 0, 0, 2.0},
+		HourlyPrecipitationProbability: {5, 20, 30, 60, 80, 75, 10, 90},
+	})
+
+	tests := []struct {
+		name   string
+		from   time.Time
+		within time.Duration
+		needed bool
+		rain   TimeWindow
+		total  float64
+	}{
+		{"dry morning", start, 3 * time.Hour, false, TimeWindow{}, 0.1},
+		{"rain later", start, 6 * time.Hour, true, TimeWindow{start.Add(3 * time.Hour), start.Add(6 * time.Hour)}, 1.9},
+		{"already raining", start.Add(4*time.Hour + 30*time.Minute), time.Hour, true, TimeWindow{start.Add(4*time.Hour + 30*time.Minute), start.Add(6 * time.Hour)}, 1.2},
+		{"gap before more rain", start.Add(6 * time.Hour), 2 * time.Hour, true, TimeWindow{start.Add(7 * time.Hour), start.Add(8 * time.Hour)}, 2.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			advice := umbrellaAdvice(&s, tt.from, tt.within)
+			if advice.Needed != tt.needed || advice.Rain != tt.rain {
+				t.Errorf("Expected needed=%v rain=%+v, got %+v", tt.needed, tt.rain, advice)
+			}
+			if math.Abs(advice.Precipitation-tt.total) > 1e-9 {
+				t.Errorf("Expected %.1f mm, got %.1f", tt.total, advice.Precipitation)
+			}
+		})
+	}
+	if p := umbrellaAdvice(&s, start, 5*time.Hour).Probability; p != 80 {
+		t.Errorf("Expected maximum probability 80, got %v", p)
+	}
+}
+
+// TestNeedUmbrella_NoProbability tests the amount-only fallback and the time.Now wrapper
+func TestNeedUmbrella_NoProbability(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	s := hourlySeries(start, map[Variable][]float64{HourlyPrecipitation: {0, 0.5, 0}})
+
+	advice := NeedUmbrella(&s, 3*time.Hour)
+	if !advice.Needed || !advice.Rain.Start.Equal(start.Add(time.Hour)) || !math.IsNaN(advice.Probability) {
+		t.Errorf("Unexpected advice %+v", advice)
+	}
+}
@@ -0,0 +1,269 @@
+// Package units provides strongly typed weather quantities: temperatures, speeds, pressures,
+// lengths and directions. Each type stores its value in one base unit, converts to the other
+// units of its kind with In, and formats itself with String or Format.
+//
+// Unit symbols match those of the Open Meteo API and openmeteo.Unit (e.g., "km/h" or
+// "inch"), so API units convert directly, e.g., units.SpeedUnit(openmeteo.UnitKnots).
+// Values created with an unknown unit, and conversions to one, are NaN.
+//
+// Example:
+//
+//	t := units.NewTemperature(59, units.Fahrenheit)
+//	fmt.Println(t)                        // 15.0°C
+//	fmt.Println(t.Format(units.Kelvin))   // 288.1 K
+//	wind := units.NewSpeed(18, units.KilometersPerHour)
+//	fmt.Printf("%.0f kn\n", wind.In(units.Knots)) // 10 kn
+package units
+
+import (
+	"fmt"
+	"math"
+)
+
+// TemperatureUnit is a unit of temperature.
+type TemperatureUnit string
+
+// Temperature units.
+const (
+	Celsius    TemperatureUnit = "°C"
+	Fahrenheit TemperatureUnit = "°F"
+	Kelvin     TemperatureUnit = "K"
+)
+
+// Temperature is a temperature in degrees Celsius.
+type Temperature float64
+
+// NewTemperature returns the temperature of value in unit.
+func NewTemperature(value float64, unit TemperatureUnit) Temperature {
+	switch unit {
+	case Celsius:
+		return Temperature(value)
+	case Fahrenheit:
+		return Temperature((value - 32) * 5 / 9)
+	case Kelvin:
+		return Temperature(value - 273.15)
+	default:
+		return Temperature(math.NaN())
+	}
+}
+
+// In returns the temperature expressed in unit.
+func (t Temperature) In(unit TemperatureUnit) float64 {
+	switch unit {
+	case Celsius:
+		return float64(t)
+	case Fahrenheit:
+		return float64(t)*9/5 + 32
+	case Kelvin:
+		return float64(t) + 273.15
+	default:
+		return math.NaN()
+	}
+}
+
+// Format formats the temperature in unit with one decimal, e.g., "59.5°F" or "288.1 K".
+func (t Temperature) Format(unit TemperatureUnit) string {
+	if unit == Kelvin {
+		return fmt.Sprintf("%.1f %s", t.In(unit), unit)
+	}
+	return fmt.Sprintf("%.1f%s", t.In(unit), unit)
+}
+
+// String formats the temperature in degrees Celsius, e.g., "15.3°C".
+func (t Temperature) String() string {
+	return t.Format(Celsius)
+}
+
+// SpeedUnit is a unit of speed.
+type SpeedUnit string
+
+// Speed units.
+const (
+	MetersPerSecond   SpeedUnit = "m/s"
+	KilometersPerHour SpeedUnit = "km/h"
+	MilesPerHour      SpeedUnit = "mph"
+	Knots             SpeedUnit = "kn"
+)
+
+// speedScales holds the meters per second of one unit of each speed unit.
+var speedScales = map[SpeedUnit]float64{
+	MetersPerSecond:   1,
+	KilometersPerHour: 1 / 3.6,
+	MilesPerHour:      0.44704,
+	Knots:             1852.0 / 3600,
+}
+
+// Speed is a speed in meters per second.
+type Speed float64
+
+// NewSpeed returns the speed of value in unit.
+func NewSpeed(value float64, unit SpeedUnit) Speed {
+	return Speed(toBase(value, speedScales, unit))
+}
+
+// In returns the speed expressed in unit.
+func (s Speed) In(unit SpeedUnit) float64 {
+	return fromBase(float64(s), speedScales, unit)
+}
+
+// Format formats the speed in unit with one decimal, e.g., "12.5 km/h".
+func (s Speed) Format(unit SpeedUnit) string {
+	return fmt.Sprintf("%.1f %s", s.In(unit), unit)
+}
+
+// String formats the speed in kilometers per hour, the Open Meteo default, e.g., "12.5 km/h".
+func (s Speed) String() string {
+	return s.Format(KilometersPerHour)
+}
+
+// PressureUnit is a unit of pressure.
+type PressureUnit string
+
+// Pressure units.
+const (
+	Hectopascal     PressureUnit = "hPa"
+	Kilopascal      PressureUnit = "kPa"
+	InchesOfMercury PressureUnit = "inHg"
+)
+
+// pressureScales holds the hectopascals of one unit of each pressure unit.
+var pressureScales = map[PressureUnit]float64{
+	Hectopascal:     1,
+	Kilopascal:      10,
+	InchesOfMercury: 33.8638866667,
+}
+
+// Pressure is a pressure in hectopascals.
+type Pressure float64
+
+// NewPressure returns the pressure of value in unit.
+func NewPressure(value float64, unit PressureUnit) Pressure {
+	return Pressure(toBase(value, pressureScales, unit))
+}
+
+// In returns the pressure expressed in unit.
+func (p Pressure) In(unit PressureUnit) float64 {
+	return fromBase(float64(p), pressureScales, unit)
+}
+
+// Format formats the pressure in unit, e.g., "1013.2 hPa" or "29.92 inHg". Hectopascals
+// have one decimal, kilopascals and inches of mercury two.
+func (p Pressure) Format(unit PressureUnit) string {
+	if unit == Hectopascal {
+		return fmt.Sprintf("%.1f %s", p.In(unit), unit)
+	}
+	return fmt.Sprintf("%.2f %s", p.In(unit), unit)
+}
+
+// String formats the pressure in hectopascals, e.g., "1013.2 hPa".
+func (p Pressure) String() string {
+	return p.Format(Hectopascal)
+}
+
+// LengthUnit is a unit of length, used for precipitation, snow depth and visibility.
+type LengthUnit string
+
+// Length units.
+const (
+	Millimeter LengthUnit = "mm"
+	Centimeter LengthUnit = "cm"
+	Meter      LengthUnit = "m"
+	Kilometer  LengthUnit = "km"
+	Inch       LengthUnit = "inch"
+	Foot       LengthUnit = "ft"
+	Mile       LengthUnit = "mi"
+)
+
+// lengthScales holds the meters of one unit of each length unit.
+var lengthScales = map[LengthUnit]float64{
+	Millimeter: 0.001,
+	Centimeter: 0.01,
+	Meter:      1,
+	Kilometer:  1000,
+	Inch:       0.0254,
+	Foot:       0.3048,
+	Mile:       1609.344,
+}
+
+// Length is a length in meters.
+type Length float64
+
+// NewLength returns the length of value in unit.
+func NewLength(value float64, unit LengthUnit) Length {
+	return Length(toBase(value, lengthScales, unit))
+}
+
+// In returns the length expressed in unit.
+func (l Length) In(unit LengthUnit) float64 {
+	return fromBase(float64(l), lengthScales, unit)
+}
+
+// Format formats the length in unit, e.g., "2.5 mm" or "24140 m". Meters and feet have no
+// decimals, all other units one.
+func (l Length) Format(unit LengthUnit) string {
+	if unit == Meter || unit == Foot {
+		return fmt.Sprintf("%.0f %s", l.In(unit), unit)
+	}
+	return fmt.Sprintf("%.1f %s", l.In(unit), unit)
+}
+
+// String formats the length in meters, e.g., "24140 m".
+func (l Length) String() string {
+	return l.Format(Meter)
+}
+
+// compassPoints lists the 16 compass points clockwise from north
+var compassPoints = [16]string{
+	"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
+	"S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
+}
+
+// Direction is a direction in degrees clockwise from north, e.g., the direction the wind
+// blows from.
+type Direction float64
+
+// Degrees returns the direction in degrees, wrapped into [0, 360).
+func (d Direction) Degrees() float64 {
+	deg := math.Mod(float64(d), 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// Radians returns the direction in radians, wrapped into [0, 2π).
+func (d Direction) Radians() float64 {
+	return d.Degrees() * math.Pi / 180
+}
+
+// Compass returns the 16-point compass abbreviation of the direction (e.g., "WSW"), or ""
+// for NaN and infinite values.
+func (d Direction) Compass() string {
+	if math.IsNaN(float64(d)) || math.IsInf(float64(d), 0) {
+		return ""
+	}
+	return compassPoints[int(math.Round(d.Degrees()/22.5))%16]
+}
+
+// String formats the direction in whole degrees, e.g., "250°".
+func (d Direction) String() string {
+	return fmt.Sprintf("%.0f°", d.Degrees())
+}
+
+// toBase converts value in unit to the base unit of scales, or NaN for unknown units.
+func toBase[U comparable](value float64, scales map[U]float64, unit U) float64 {
+	scale, ok := scales[unit]
+	if !ok {
+		return math.NaN()
+	}
+	return value * scale
+}
+
+// fromBase converts value in the base unit of scales to unit, or NaN for unknown units.
+func fromBase[U comparable](value float64, scales map[U]float64, unit U) float64 {
+	scale, ok := scales[unit]
+	if !ok {
+		return math.NaN()
+	}
+	return value / scale
+}
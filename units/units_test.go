@@ -0,0 +1,160 @@
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+// TestTemperature tests temperature conversions and formatting
+func TestTemperature(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+		unit  TemperatureUnit
+		want  map[TemperatureUnit]float64
+	}{
+		{"freezing", 0, Celsius, map[TemperatureUnit]float64{Celsius: 0, Fahrenheit: 32, Kelvin: 273.15}},
+		{"from fahrenheit", 59, Fahrenheit, map[TemperatureUnit]float64{Celsius: 15, Fahrenheit: 59, Kelvin: 288.15}},
+		{"from kelvin", 0, Kelvin, map[TemperatureUnit]float64{Celsius: -273.15, Fahrenheit: -459.67}},
+		{"crossover", -40, Celsius, map[TemperatureUnit]float64{Fahrenheit: -40}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			temp := NewTemperature(tt.value, tt.unit)
+			for unit, want := range tt.want {
+				if got := temp.In(unit); math.Abs(got-want) > 1e-9 {
+					t.Errorf("In(%s) = %v, want %v", unit, got, want)
+				}
+			}
+		})
+	}
+
+	temp := NewTemperature(15.3, Celsius)
+	if got := temp.String(); got != "15.3°C" {
+		t.Errorf("Expected 15.3°C, got %s", got)
+	}
+	if got := temp.Format(Fahrenheit); got != "59.5°F" {
+		t.Errorf("Expected 59.5°F, got %s", got)
+	}
+	if got := temp.Format(Kelvin); got != "288.4 K" {
+		t.Errorf("Expected 288.4 K, got %s", got)
+	}
+}
+
+// TestSpeed tests speed conversions and formatting
+func TestSpeed(t *testing.T) {
+	speed := NewSpeed(18, KilometersPerHour)
+	tests := []struct {
+		unit SpeedUnit
+		want float64
+	}{
+		{MetersPerSecond, 5},
+		{KilometersPerHour, 18},
+		{MilesPerHour, 11.184681460272012},
+		{Knots, 9.719222462203025},
+	}
+	for _, tt := range tests {
+		if got := speed.In(tt.unit); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("In(%s) = %v, want %v", tt.unit, got, tt.want)
+		}
+	}
+	if got := speed.String(); got != "18.0 km/h" {
+		t.Errorf("Expected 18.0 km/h, got %s", got)
+	}
+	if got := NewSpeed(10, Knots).Format(MetersPerSecond); got != "5.1 m/s" {
+		t.Errorf("Expected 5.1 m/s, got %s", got)
+	}
+}
+
+// TestPressure tests pressure conversions and formatting
+func TestPressure(t *testing.T) {
+	pressure := NewPressure(1013.25, Hectopascal)
+	if got := pressure.In(InchesOfMercury); math.Abs(got-29.92) > 0.01 {
+		t.Errorf("Expected about 29.92 inHg, got %v", got)
+	}
+	if got := pressure.In(Kilopascal); math.Abs(got-101.325) > 1e-9 {
+		t.Errorf("Expected 101.325 kPa, got %v", got)
+	}
+	if got := NewPressure(30, InchesOfMercury).In(Hectopascal); math.Abs(got-1015.9166) > 0.001 {
+		t.Errorf("Expected about 1015.92 hPa, got %v", got)
+	}
+	if got := pressure.String(); got != "1013.2 hPa" {
+		t.Errorf("Expected 1013.2 hPa, got %s", got)
+	}
+	if got := pressure.Format(InchesOfMercury); got != "29.92 inHg" {
+		t.Errorf("Expected 29.92 inHg, got %s", got)
+	}
+}
+
+// TestLength tests length conversions and formatting
+func TestLength(t *testing.T) {
+	rain := NewLength(25.4, Millimeter)
+	if got := rain.In(Inch); math.Abs(got-1) > 1e-9 {
+		t.Errorf("Expected 1 inch, got %v", got)
+	}
+	if got := rain.Format(Centimeter); got != "2.5 cm" {
+		t.Errorf("Expected 2.5 cm, got %s", got)
+	}
+	visibility := NewLength(15, Mile)
+	if got := visibility.String(); got != "24140 m" {
+		t.Errorf("Expected 24140 m, got %s", got)
+	}
+	if got := visibility.Format(Kilometer); got != "24.1 km" {
+		t.Errorf("Expected 24.1 km, got %s", got)
+	}
+	if got := NewLength(1, Foot).In(Inch); math.Abs(got-12) > 1e-9 {
+		t.Errorf("Expected 12 inch, got %v", got)
+	}
+}
+
+// TestDirection tests direction wrapping, compass points and formatting
+func TestDirection(t *testing.T) {
+	tests := []struct {
+		dir     Direction
+		degrees float64
+		compass string
+		str     string
+	}{
+		{0, 0, "N", "0°"},
+		{250, 250, "WSW", "250°"},
+		{-90, 270, "W", "270°"},
+		{370, 10, "N", "10°"},
+		{349, 349, "N", "349°"},
+	}
+	for _, tt := range tests {
+		if got := tt.dir.Degrees(); got != tt.degrees {
+			t.Errorf("Direction(%v).Degrees() = %v, want %v", float64(tt.dir), got, tt.degrees)
+		}
+		if got := tt.dir.Compass(); got != tt.compass {
+			t.Errorf("Direction(%v).Compass() = %q, want %q", float64(tt.dir), got, tt.compass)
+		}
+		if got := tt.dir.String(); got != tt.str {
+			t.Errorf("Direction(%v).String() = %q, want %q", float64(tt.dir), got, tt.str)
+		}
+	}
+	if got := Direction(math.NaN()).Compass(); got != "" {
+		t.Errorf("Expected empty compass point for NaN, got %q", got)
+	}
+	if got := Direction(180).Radians(); math.Abs(got-math.Pi) > 1e-9 {
+		t.Errorf("Expected π radians, got %v", got)
+	}
+}
+
+// TestUnknownUnits tests that unknown units yield NaN
+func TestUnknownUnits(t *testing.T) {
+	values := []float64{
+		float64(NewTemperature(1, "°R")),
+		NewTemperature(1, Celsius).In("°R"),
+		float64(NewSpeed(1, "ft/s")),
+		NewSpeed(1, Knots).In("ft/s"),
+		float64(NewPressure(1, "bar")),
+		NewPressure(1, Hectopascal).In("bar"),
+		float64(NewLength(1, "yd")),
+		NewLength(1, Meter).In("yd"),
+	}
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			t.Errorf("Expected NaN for value %d, got %v", i, v)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package openmeteo
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// uvIndexIrradiance is the erythemally weighted irradiance of one UV index unit in W/m²
+	uvIndexIrradiance = 0.025
+
+	// uvProtectionIndex is the UV index from which the WHO recommends sun protection
+	uvProtectionIndex = 3
+)
+
+// SkinType is a Fitzpatrick skin phototype, from I (always burns) to VI (never burns).
+type SkinType int
+
+const (
+	// SkinTypeI always burns and never tans
+	SkinTypeI SkinType = iota + 1
+
+	// SkinTypeII usually burns and tans minimally
+	SkinTypeII
+
+	// SkinTypeIII sometimes burns and tans gradually
+	SkinTypeIII
+
+	// SkinTypeIV rarely burns and tans easily
+	SkinTypeIV
+
+	// SkinTypeV very rarely burns
+	SkinTypeV
+
+	// SkinTypeVI never burns
+	SkinTypeVI
+)
+
+// minimalErythemalDose is the UV dose (J/m², erythemally weighted) that reddens the skin,
+// indexed by skin type.
+var minimalErythemalDose = [...]float64{
+	SkinTypeI:   200,
+	SkinTypeII:  250,
+	SkinTypeIII: 350,
+	SkinTypeIV:  450,
+	SkinTypeV:   600,
+	SkinTypeVI:  1000,
+}
+
+// String returns the Roman numeral of the skin type (e.g., "III").
+func (s SkinType) String() string {
+	names := [...]string{SkinTypeI: "I", SkinTypeII: "II", SkinTypeIII: "III", SkinTypeIV: "IV", SkinTypeV: "V", SkinTypeVI: "VI"}
+	if s < SkinTypeI || s > SkinTypeVI {
+		return fmt.Sprintf("SkinType(%d)", int(s))
+	}
+	return names[s]
+}
+
+// UVCategory is the WHO exposure category of a UV index.
+type UVCategory int
+
+const (
+	// UVLow is a UV index below 3
+	UVLow UVCategory = iota
+
+	// UVModerate is a UV index from 3 to below 6
+	UVModerate
+
+	// UVHigh is a UV index from 6 to below 8
+	UVHigh
+
+	// UVVeryHigh is a UV index from 8 to below 11
+	UVVeryHigh
+
+	// UVExtreme is a UV index of 11 or more
+	UVExtreme
+)
+
+// String returns the name of the category ("low", "moderate", "high", "very high" or "extreme").
+func (c UVCategory) String() string {
+	switch c {
+	case UVLow:
+		return "low"
+	case UVModerate:
+		return "moderate"
+	case UVHigh:
+		return "high"
+	case UVVeryHigh:
+		return "very high"
+	case UVExtreme:
+		return "extreme"
+	default:
+		return fmt.Sprintf("UVCategory(%d)", int(c))
+	}
+}
+
+// ClassifyUVIndex returns the WHO category of a UV index. Missing values (NaN) are UVLow.
+func ClassifyUVIndex(uvIndex float64) UVCategory {
+	switch {
+	case uvIndex >= 11:
+		return UVExtreme
+	case uvIndex >= 8:
+		return UVVeryHigh
+	case uvIndex >= 6:
+		return UVHigh
+	case uvIndex >= uvProtectionIndex:
+		return UVModerate
+	default:
+		return UVLow
+	}
+}
+
+// SafeExposure returns how long unprotected skin of the given type can be exposed at a
+// constant UV index before receiving its minimal erythemal dose (the onset of sunburn).
+// It returns false if the UV index is not positive or the skin type is unknown.
+// This is an estimate for guidance only; reflection from snow, sand or water increases the dose.
+func SafeExposure(uvIndex float64, skin SkinType) (time.Duration, bool) {
+	if !(uvIndex > 0) || skin < SkinTypeI || skin > SkinTypeVI {
+		return 0, false
+	}
+	seconds := minimalErythemalDose[skin] / (uvIndex * uvIndexIrradiance)
+	return time.Duration(seconds * float64(time.Second)).Round(time.Minute), true
+}
+
+// UVInterval is a period in which sun protection is recommended (UV index 3 or more).
+type UVInterval struct {
+	// Window is the period of the interval
+	Window TimeWindow
+
+	// MaxUVIndex is the highest UV index in the interval
+	MaxUVIndex float64
+
+	// Category is the WHO category of MaxUVIndex
+	Category UVCategory
+
+	// ShortestSafeExposure is the safe unprotected exposure at the peak of the interval
+	ShortestSafeExposure time.Duration
+
+	// LongestSafeExposure is the safe unprotected exposure at the lowest UV index of the interval
+	LongestSafeExposure time.Duration
+}
+
+// UVProtection returns the periods of an hourly series containing HourlyUVIndex in which sun
+// protection is recommended, with the range of safe unprotected exposure times for the skin type.
+//
+// Example:
+//
+//	f, err := client.GetHourlyForecast(ctx, 41.39, 2.17, []openmeteo.Variable{openmeteo.HourlyUVIndex})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, p := range openmeteo.UVProtection(&f.Hourly, openmeteo.SkinTypeII) {
+//	    fmt.Printf("%s-%s: UV %s, burns after %s to %s\n", p.Window.Start.Format("15:04"), p.Window.End.Format("15:04"),
+//	        p.Category, p.ShortestSafeExposure, p.LongestSafeExposure)
+//	}
+func UVProtection(hourly *Series, skin SkinType) []UVInterval {
+	windows := windowsOf(hourly.Time, func(i int) bool {
+		return hourly.valueAt(HourlyUVIndex, i) >= uvProtectionIndex
+	})
+
+	intervals := make([]UVInterval, 0, len(windows))
+	for _, w := range windows {
+		highest, lowest := 0.0, math.Inf(1)
+		for i, t := range hourly.Time {
+			if t.Before(w.Start) || !t.Before(w.End) {
+				continue
+			}
+			uv := hourly.valueAt(HourlyUVIndex, i)
+			highest, lowest = math.Max(highest, uv), math.Min(lowest, uv)
+		}
+		interval := UVInterval{Window: w, MaxUVIndex: highest, Category: ClassifyUVIndex(highest)}
+		interval.ShortestSafeExposure, _ = SafeExposure(highest, skin)
+		interval.LongestSafeExposure, _ = SafeExposure(lowest, skin)
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
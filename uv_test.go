@@ -0,0 +1,67 @@
+package openmeteo
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSafeExposure tests exposure times by skin type
+func TestSafeExposure(t *testing.T) {
+	tests := []struct {
+		uv   float64
+		skin SkinType
+		want time.Duration
+		ok   bool
+	}{
+		{8, SkinTypeII, 21 * time.Minute, true},
+		{4, SkinTypeII, 42 * time.Minute, true},
+		{8, SkinTypeVI, 83 * time.Minute, true},
+		{0, SkinTypeI, 0, false},
+		{5, SkinType(7), 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := SafeExposure(tt.uv, tt.skin)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("SafeExposure(%v, %s) = %s, %v; want %s, %v", tt.uv, tt.skin, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// TestClassifyUVIndex tests the WHO categories and names
+func TestClassifyUVIndex(t *testing.T) {
+	want := map[float64]UVCategory{0: UVLow, 2.9: UVLow, 3: UVModerate, 6: UVHigh, 10.9: UVVeryHigh, 11: UVExtreme}
+	for uv, category := range want {
+		if got := ClassifyUVIndex(uv); got != category {
+			t.Errorf("ClassifyUVIndex(%v) = %s, want %s", uv, got, category)
+		}
+	}
+	if UVVeryHigh.String() != "very high" || SkinTypeIV.String() != "IV" || SkinType(0).String() != "SkinType(0)" {
+		t.Error("Unexpected names")
+	}
+}
+
+// TestUVProtection tests the protection intervals of a day
+func TestUVProtection(t *testing.T) {
+	start := time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+	s := hourlySeries(start, map[Variable][]float64{
+		HourlyUVIndex: {2, 4, 6, 8, 5, 2.5, 3},
+	})
+
+	intervals := UVProtection(&s, SkinTypeII)
+	if len(intervals) != 2 {
+		t.Fatalf("Expected 2 intervals, got %+v", intervals)
+	}
+	midday := intervals[0]
+	if midday.Window != (TimeWindow{start.Add(time.Hour), start.Add(5 * time.Hour)}) {
+		t.Errorf("Unexpected window %+v", midday.Window)
+	}
+	if midday.MaxUVIndex != 8 || midday.Category != UVVeryHigh {
+		t.Errorf("Unexpected peak %+v", midday)
+	}
+	if midday.ShortestSafeExposure != 21*time.Minute || midday.LongestSafeExposure != 42*time.Minute {
+		t.Errorf("Unexpected exposure range %s-%s", midday.ShortestSafeExposure, midday.LongestSafeExposure)
+	}
+	if intervals[1].Category != UVModerate {
+		t.Errorf("Unexpected second interval %+v", intervals[1])
+	}
+}
@@ -0,0 +1,161 @@
+package openmeteo
+
+import "strings"
+
+// Variable identifies a weather variable requested from the Open Meteo API
+// (e.g., "temperature_2m"). The constants below cover the commonly used variables;
+// any other variable supported by the API can be requested by converting its name:
+//
+//	openmeteo.Variable("soil_temperature_0cm")
+type Variable string
+
+// Hourly variables available from the forecast endpoint.
+const (
+	HourlyTemperature2m            Variable = "temperature_2m"
+	HourlyRelativeHumidity2m       Variable = "relative_humidity_2m"
+	HourlyApparentTemperature      Variable = "apparent_temperature"
+	HourlyIsDay                    Variable = "is_day"
+	HourlyPrecipitation            Variable = "precipitation"
+	HourlyPrecipitationProbability Variable = "precipitation_probability"
+	HourlyRain                     Variable = "rain"
+	HourlyShowers                  Variable = "showers"
+	HourlySnowfall                 Variable = "snowfall"
+	HourlyWeatherCode              Variable = "weather_code"
+	HourlyCloudCover               Variable = "cloud_cover"
+	HourlyPressureMSL              Variable = "pressure_msl"
+	HourlySurfacePressure          Variable = "surface_pressure"
+	HourlyWindSpeed10m             Variable = "wind_speed_10m"
+	HourlyWindDirection10m         Variable = "wind_direction_10m"
+	HourlyWindGusts10m             Variable = "wind_gusts_10m"
+
+	// Wind at typical wind turbine hub heights (see Series.WindSpeedAt)
+	HourlyWindSpeed80m      Variable = "wind_speed_80m"
+	HourlyWindSpeed120m     Variable = "wind_speed_120m"
+	HourlyWindSpeed180m     Variable = "wind_speed_180m"
+	HourlyWindDirection80m  Variable = "wind_direction_80m"
+	HourlyWindDirection120m Variable = "wind_direction_120m"
+	HourlyWindDirection180m Variable = "wind_direction_180m"
+
+	// HourlyDewPoint2m is the dew point temperature at 2 meters in °C
+	HourlyDewPoint2m Variable = "dew_point_2m"
+
+	// HourlyVapourPressureDeficit is the vapour pressure deficit in kPa; high values drive
+	// plant transpiration and water stress
+	HourlyVapourPressureDeficit Variable = "vapour_pressure_deficit"
+
+	// HourlyLightningPotential is the lightning potential index in J/kg, only provided by
+	// ICON-D2 (see WithModelEndpoint and EndpointDWDICON)
+	HourlyLightningPotential Variable = "lightning_potential"
+
+	// HourlyVisibility is the horizontal visibility in meters
+	HourlyVisibility Variable = "visibility"
+
+	// HourlySnowDepth is the snow depth on the ground in meters
+	HourlySnowDepth Variable = "snow_depth"
+
+	// HourlyFreezingLevelHeight is the altitude of the 0 °C isotherm above sea level in meters
+	HourlyFreezingLevelHeight Variable = "freezing_level_height"
+
+	// HourlyCAPE is the convective available potential energy in J/kg, a measure of the
+	// atmospheric instability that fuels thunderstorms
+	HourlyCAPE Variable = "cape"
+
+	// HourlyUVIndex is the UV index accounting for clouds (see UVProtection)
+	HourlyUVIndex Variable = "uv_index"
+
+	// HourlyUVIndexClearSky is the UV index under a clear sky, the worst case for the hour
+	HourlyUVIndexClearSky Variable = "uv_index_clear_sky"
+
+	// HourlySunshineDuration is the time with direct sunshine during the preceding hour,
+	// in seconds (see Series.Duration)
+	HourlySunshineDuration Variable = "sunshine_duration"
+
+	// HourlyGlobalTiltedIrradiance is the irradiance on a tilted plane in W/m²;
+	// set the panel orientation with WithPanelOrientation.
+	HourlyGlobalTiltedIrradiance Variable = "global_tilted_irradiance"
+)
+
+// Hourly solar radiation variables in W/m², averaged over the preceding hour. The _instant
+// variants hold the value at the timestamp instead, which suits comparisons with
+// measurements of PV monitoring systems.
+const (
+	// HourlyShortwaveRadiation is the global horizontal irradiance (GHI)
+	HourlyShortwaveRadiation Variable = "shortwave_radiation"
+
+	// HourlyDirectRadiation is the direct irradiance on a horizontal plane
+	HourlyDirectRadiation Variable = "direct_radiation"
+
+	// HourlyDirectNormalIrradiance is the direct irradiance on a plane facing the sun (DNI)
+	HourlyDirectNormalIrradiance Variable = "direct_normal_irradiance"
+
+	// HourlyDiffuseRadiation is the diffuse irradiance on a horizontal plane (DHI)
+	HourlyDiffuseRadiation Variable = "diffuse_radiation"
+
+	HourlyShortwaveRadiationInstant     Variable = "shortwave_radiation_instant"
+	HourlyDirectRadiationInstant        Variable = "direct_radiation_instant"
+	HourlyDirectNormalIrradianceInstant Variable = "direct_normal_irradiance_instant"
+	HourlyDiffuseRadiationInstant       Variable = "diffuse_radiation_instant"
+	HourlyGlobalTiltedIrradianceInstant Variable = "global_tilted_irradiance_instant"
+)
+
+// SolarRadiationVariables lists the horizontal, direct normal and panel-plane irradiance
+// variables, for use with GetHourlyForecast and WithPanelOrientation.
+var SolarRadiationVariables = []Variable{
+	HourlyShortwaveRadiation,
+	HourlyDirectRadiation,
+	HourlyDirectNormalIrradiance,
+	HourlyDiffuseRadiation,
+	HourlyGlobalTiltedIrradiance,
+}
+
+// Daily variables available from the forecast endpoint. Daily data is aggregated over
+// local days and requires a timezone (see WithTimezone).
+const (
+	DailyWeatherCode                 Variable = "weather_code"
+	DailyTemperature2mMax            Variable = "temperature_2m_max"
+	DailyTemperature2mMin            Variable = "temperature_2m_min"
+	DailyApparentTemperatureMax      Variable = "apparent_temperature_max"
+	DailyApparentTemperatureMin      Variable = "apparent_temperature_min"
+	DailyPrecipitationSum            Variable = "precipitation_sum"
+	DailyRainSum                     Variable = "rain_sum"
+	DailySnowfallSum                 Variable = "snowfall_sum"
+	DailyPrecipitationProbabilityMax Variable = "precipitation_probability_max"
+	DailyWindSpeed10mMax             Variable = "wind_speed_10m_max"
+	DailyWindGusts10mMax             Variable = "wind_gusts_10m_max"
+
+	// DailyWindDirection10mDominant is the dominant wind direction in degrees (see Series.Compass)
+	DailyWindDirection10mDominant Variable = "wind_direction_10m_dominant"
+
+	// DailyShortwaveRadiationSum is the total shortwave (global) radiation in MJ/m² (see PVEnergy)
+	DailyShortwaveRadiationSum Variable = "shortwave_radiation_sum"
+
+	// DailyPrecipitationHours is the number of hours with precipitation (see Series.Duration)
+	DailyPrecipitationHours Variable = "precipitation_hours"
+
+	// DailySunshineDuration is the time with direct sunshine, in seconds (see Series.Duration)
+	DailySunshineDuration Variable = "sunshine_duration"
+
+	// DailyUVIndexMax is the highest UV index of the day, accounting for clouds
+	DailyUVIndexMax Variable = "uv_index_max"
+
+	// DailyUVIndexClearSkyMax is the highest UV index of the day under a clear sky
+	DailyUVIndexClearSkyMax Variable = "uv_index_clear_sky_max"
+
+	// DailySunrise is the local time of sunrise (see Series.TimeAt and SunTimes)
+	DailySunrise Variable = "sunrise"
+
+	// DailySunset is the local time of sunset (see Series.TimeAt and SunTimes)
+	DailySunset Variable = "sunset"
+
+	// DailyDaylightDuration is the time between sunrise and sunset, in seconds (see Series.Duration)
+	DailyDaylightDuration Variable = "daylight_duration"
+)
+
+// joinVariables formats variables as the comma-separated list expected by the API.
+func joinVariables(vars []Variable) string {
+	names := make([]string, len(vars))
+	for i, v := range vars {
+		names[i] = string(v)
+	}
+	return strings.Join(names, ",")
+}
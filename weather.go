@@ -1,12 +1,14 @@
 package openmeteo
 
 import (
-	"fmt"
+	"cmp"
+	"net/url"
 	"time"
 )
 
 // CurrentWeather represents a complete snapshot of current weather conditions at a specific location.
-// All weather parameter fields use metric units (°C, m/s, mm, hPa, %).
+// Weather parameter fields use metric units (°C, km/h, mm, hPa, %) unless the client was created
+// with WithUnits; Units records the temperature, wind speed and precipitation units in use.
 // Zero values indicate the absence of data from the API or that the measurement is zero (e.g., 0mm precipitation).
 type CurrentWeather struct {
 	// Latitude of the weather observation location in degrees (-90 to 90)
@@ -15,9 +17,18 @@ type CurrentWeather struct {
 	// Longitude of the weather observation location in degrees (-180 to 180)
 	Longitude float64
 
-	// Time of the weather observation in UTC
+	// Time of the weather observation in UTC (see TimeInLocal for the local time)
 	Time time.Time
 
+	// Location is the time zone the data was requested in (see WithTimezone); UTC by default
+	Location *time.Location `json:"-"`
+
+	// Timezone is the time zone name reported by the API (e.g., "Europe/Berlin"; "GMT" by default)
+	Timezone string
+
+	// UTCOffsetSeconds is the offset of Location from UTC in seconds
+	UTCOffsetSeconds int
+
 	// Temperature is the air temperature at 2 meters height in degrees Celsius
 	Temperature float64
 
@@ -43,7 +54,7 @@ type CurrentWeather struct {
 	Snowfall float64
 
 	// WeatherCode is the WMO weather code (0-99) indicating general weather conditions
-	WeatherCode int
+	WeatherCode WeatherCode
 
 	// CloudCover is the total cloud cover in percent (0-100)
 	CloudCover float64
@@ -62,98 +73,401 @@ type CurrentWeather struct {
 
 	// WindGusts is the maximum wind gust speed at 10 meters height in kilometers per hour
 	WindGusts float64
+
+	// DewPoint is the dew point temperature at 2 meters height in degrees Celsius
+	DewPoint float64
+
+	// Visibility is the horizontal visibility in meters
+	Visibility float64
+
+	// VapourPressureDeficit is the vapour pressure deficit in kilopascals
+	VapourPressureDeficit float64
+
+	// CAPE is the convective available potential energy in J/kg
+	CAPE float64
+
+	// Fields flags the fields above the API returned values for (see Has); null values
+	// leave their field at the zero value
+	Fields Fields
+
+	// Units are the units the API returned the fields above in (see WithUnits)
+	Units UnitSystem
+
+	// DisplayUnits selects the units used by the QuantityOf... methods.
+	// It only affects formatting; the fields above keep the units they were returned in.
+	DisplayUnits DisplayUnits
+
+	// Model is the model that produced the data when a fallback chain is configured
+	// (see WithModelFallback) or a single model is pinned (see WithModels); empty otherwise
+	Model Model
+
+	// Stale reports that the data was served from the offline cache (see WithOfflineFallback)
+	Stale bool
+
+	// Age is the time since stale data was fetched (zero for fresh results)
+	Age time.Duration
+}
+
+// UnitSystem selects the units in which the API returns temperatures, wind speeds and
+// precipitation (see WithUnits). Empty fields use the metric API defaults.
+type UnitSystem struct {
+	// Temperature is the unit for temperatures (UnitCelsius or UnitFahrenheit)
+	Temperature Unit
+
+	// WindSpeed is the unit for wind speeds and gusts (UnitKilometersPerHour, UnitMetersPerSecond, UnitMilesPerHour or UnitKnots)
+	WindSpeed Unit
+
+	// Precipitation is the unit for precipitation, rain and showers (UnitMillimeter or UnitInch);
+	// with UnitInch, snowfall is returned in inches instead of centimeters
+	Precipitation Unit
+}
+
+var (
+	// UnitsMetric returns values in the metric API defaults (°C, km/h, mm)
+	UnitsMetric = UnitSystem{}
+
+	// UnitsImperial returns values in US customary units (°F, mph, inch)
+	UnitsImperial = UnitSystem{
+		Temperature:   UnitFahrenheit,
+		WindSpeed:     UnitMilesPerHour,
+		Precipitation: UnitInch,
+	}
+)
+
+// apiTemperatureUnits, apiWindSpeedUnits and apiPrecipitationUnits map units to the values
+// of the temperature_unit, wind_speed_unit and precipitation_unit parameters.
+var (
+	apiTemperatureUnits   = map[Unit]string{UnitCelsius: "celsius", UnitFahrenheit: "fahrenheit"}
+	apiWindSpeedUnits     = map[Unit]string{UnitKilometersPerHour: "kmh", UnitMetersPerSecond: "ms", UnitMilesPerHour: "mph", UnitKnots: "kn"}
+	apiPrecipitationUnits = map[Unit]string{UnitMillimeter: "mm", UnitInch: "inch"}
+)
+
+// normalize returns u with unsupported and default units cleared.
+func (u UnitSystem) normalize() UnitSystem {
+	keep := func(unit, metric Unit, supported map[Unit]string) Unit {
+		if _, ok := supported[unit]; !ok || unit == metric {
+			return ""
+		}
+		return unit
+	}
+	return UnitSystem{
+		Temperature:   keep(u.Temperature, UnitCelsius, apiTemperatureUnits),
+		WindSpeed:     keep(u.WindSpeed, UnitKilometersPerHour, apiWindSpeedUnits),
+		Precipitation: keep(u.Precipitation, UnitMillimeter, apiPrecipitationUnits),
+	}
+}
+
+// applyQuery sets the unit parameters of u on q.
+func (u UnitSystem) applyQuery(q url.Values) {
+	if u.Temperature != "" {
+		q.Set("temperature_unit", apiTemperatureUnits[u.Temperature])
+	}
+	if u.WindSpeed != "" {
+		q.Set("wind_speed_unit", apiWindSpeedUnits[u.WindSpeed])
+	}
+	if u.Precipitation != "" {
+		q.Set("precipitation_unit", apiPrecipitationUnits[u.Precipitation])
+	}
+}
+
+// temperature returns the temperature unit, defaulting to UnitCelsius.
+func (u UnitSystem) temperature() Unit {
+	return cmp.Or(u.Temperature, UnitCelsius)
+}
+
+// windSpeed returns the wind speed unit, defaulting to UnitKilometersPerHour.
+func (u UnitSystem) windSpeed() Unit {
+	return cmp.Or(u.WindSpeed, UnitKilometersPerHour)
+}
+
+// precipitation returns the precipitation unit, defaulting to UnitMillimeter.
+func (u UnitSystem) precipitation() Unit {
+	return cmp.Or(u.Precipitation, UnitMillimeter)
+}
+
+// snowfall returns the snowfall unit: UnitInch with inch precipitation, UnitCentimeter otherwise.
+func (u UnitSystem) snowfall() Unit {
+	if u.Precipitation == UnitInch {
+		return UnitInch
+	}
+	return UnitCentimeter
+}
+
+// DisplayUnits is a display preference for the QuantityOf... methods of CurrentWeather.
+// Empty fields keep the unit in which the data was returned (see CurrentWeather.Units).
+type DisplayUnits struct {
+	// Temperature is the unit for temperatures (UnitCelsius, UnitFahrenheit or UnitKelvin)
+	Temperature Unit
+
+	// WindSpeed is the unit for wind speed and gusts (UnitKilometersPerHour, UnitMetersPerSecond, UnitMilesPerHour or UnitKnots)
+	WindSpeed Unit
+
+	// Precipitation is the unit for precipitation, rain and showers (UnitMillimeter or UnitInch)
+	Precipitation Unit
+
+	// Snowfall is the unit for snowfall (UnitCentimeter or UnitInch)
+	Snowfall Unit
+
+	// Pressure is the unit for pressure (UnitHectopascal or UnitInchesOfMercury)
+	Pressure Unit
+
+	// Visibility is the unit for visibility (UnitMeter, UnitKilometer or UnitMile)
+	Visibility Unit
+}
+
+var (
+	// DisplayUnitsMetric displays values in the metric units they are stored in
+	DisplayUnitsMetric = DisplayUnits{}
+
+	// DisplayUnitsImperial displays values in US customary units (°F, mph, inch, inHg, mi)
+	DisplayUnitsImperial = DisplayUnits{
+		Temperature:   UnitFahrenheit,
+		WindSpeed:     UnitMilesPerHour,
+		Precipitation: UnitInch,
+		Snowfall:      UnitInch,
+		Pressure:      UnitInchesOfMercury,
+		Visibility:    UnitMile,
+	}
+)
+
+// displayQuantity returns a value stored in unit, converted to the preferred display unit if set.
+// If the preferred unit is not compatible, the stored unit is used.
+func displayQuantity(value float64, unit, preferred Unit) Quantity {
+	q := Quantity{Value: value, Unit: unit}
+	if preferred != "" {
+		if converted, err := q.Convert(preferred); err == nil {
+			q = converted
+		}
+	}
+	return q
 }
 
 // weatherResponse is an internal structure for unmarshaling JSON responses from the Open Meteo API.
 // It uses pointer types to detect null values from the API.
+// Both the modern "current" block and the legacy "current_weather" block are supported.
 type weatherResponse struct {
-	Latitude       float64                `json:"latitude"`
-	Longitude      float64                `json:"longitude"`
-	CurrentWeather currentWeatherResponse `json:"current"`
+	Latitude             float64                       `json:"latitude"`
+	Longitude            float64                       `json:"longitude"`
+	UTCOffsetSeconds     int                           `json:"utc_offset_seconds"`
+	Timezone             string                        `json:"timezone"`
+	TimezoneAbbreviation string                        `json:"timezone_abbreviation"`
+	CurrentWeather       currentWeatherResponse        `json:"current"`
+	LegacyCurrentWeather *legacyCurrentWeatherResponse `json:"current_weather"`
 }
 
 // currentWeatherResponse is an internal structure for unmarshaling the current_weather object
 // from the Open Meteo API JSON response. Pointer types allow detection of null/missing values.
 type currentWeatherResponse struct {
-	Time                *string  `json:"time"`
-	Temperature         *float64 `json:"temperature_2m"`
-	Windspeed           *float64 `json:"wind_speed_10m"`
-	Winddirection       *float64 `json:"wind_direction_10m"`
-	Weathercode         *int     `json:"weather_code"`
-	IsDay               *int     `json:"is_day"`
-	RelativeHumidity    *float64 `json:"relative_humidity_2m"`
-	ApparentTemperature *float64 `json:"apparent_temperature"`
-	Precipitation       *float64 `json:"precipitation"`
-	Rain                *float64 `json:"rain"`
-	Showers             *float64 `json:"showers"`
-	Snowfall            *float64 `json:"snowfall"`
-	CloudCover          *float64 `json:"cloud_cover"`
-	PressureMSL         *float64 `json:"pressure_msl"`
-	SurfacePressure     *float64 `json:"surface_pressure"`
-	WindGusts           *float64 `json:"wind_gusts_10m"`
-}
-
-// QuantityOfTemperature returns the temperature with its unit
+	Time                *apiTimestamp `json:"time"`
+	Temperature         *float64      `json:"temperature_2m"`
+	Windspeed           *float64      `json:"wind_speed_10m"`
+	Winddirection       *float64      `json:"wind_direction_10m"`
+	Weathercode         *int          `json:"weather_code"`
+	IsDay               *int          `json:"is_day"`
+	RelativeHumidity    *float64      `json:"relative_humidity_2m"`
+	ApparentTemperature *float64      `json:"apparent_temperature"`
+	Precipitation       *float64      `json:"precipitation"`
+	Rain                *float64      `json:"rain"`
+	Showers             *float64      `json:"showers"`
+	Snowfall            *float64      `json:"snowfall"`
+	CloudCover          *float64      `json:"cloud_cover"`
+	PressureMSL         *float64      `json:"pressure_msl"`
+	SurfacePressure     *float64      `json:"surface_pressure"`
+	WindGusts           *float64      `json:"wind_gusts_10m"`
+
+	DewPoint              *float64 `json:"dew_point_2m"`
+	Visibility            *float64 `json:"visibility"`
+	VapourPressureDeficit *float64 `json:"vapour_pressure_deficit"`
+	CAPE                  *float64 `json:"cape"`
+}
+
+// legacyCurrentWeatherResponse is an internal structure for unmarshaling the legacy
+// current_weather object (requested with current_weather=true), which is still served by
+// older mirrors and self-hosted instances. It only carries a subset of the current variables.
+type legacyCurrentWeatherResponse struct {
+	Time          *apiTimestamp `json:"time"`
+	Temperature   *float64      `json:"temperature"`
+	Windspeed     *float64      `json:"windspeed"`
+	Winddirection *float64      `json:"winddirection"`
+	Weathercode   *int          `json:"weathercode"`
+	IsDay         *int          `json:"is_day"`
+}
+
+// TimeInLocal returns the observation time converted to the weather's Location,
+// i.e., the local wall-clock time of the requested timezone.
+func (w *CurrentWeather) TimeInLocal() time.Time {
+	if w.Location == nil {
+		return w.Time
+	}
+	return w.Time.In(w.Location)
+}
+
+// TemperatureQuantity returns the temperature with its unit, in DisplayUnits.Temperature if set
+func (w *CurrentWeather) TemperatureQuantity() Quantity {
+	return displayQuantity(w.Temperature, w.Units.temperature(), w.DisplayUnits.Temperature)
+}
+
+// QuantityOfTemperature returns the temperature formatted with its unit (see TemperatureQuantity)
 func (w *CurrentWeather) QuantityOfTemperature() string {
-	return fmt.Sprintf("%.1f°C", w.Temperature)
+	return w.TemperatureQuantity().String()
 }
 
-// QuantityOfApparentTemperature returns the apparent temperature with its unit
+// ApparentTemperatureQuantity returns the apparent temperature with its unit, in DisplayUnits.Temperature if set
+func (w *CurrentWeather) ApparentTemperatureQuantity() Quantity {
+	return displayQuantity(w.ApparentTemperature, w.Units.temperature(), w.DisplayUnits.Temperature)
+}
+
+// QuantityOfApparentTemperature returns the apparent temperature formatted with its unit (see ApparentTemperatureQuantity)
 func (w *CurrentWeather) QuantityOfApparentTemperature() string {
-	return fmt.Sprintf("%.1f°C", w.ApparentTemperature)
+	return w.ApparentTemperatureQuantity().String()
+}
+
+// RelativeHumidityQuantity returns the relative humidity with its unit
+func (w *CurrentWeather) RelativeHumidityQuantity() Quantity {
+	return displayQuantity(w.RelativeHumidity, UnitPercent, "")
 }
 
-// QuantityOfRelativeHumidity returns the relative humidity with its unit
+// QuantityOfRelativeHumidity returns the relative humidity formatted with its unit (see RelativeHumidityQuantity)
 func (w *CurrentWeather) QuantityOfRelativeHumidity() string {
-	return fmt.Sprintf("%.0f%%", w.RelativeHumidity)
+	return w.RelativeHumidityQuantity().String()
+}
+
+// PrecipitationQuantity returns the precipitation with its unit, in DisplayUnits.Precipitation if set
+func (w *CurrentWeather) PrecipitationQuantity() Quantity {
+	return displayQuantity(w.Precipitation, w.Units.precipitation(), w.DisplayUnits.Precipitation)
 }
 
-// QuantityOfPrecipitation returns the precipitation with its unit
+// QuantityOfPrecipitation returns the precipitation formatted with its unit (see PrecipitationQuantity)
 func (w *CurrentWeather) QuantityOfPrecipitation() string {
-	return fmt.Sprintf("%.1f mm", w.Precipitation)
+	return w.PrecipitationQuantity().String()
 }
 
-// QuantityOfRain returns the rain amount with its unit
+// RainQuantity returns the rain amount with its unit, in DisplayUnits.Precipitation if set
+func (w *CurrentWeather) RainQuantity() Quantity {
+	return displayQuantity(w.Rain, w.Units.precipitation(), w.DisplayUnits.Precipitation)
+}
+
+// QuantityOfRain returns the rain amount formatted with its unit (see RainQuantity)
 func (w *CurrentWeather) QuantityOfRain() string {
-	return fmt.Sprintf("%.1f mm", w.Rain)
+	return w.RainQuantity().String()
+}
+
+// ShowersQuantity returns the shower amount with its unit, in DisplayUnits.Precipitation if set
+func (w *CurrentWeather) ShowersQuantity() Quantity {
+	return displayQuantity(w.Showers, w.Units.precipitation(), w.DisplayUnits.Precipitation)
 }
 
-// QuantityOfShowers returns the shower amount with its unit
+// QuantityOfShowers returns the shower amount formatted with its unit (see ShowersQuantity)
 func (w *CurrentWeather) QuantityOfShowers() string {
-	return fmt.Sprintf("%.1f mm", w.Showers)
+	return w.ShowersQuantity().String()
+}
+
+// SnowfallQuantity returns the snowfall amount with its unit, in DisplayUnits.Snowfall if set
+func (w *CurrentWeather) SnowfallQuantity() Quantity {
+	return displayQuantity(w.Snowfall, w.Units.snowfall(), w.DisplayUnits.Snowfall)
 }
 
-// QuantityOfSnowfall returns the snowfall amount with its unit
+// QuantityOfSnowfall returns the snowfall amount formatted with its unit (see SnowfallQuantity)
 func (w *CurrentWeather) QuantityOfSnowfall() string {
-	return fmt.Sprintf("%.1f cm", w.Snowfall)
+	return w.SnowfallQuantity().String()
 }
 
-// QuantityOfCloudCover returns the cloud cover with its unit
+// CloudCoverQuantity returns the cloud cover with its unit
+func (w *CurrentWeather) CloudCoverQuantity() Quantity {
+	return displayQuantity(w.CloudCover, UnitPercent, "")
+}
+
+// QuantityOfCloudCover returns the cloud cover formatted with its unit (see CloudCoverQuantity)
 func (w *CurrentWeather) QuantityOfCloudCover() string {
-	return fmt.Sprintf("%.0f%%", w.CloudCover)
+	return w.CloudCoverQuantity().String()
 }
 
-// QuantityOfPressureMSL returns the mean sea level pressure with its unit
+// PressureMSLQuantity returns the mean sea level pressure with its unit, in DisplayUnits.Pressure if set
+func (w *CurrentWeather) PressureMSLQuantity() Quantity {
+	return displayQuantity(w.PressureMSL, UnitHectopascal, w.DisplayUnits.Pressure)
+}
+
+// QuantityOfPressureMSL returns the mean sea level pressure formatted with its unit (see PressureMSLQuantity)
 func (w *CurrentWeather) QuantityOfPressureMSL() string {
-	return fmt.Sprintf("%.1f hPa", w.PressureMSL)
+	return w.PressureMSLQuantity().String()
+}
+
+// SurfacePressureQuantity returns the surface pressure with its unit, in DisplayUnits.Pressure if set
+func (w *CurrentWeather) SurfacePressureQuantity() Quantity {
+	return displayQuantity(w.SurfacePressure, UnitHectopascal, w.DisplayUnits.Pressure)
 }
 
-// QuantityOfSurfacePressure returns the surface pressure with its unit
+// QuantityOfSurfacePressure returns the surface pressure formatted with its unit (see SurfacePressureQuantity)
 func (w *CurrentWeather) QuantityOfSurfacePressure() string {
-	return fmt.Sprintf("%.1f hPa", w.SurfacePressure)
+	return w.SurfacePressureQuantity().String()
+}
+
+// WindSpeedQuantity returns the wind speed with its unit, in DisplayUnits.WindSpeed if set
+func (w *CurrentWeather) WindSpeedQuantity() Quantity {
+	return displayQuantity(w.WindSpeed, w.Units.windSpeed(), w.DisplayUnits.WindSpeed)
 }
 
-// QuantityOfWindSpeed returns the wind speed with its unit
+// QuantityOfWindSpeed returns the wind speed formatted with its unit (see WindSpeedQuantity)
 func (w *CurrentWeather) QuantityOfWindSpeed() string {
-	return fmt.Sprintf("%.1f km/h", w.WindSpeed)
+	return w.WindSpeedQuantity().String()
 }
 
-// QuantityOfWindDirection returns the wind direction with its unit
+// WindDirectionQuantity returns the wind direction with its unit
+func (w *CurrentWeather) WindDirectionQuantity() Quantity {
+	return displayQuantity(w.WindDirection, UnitDegree, "")
+}
+
+// QuantityOfWindDirection returns the wind direction formatted with its unit (see WindDirectionQuantity)
 func (w *CurrentWeather) QuantityOfWindDirection() string {
-	return fmt.Sprintf("%.0f°", w.WindDirection)
+	return w.WindDirectionQuantity().String()
+}
+
+// WindGustsQuantity returns the wind gusts with its unit, in DisplayUnits.WindSpeed if set
+func (w *CurrentWeather) WindGustsQuantity() Quantity {
+	return displayQuantity(w.WindGusts, w.Units.windSpeed(), w.DisplayUnits.WindSpeed)
 }
 
-// QuantityOfWindGusts returns the wind gusts with its unit
+// QuantityOfWindGusts returns the wind gusts formatted with its unit (see WindGustsQuantity)
 func (w *CurrentWeather) QuantityOfWindGusts() string {
-	return fmt.Sprintf("%.1f km/h", w.WindGusts)
+	return w.WindGustsQuantity().String()
+}
+
+// DewPointQuantity returns the dew point with its unit, in DisplayUnits.Temperature if set
+func (w *CurrentWeather) DewPointQuantity() Quantity {
+	return displayQuantity(w.DewPoint, w.Units.temperature(), w.DisplayUnits.Temperature)
+}
+
+// QuantityOfDewPoint returns the dew point formatted with its unit (see DewPointQuantity)
+func (w *CurrentWeather) QuantityOfDewPoint() string {
+	return w.DewPointQuantity().String()
+}
+
+// VisibilityQuantity returns the visibility with its unit, in DisplayUnits.Visibility if set
+func (w *CurrentWeather) VisibilityQuantity() Quantity {
+	return displayQuantity(w.Visibility, UnitMeter, w.DisplayUnits.Visibility)
+}
+
+// QuantityOfVisibility returns the visibility formatted with its unit (see VisibilityQuantity)
+func (w *CurrentWeather) QuantityOfVisibility() string {
+	return w.VisibilityQuantity().String()
+}
+
+// VapourPressureDeficitQuantity returns the vapour pressure deficit with its unit
+func (w *CurrentWeather) VapourPressureDeficitQuantity() Quantity {
+	return displayQuantity(w.VapourPressureDeficit, UnitKilopascal, "")
+}
+
+// QuantityOfVapourPressureDeficit returns the vapour pressure deficit formatted with its unit (see VapourPressureDeficitQuantity)
+func (w *CurrentWeather) QuantityOfVapourPressureDeficit() string {
+	return w.VapourPressureDeficitQuantity().String()
+}
+
+// CAPEQuantity returns the convective available potential energy with its unit
+func (w *CurrentWeather) CAPEQuantity() Quantity {
+	return displayQuantity(w.CAPE, UnitJoulesPerKilogram, "")
+}
+
+// QuantityOfCAPE returns the convective available potential energy formatted with its unit (see CAPEQuantity)
+func (w *CurrentWeather) QuantityOfCAPE() string {
+	return w.CAPEQuantity().String()
 }
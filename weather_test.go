@@ -2,8 +2,11 @@ package openmeteo
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 	"time"
+
+	"github.com/gregbalnis/open-meteo-weather-sdk/units"
 )
 
 // TestCurrentWeather_JSONUnmarshaling tests JSON unmarshaling with complete API response
@@ -133,7 +136,7 @@ func TestCurrentWeather_JSONUnmarshalingWithNulls(t *testing.T) {
 func TestConvertToCurrentWeather(t *testing.T) {
 	c := NewClient()
 
-	timeStr := "2025-12-29T10:00"
+	timeStr := apiTimestamp("2025-12-29T10:00")
 	temp := 15.3
 	humidity := 65.0
 	windspeed := 12.5
@@ -155,7 +158,7 @@ func TestConvertToCurrentWeather(t *testing.T) {
 		},
 	}
 
-	weather := c.convertToCurrentWeather(apiResp)
+	weather := c.convertToCurrentWeather(apiResp, c.units)
 
 	if weather.Latitude != 52.52 {
 		t.Errorf("Expected latitude 52.52, got %.2f", weather.Latitude)
@@ -192,7 +195,7 @@ func TestConvertToCurrentWeather(t *testing.T) {
 func TestConvertToCurrentWeather_WithNulls(t *testing.T) {
 	c := NewClient()
 
-	timeStr := "2025-12-29T10:00"
+	timeStr := apiTimestamp("2025-12-29T10:00")
 	temp := 15.3
 
 	apiResp := weatherResponse{
@@ -205,7 +208,7 @@ func TestConvertToCurrentWeather_WithNulls(t *testing.T) {
 		},
 	}
 
-	weather := c.convertToCurrentWeather(apiResp)
+	weather := c.convertToCurrentWeather(apiResp, c.units)
 
 	if weather.Temperature != 15.3 {
 		t.Errorf("Expected temperature 15.3, got %.1f", weather.Temperature)
@@ -409,3 +412,199 @@ func TestCurrentWeather_QuantityMethods_NegativeValues(t *testing.T) {
 		})
 	}
 }
+
+// TestCurrentWeather_QuantityMethods_DisplayUnits tests display unit preferences
+func TestCurrentWeather_QuantityMethods_DisplayUnits(t *testing.T) {
+	weather := &CurrentWeather{
+		Temperature:         15.3,
+		ApparentTemperature: 14.1,
+		RelativeHumidity:    65.0,
+		Precipitation:       25.4,
+		Rain:                12.7,
+		Showers:             0.0,
+		Snowfall:            2.54,
+		PressureMSL:         1013.25,
+		SurfacePressure:     1010.0,
+		WindSpeed:           12.5,
+		WindDirection:       270.0,
+		WindGusts:           18.0,
+		DisplayUnits:        DisplayUnitsImperial,
+	}
+
+	tests := []struct {
+		name     string
+		method   func() string
+		expected string
+	}{
+		{"QuantityOfTemperature", weather.QuantityOfTemperature, "59.5°F"},
+		{"QuantityOfApparentTemperature", weather.QuantityOfApparentTemperature, "57.4°F"},
+		{"QuantityOfRelativeHumidity", weather.QuantityOfRelativeHumidity, "65%"},
+		{"QuantityOfPrecipitation", weather.QuantityOfPrecipitation, "1.0 inch"},
+		{"QuantityOfRain", weather.QuantityOfRain, "0.5 inch"},
+		{"QuantityOfShowers", weather.QuantityOfShowers, "0.0 inch"},
+		{"QuantityOfSnowfall", weather.QuantityOfSnowfall, "1.0 inch"},
+		{"QuantityOfPressureMSL", weather.QuantityOfPressureMSL, "29.9 inHg"},
+		{"QuantityOfSurfacePressure", weather.QuantityOfSurfacePressure, "29.8 inHg"},
+		{"QuantityOfWindSpeed", weather.QuantityOfWindSpeed, "7.8 mph"},
+		{"QuantityOfWindDirection", weather.QuantityOfWindDirection, "270°"},
+		{"QuantityOfWindGusts", weather.QuantityOfWindGusts, "11.2 mph"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.method(); result != tt.expected {
+				t.Errorf("%s() = %q, want %q", tt.name, result, tt.expected)
+			}
+		})
+	}
+
+	if weather.Temperature != 15.3 || weather.WindSpeed != 12.5 {
+		t.Error("Expected stored values to remain metric")
+	}
+}
+
+// TestCurrentWeather_QuantityMethods_IncompatibleDisplayUnit tests fallback for mismatched preferences
+func TestCurrentWeather_QuantityMethods_IncompatibleDisplayUnit(t *testing.T) {
+	weather := &CurrentWeather{
+		Temperature:  15.3,
+		WindSpeed:    18.0,
+		DisplayUnits: DisplayUnits{Temperature: UnitMillimeter, WindSpeed: UnitMetersPerSecond},
+	}
+
+	if got := weather.QuantityOfTemperature(); got != "15.3°C" {
+		t.Errorf("Expected fallback to °C, got %q", got)
+	}
+	if got := weather.QuantityOfWindSpeed(); got != "5.0 m/s" {
+		t.Errorf("Expected 5.0 m/s, got %q", got)
+	}
+}
+
+// TestCurrentWeather_QuantityMethods_Atmosphere tests formatting of dew point, visibility, VPD and CAPE
+func TestCurrentWeather_QuantityMethods_Atmosphere(t *testing.T) {
+	weather := &CurrentWeather{
+		DewPoint:              8.7,
+		Visibility:            24140.0,
+		VapourPressureDeficit: 0.61,
+		CAPE:                  120.0,
+	}
+
+	tests := []struct {
+		name     string
+		units    DisplayUnits
+		method   func() string
+		expected string
+	}{
+		{"QuantityOfDewPoint", DisplayUnitsMetric, weather.QuantityOfDewPoint, "8.7°C"},
+		{"QuantityOfVisibility", DisplayUnitsMetric, weather.QuantityOfVisibility, "24140 m"},
+		{"QuantityOfVapourPressureDeficit", DisplayUnitsMetric, weather.QuantityOfVapourPressureDeficit, "0.61 kPa"},
+		{"QuantityOfCAPE", DisplayUnitsMetric, weather.QuantityOfCAPE, "120 J/kg"},
+		{"QuantityOfDewPoint imperial", DisplayUnitsImperial, weather.QuantityOfDewPoint, "47.7°F"},
+		{"QuantityOfVisibility imperial", DisplayUnitsImperial, weather.QuantityOfVisibility, "15.0 mi"},
+		{"QuantityOfVisibility km", DisplayUnits{Visibility: UnitKilometer}, weather.QuantityOfVisibility, "24.1 km"},
+		{"QuantityOfCAPE imperial", DisplayUnitsImperial, weather.QuantityOfCAPE, "120 J/kg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weather.DisplayUnits = tt.units
+			if result := tt.method(); result != tt.expected {
+				t.Errorf("%s() = %q, want %q", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCurrentWeather_Measurements tests conversion of the fields into typed units
+func TestCurrentWeather_Measurements(t *testing.T) {
+	metric := &CurrentWeather{
+		Temperature:   15.3,
+		WindSpeed:     18.0,
+		WindDirection: 250,
+		Rain:          25.4,
+		Snowfall:      1.5,
+		Visibility:    24140.0,
+		PressureMSL:   1013.25,
+	}
+	m := metric.Measurements()
+	if got := m.Temperature.In(units.Fahrenheit); math.Abs(got-59.54) > 1e-9 {
+		t.Errorf("Expected 59.54°F, got %v", got)
+	}
+	if got := m.WindSpeed.In(units.MetersPerSecond); math.Abs(got-5) > 1e-9 {
+		t.Errorf("Expected 5 m/s, got %v", got)
+	}
+	if got := m.WindDirection.Compass(); got != "WSW" {
+		t.Errorf("Expected WSW, got %s", got)
+	}
+	if got := m.Rain.In(units.Inch); math.Abs(got-1) > 1e-9 {
+		t.Errorf("Expected 1 inch of rain, got %v", got)
+	}
+	if got := m.Snowfall.In(units.Millimeter); math.Abs(got-15) > 1e-9 {
+		t.Errorf("Expected 15 mm of snow, got %v", got)
+	}
+	if got := m.Visibility.Format(units.Kilometer); got != "24.1 km" {
+		t.Errorf("Expected 24.1 km, got %s", got)
+	}
+	if got := m.PressureMSL.Format(units.InchesOfMercury); got != "29.92 inHg" {
+		t.Errorf("Expected 29.92 inHg, got %s", got)
+	}
+
+	imperial := &CurrentWeather{Temperature: 59, WindSpeed: 10, Rain: 1, Snowfall: 2, Units: UnitsImperial}
+	m = imperial.Measurements()
+	if got := m.Temperature.String(); got != "15.0°C" {
+		t.Errorf("Expected 15.0°C, got %s", got)
+	}
+	if got := m.WindSpeed.In(units.MilesPerHour); math.Abs(got-10) > 1e-9 {
+		t.Errorf("Expected 10 mph, got %v", got)
+	}
+	if got := m.Rain.In(units.Millimeter); math.Abs(got-25.4) > 1e-9 {
+		t.Errorf("Expected 25.4 mm of rain, got %v", got)
+	}
+	if got := m.Snowfall.In(units.Centimeter); math.Abs(got-5.08) > 1e-9 {
+		t.Errorf("Expected 5.08 cm of snow, got %v", got)
+	}
+}
+
+// TestCurrentWeather_QuantityStructs tests the Quantity methods and their string wrappers
+func TestCurrentWeather_QuantityStructs(t *testing.T) {
+	weather := &CurrentWeather{
+		Temperature:   15.3,
+		WindSpeed:     18.0,
+		WindGusts:     36.0,
+		WindDirection: 250,
+		Snowfall:      1.5,
+		PressureMSL:   1013.25,
+		DisplayUnits:  DisplayUnits{WindSpeed: UnitMetersPerSecond},
+	}
+
+	tests := []struct {
+		name     string
+		quantity Quantity
+		str      string
+		want     Quantity
+	}{
+		{"Temperature", weather.TemperatureQuantity(), weather.QuantityOfTemperature(), Quantity{15.3, UnitCelsius}},
+		{"WindSpeed", weather.WindSpeedQuantity(), weather.QuantityOfWindSpeed(), Quantity{5, UnitMetersPerSecond}},
+		{"WindGusts", weather.WindGustsQuantity(), weather.QuantityOfWindGusts(), Quantity{10, UnitMetersPerSecond}},
+		{"WindDirection", weather.WindDirectionQuantity(), weather.QuantityOfWindDirection(), Quantity{250, UnitDegree}},
+		{"Snowfall", weather.SnowfallQuantity(), weather.QuantityOfSnowfall(), Quantity{1.5, UnitCentimeter}},
+		{"PressureMSL", weather.PressureMSLQuantity(), weather.QuantityOfPressureMSL(), Quantity{1013.25, UnitHectopascal}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.quantity.Unit != tt.want.Unit || math.Abs(tt.quantity.Value-tt.want.Value) > 1e-9 {
+				t.Errorf("Expected %+v, got %+v", tt.want, tt.quantity)
+			}
+			if tt.quantity.String() != tt.str {
+				t.Errorf("Expected string wrapper %q to match %q", tt.str, tt.quantity.String())
+			}
+		})
+	}
+
+	limit := Quantity{Value: 30, Unit: UnitKilometersPerHour}
+	if !weather.WindGustsQuantity().Above(limit) || weather.WindSpeedQuantity().Above(limit) {
+		t.Error("Expected only the gusts to exceed 30 km/h")
+	}
+	if f, err := weather.TemperatureQuantity().Convert(UnitFahrenheit); err != nil || f.String() != "59.5°F" {
+		t.Errorf("Expected 59.5°F, got %v (%v)", f, err)
+	}
+}
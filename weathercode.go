@@ -0,0 +1,102 @@
+package openmeteo
+
+// WeatherCode is a WMO weather interpretation code (0-99) as returned by the API, e.g., 0 for
+// a clear sky, 61 for slight rain or 95 for a thunderstorm. Daily and hourly weather_code
+// series hold the same codes as floats; convert them with WeatherCode(value). It is the type
+// of CurrentWeather.WeatherCode, which was an int in earlier releases; use int(code) where
+// an int is needed.
+type WeatherCode int
+
+// weatherCodeIcon holds the emoji and icon names of a weather code by day and by night.
+type weatherCodeIcon struct {
+	emoji, nightEmoji string
+	icon, nightIcon   string
+}
+
+// unknownIconName is the icon name of codes not listed in weatherCodeIcons.
+const unknownIconName = "wi-na"
+
+// weatherCodeIcons maps the WMO weather codes returned by the API to emoji and to the icon
+// names of the Weather Icons font (https://erikflowers.github.io/weather-icons/).
+var weatherCodeIcons = map[WeatherCode]weatherCodeIcon{
+	0:  {"☀️", "🌙", "wi-day-sunny", "wi-night-clear"},
+	1:  {"🌤️", "🌙", "wi-day-sunny-overcast", "wi-night-alt-partly-cloudy"},
+	2:  {"⛅", "☁️", "wi-day-cloudy", "wi-night-alt-cloudy"},
+	3:  {"☁️", "☁️", "wi-cloudy", "wi-cloudy"},
+	45: {"🌫️", "🌫️", "wi-day-fog", "wi-night-fog"},
+	48: {"🌫️", "🌫️", "wi-day-fog", "wi-night-fog"},
+	51: {"🌦️", "🌧️", "wi-day-sprinkle", "wi-night-alt-sprinkle"},
+	53: {"🌦️", "🌧️", "wi-day-sprinkle", "wi-night-alt-sprinkle"},
+	55: {"🌧️", "🌧️", "wi-day-sprinkle", "wi-night-alt-sprinkle"},
+	56: {"🌧️", "🌧️", "wi-day-sleet", "wi-night-alt-sleet"},
+	57: {"🌧️", "🌧️", "wi-day-sleet", "wi-night-alt-sleet"},
+	61: {"🌦️", "🌧️", "wi-day-rain", "wi-night-alt-rain"},
+	63: {"🌧️", "🌧️", "wi-day-rain", "wi-night-alt-rain"},
+	65: {"🌧️", "🌧️", "wi-day-rain", "wi-night-alt-rain"},
+	66: {"🌧️", "🌧️", "wi-day-sleet", "wi-night-alt-sleet"},
+	67: {"🌧️", "🌧️", "wi-day-sleet", "wi-night-alt-sleet"},
+	71: {"🌨️", "🌨️", "wi-day-snow", "wi-night-alt-snow"},
+	73: {"🌨️", "🌨️", "wi-day-snow", "wi-night-alt-snow"},
+	75: {"❄️", "❄️", "wi-day-snow", "wi-night-alt-snow"},
+	77: {"🌨️", "🌨️", "wi-day-snow", "wi-night-alt-snow"},
+	80: {"🌦️", "🌧️", "wi-day-showers", "wi-night-alt-showers"},
+	81: {"🌧️", "🌧️", "wi-day-showers", "wi-night-alt-showers"},
+	82: {"🌧️", "🌧️", "wi-day-showers", "wi-night-alt-showers"},
+	85: {"🌨️", "🌨️", "wi-day-snow", "wi-night-alt-snow"},
+	86: {"❄️", "❄️", "wi-day-snow", "wi-night-alt-snow"},
+	95: {"⛈️", "⛈️", "wi-day-thunderstorm", "wi-night-alt-thunderstorm"},
+	96: {"⛈️", "⛈️", "wi-day-hail", "wi-night-alt-hail"},
+	99: {"⛈️", "⛈️", "wi-day-hail", "wi-night-alt-hail"},
+}
+
+// Emoji returns an emoji for the weather code as seen during the day (e.g., "☀️" for a clear
+// sky or "⛈️" for a thunderstorm), or "" for codes the API does not use. Use NightEmoji or
+// CurrentWeather.Emoji for night-time variants.
+//
+// Example:
+//
+//	fmt.Println(openmeteo.WeatherCode(61).Emoji()) // "🌦️"
+func (c WeatherCode) Emoji() string {
+	return weatherCodeIcons[c].emoji
+}
+
+// NightEmoji returns an emoji for the weather code as seen at night, which shows a moon
+// instead of the sun for clear skies, or "" for codes the API does not use.
+func (c WeatherCode) NightEmoji() string {
+	return weatherCodeIcons[c].nightEmoji
+}
+
+// IconName returns the name of the icon of the weather code in the Weather Icons font
+// (https://erikflowers.github.io/weather-icons/), e.g., "wi-day-rain" or "wi-night-alt-rain".
+// The names are stable and can also key custom icon sets. Codes the API does not use return
+// "wi-na".
+//
+// Example:
+//
+//	icon := openmeteo.WeatherCode(2).IconName(false) // "wi-night-alt-cloudy"
+//	html := fmt.Sprintf(`<i class="wi %s"></i>`, icon)
+func (c WeatherCode) IconName(isDay bool) string {
+	icon, ok := weatherCodeIcons[c]
+	switch {
+	case !ok:
+		return unknownIconName
+	case isDay:
+		return icon.icon
+	default:
+		return icon.nightIcon
+	}
+}
+
+// Emoji returns the emoji of the weather code, taking day and night into account.
+func (w *CurrentWeather) Emoji() string {
+	if w.IsDay {
+		return w.WeatherCode.Emoji()
+	}
+	return w.WeatherCode.NightEmoji()
+}
+
+// IconName returns the icon name of the weather code (see WeatherCode.IconName), taking day
+// and night into account.
+func (w *CurrentWeather) IconName() string {
+	return w.WeatherCode.IconName(w.IsDay)
+}
@@ -0,0 +1,64 @@
+package openmeteo
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestWeatherCode_Icons tests emoji and icon names by day and night
+func TestWeatherCode_Icons(t *testing.T) {
+	tests := []struct {
+		code       WeatherCode
+		emoji      string
+		nightEmoji string
+		icon       string
+		nightIcon  string
+	}{
+		{0, "☀️", "🌙", "wi-day-sunny", "wi-night-clear"},
+		{2, "⛅", "☁️", "wi-day-cloudy", "wi-night-alt-cloudy"},
+		{3, "☁️", "☁️", "wi-cloudy", "wi-cloudy"},
+		{45, "🌫️", "🌫️", "wi-day-fog", "wi-night-fog"},
+		{61, "🌦️", "🌧️", "wi-day-rain", "wi-night-alt-rain"},
+		{66, "🌧️", "🌧️", "wi-day-sleet", "wi-night-alt-sleet"},
+		{75, "❄️", "❄️", "wi-day-snow", "wi-night-alt-snow"},
+		{95, "⛈️", "⛈️", "wi-day-thunderstorm", "wi-night-alt-thunderstorm"},
+		{99, "⛈️", "⛈️", "wi-day-hail", "wi-night-alt-hail"},
+		{4, "", "", "wi-na", "wi-na"},
+		{-1, "", "", "wi-na", "wi-na"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.Emoji(); got != tt.emoji {
+			t.Errorf("WeatherCode(%d).Emoji() = %q, want %q", tt.code, got, tt.emoji)
+		}
+		if got := tt.code.NightEmoji(); got != tt.nightEmoji {
+			t.Errorf("WeatherCode(%d).NightEmoji() = %q, want %q", tt.code, got, tt.nightEmoji)
+		}
+		if got := tt.code.IconName(true); got != tt.icon {
+			t.Errorf("WeatherCode(%d).IconName(true) = %q, want %q", tt.code, got, tt.icon)
+		}
+		if got := tt.code.IconName(false); got != tt.nightIcon {
+			t.Errorf("WeatherCode(%d).IconName(false) = %q, want %q", tt.code, got, tt.nightIcon)
+		}
+	}
+
+	for code, icon := range weatherCodeIcons {
+		if icon.emoji == "" || icon.nightEmoji == "" {
+			t.Errorf("Expected emoji for code %d", code)
+		}
+		if !strings.HasPrefix(icon.icon, "wi-") || !strings.HasPrefix(icon.nightIcon, "wi-") || strings.Contains(icon.nightIcon, "-day-") {
+			t.Errorf("Unexpected icon names for code %d: %q, %q", code, icon.icon, icon.nightIcon)
+		}
+	}
+}
+
+// TestCurrentWeather_Icons tests that the current conditions pick day or night variants
+func TestCurrentWeather_Icons(t *testing.T) {
+	weather := &CurrentWeather{WeatherCode: 1, IsDay: true}
+	if weather.Emoji() != "🌤️" || weather.IconName() != "wi-day-sunny-overcast" {
+		t.Errorf("Expected day variants, got %q and %q", weather.Emoji(), weather.IconName())
+	}
+	weather.IsDay = false
+	if weather.Emoji() != "🌙" || weather.IconName() != "wi-night-alt-partly-cloudy" {
+		t.Errorf("Expected night variants, got %q and %q", weather.Emoji(), weather.IconName())
+	}
+}
@@ -0,0 +1,111 @@
+package openmeteo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/gregbalnis/open-meteo-weather-sdk/units"
+)
+
+// CompassPoint returns the 16-point compass abbreviation (e.g., "NNE") of a direction in
+// degrees, where 0 is north and directions increase clockwise. Values outside 0-360 are
+// wrapped. It returns "" for NaN.
+//
+// Example:
+//
+//	fmt.Println(openmeteo.CompassPoint(weather.WindDirection)) // e.g. "WSW"
+func CompassPoint(degrees float64) string {
+	return units.Direction(degrees).Compass()
+}
+
+// Compass returns the value of a direction variable (e.g., DailyWindDirection10mDominant or
+// HourlyWindDirection10m) at step i as a 16-point compass abbreviation, or "" if missing.
+//
+// Example:
+//
+//	for i, day := range f.Daily.TimesInLocal() {
+//	    fmt.Printf("%s: %s, gusts up to %.0f km/h\n", day.Format("Mon"),
+//	        f.Daily.Compass(openmeteo.DailyWindDirection10mDominant, i),
+//	        f.Daily.Get(openmeteo.DailyWindGusts10mMax)[i])
+//	}
+func (s *Series) Compass(v Variable, i int) string {
+	return CompassPoint(s.valueAt(v, i))
+}
+
+// WindCompass returns the wind direction as a 16-point compass abbreviation (e.g., "WSW").
+func (w *CurrentWeather) WindCompass() string {
+	return CompassPoint(w.WindDirection)
+}
+
+// windHeights maps the measurement heights of hourly wind speed variables in meters
+var windHeights = map[float64]Variable{
+	10:  HourlyWindSpeed10m,
+	80:  HourlyWindSpeed80m,
+	120: HourlyWindSpeed120m,
+	180: HourlyWindSpeed180m,
+}
+
+// HubHeightWindVariables lists the wind speed and direction variables from 10 m up to 180 m,
+// for use with GetHourlyForecast and Series.WindSpeedAt.
+var HubHeightWindVariables = []Variable{
+	HourlyWindSpeed10m, HourlyWindSpeed80m, HourlyWindSpeed120m, HourlyWindSpeed180m,
+	HourlyWindDirection10m, HourlyWindDirection80m, HourlyWindDirection120m, HourlyWindDirection180m,
+}
+
+// WindSpeedAt estimates the wind speed at a height in meters (e.g., the hub height of a
+// turbine) from the wind speed variables of the series at 10, 80, 120 and 180 m. At a
+// measured height the value is returned as is; in between, and beyond the measured range,
+// it follows the wind profile power law v = v₁ (h/h₁)^α, with the shear exponent α fitted to
+// the two nearest heights of each step. Steps with fewer than two values are NaN, as are all
+// steps for heights that are not positive.
+//
+// Example:
+//
+//	f, err := client.GetHourlyForecast(ctx, 54.5, 8.3, openmeteo.HubHeightWindVariables)
+//	if err != nil {
+//	    return err
+//	}
+//	hub := f.Hourly.WindSpeedAt(100)
+func (s *Series) WindSpeedAt(height float64) []float64 {
+	speeds := make([]float64, s.Len())
+	for i := range speeds {
+		speeds[i] = s.windSpeedAt(height, i)
+	}
+	return speeds
+}
+
+// windSpeedAt estimates the wind speed at a height at step i (see WindSpeedAt).
+func (s *Series) windSpeedAt(height float64, i int) float64 {
+	if !(height > 0) {
+		return math.NaN()
+	}
+	type reading struct{ height, speed float64 }
+	var readings []reading
+	for h, v := range windHeights {
+		speed := s.valueAt(v, i)
+		if math.IsNaN(speed) {
+			continue
+		}
+		if h == height {
+			return speed
+		}
+		readings = append(readings, reading{h, speed})
+	}
+	if len(readings) < 2 {
+		return math.NaN()
+	}
+	sort.Slice(readings, func(a, b int) bool { return readings[a].height < readings[b].height })
+
+	// Pick the bracketing pair, or the outermost pair when extrapolating
+	j := sort.Search(len(readings), func(k int) bool { return readings[k].height > height })
+	j = min(max(j, 1), len(readings)-1)
+	lo, hi := readings[j-1], readings[j]
+
+	if lo.speed <= 0 || hi.speed <= 0 {
+		// The power law is undefined for calm air; interpolate over log height instead
+		f := math.Log(height/lo.height) / math.Log(hi.height/lo.height)
+		return math.Max(0, lo.speed+f*(hi.speed-lo.speed))
+	}
+	alpha := math.Log(hi.speed/lo.speed) / math.Log(hi.height/lo.height)
+	return lo.speed * math.Pow(height/lo.height, alpha)
+}
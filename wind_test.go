@@ -0,0 +1,99 @@
+package openmeteo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestCompassPoint tests conversion of degrees to 16-point compass abbreviations
+func TestCompassPoint(t *testing.T) {
+	tests := []struct {
+		degrees float64
+		want    string
+	}{
+		{0, "N"},
+		{11.24, "N"},
+		{11.25, "NNE"},
+		{45, "NE"},
+		{90, "E"},
+		{180, "S"},
+		{250, "WSW"},
+		{270, "W"},
+		{348.75, "N"},
+		{360, "N"},
+		{-90, "W"},
+		{720 + 135, "SE"},
+		{math.NaN(), ""},
+		{math.Inf(1), ""},
+	}
+	for _, tt := range tests {
+		if got := CompassPoint(tt.degrees); got != tt.want {
+			t.Errorf("CompassPoint(%v) = %q, want %q", tt.degrees, got, tt.want)
+		}
+	}
+}
+
+// TestSeries_Compass tests compass-formatted access to direction variables
+func TestSeries_Compass(t *testing.T) {
+	s := dailySeries(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		DailyWindDirection10mDominant: {200, math.NaN()},
+	}, map[Variable]string{DailyWindDirection10mDominant: "°"})
+
+	if got := s.Compass(DailyWindDirection10mDominant, 0); got != "SSW" {
+		t.Errorf("Expected SSW, got %q", got)
+	}
+	if got := s.Compass(DailyWindDirection10mDominant, 1); got != "" {
+		t.Errorf("Expected empty string for missing value, got %q", got)
+	}
+	if got := s.Compass(DailyWindDirection10mDominant, 5); got != "" {
+		t.Errorf("Expected empty string out of range, got %q", got)
+	}
+
+	w := CurrentWeather{WindDirection: 270}
+	if got := w.WindCompass(); got != "W" {
+		t.Errorf("Expected W, got %q", got)
+	}
+}
+
+// TestSeries_WindSpeedAt tests hub-height wind estimates from the wind profile
+func TestSeries_WindSpeedAt(t *testing.T) {
+	nan := math.NaN()
+	s := hourlySeries(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), map[Variable][]float64{
+		HourlyWindSpeed10m:  {10, 10, nan, 0},
+		HourlyWindSpeed80m:  {20, nan, nan, 10},
+		HourlyWindSpeed120m: {22, nan, nan, 12},
+		HourlyWindSpeed180m: {25, nan, 30, 15},
+	})
+
+	// Exact height
+	if got := s.WindSpeedAt(120)[0]; got != 22 {
+		t.Errorf("Expected 22 at 120 m, got %v", got)
+	}
+
+	// Power law between 80 and 120 m
+	alpha := math.Log(22.0/20) / math.Log(120.0/80)
+	want := 20 * math.Pow(100.0/80, alpha)
+	if got := s.WindSpeedAt(100)[0]; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected %.3f at 100 m, got %.3f", want, got)
+	}
+
+	// Extrapolation above 180 m uses the 120-180 m shear
+	if got := s.WindSpeedAt(200)[0]; got <= 25 {
+		t.Errorf("Expected more than 25 above 180 m, got %v", got)
+	}
+
+	speeds := s.WindSpeedAt(100)
+	if !math.IsNaN(speeds[1]) || !math.IsNaN(speeds[2]) {
+		t.Errorf("Expected NaN with fewer than two heights, got %v", speeds)
+	}
+
+	// Calm air at 10 m falls back to log-height interpolation
+	if got := s.WindSpeedAt(40)[3]; got < 0 || got > 10 {
+		t.Errorf("Expected a value between 0 and 10 at 40 m, got %v", got)
+	}
+
+	if got := s.WindSpeedAt(0); !math.IsNaN(got[0]) {
+		t.Errorf("Expected NaN for a zero height, got %v", got)
+	}
+}
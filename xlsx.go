@@ -0,0 +1,299 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cell styles defined in xlsxStyles.
+const (
+	xlsxStyleDefault = iota
+	xlsxStyleHeader
+	xlsxStyleDateTime
+	xlsxStyleDate
+)
+
+// xlsxEpoch is day zero of the spreadsheet date system (serial 1 is 1900-01-01, counting
+// the non-existent 1900-02-29).
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// xlsxCell is a single cell value: a string, a number or a timestamp.
+type xlsxCell struct {
+	text  string
+	num   float64
+	style int
+	isNum bool
+}
+
+// xlsxPart is a file of the workbook package.
+type xlsxPart struct {
+	name    string
+	content string
+}
+
+// xlsxSheet is a worksheet with a header row.
+type xlsxSheet struct {
+	name   string
+	header []string
+	rows   [][]xlsxCell
+	widths []float64
+}
+
+// WriteXLSX writes the blocks of a forecast as an Excel workbook (.xlsx) to w, with one sheet
+// per block that contains data: "Current", "Hourly" and "Daily". Series sheets have a time
+// column followed by one column per variable, headed with the variable name and unit.
+// Timestamps are written as local times in the forecast's time zone (see WithTimezone) and
+// formatted as dates; missing values are left empty. The header row is bold and frozen.
+//
+// Example:
+//
+//	f, err := client.GetForecast(ctx, openmeteo.ForecastRequest{Latitude: 52.52, Longitude: 13.41,
+//	    Current: true, Hourly: []openmeteo.Variable{openmeteo.HourlyTemperature2m}})
+//	if err != nil {
+//	    return err
+//	}
+//	out, err := os.Create("berlin.xlsx")
+//	if err != nil {
+//	    return err
+//	}
+//	defer out.Close()
+//	return openmeteo.WriteXLSX(out, f)
+func WriteXLSX(w io.Writer, f *Forecast) error {
+	var sheets []xlsxSheet
+	if f.Current != nil {
+		sheets = append(sheets, currentSheet(f.Current))
+	}
+	if f.Hourly.Len() > 0 {
+		sheets = append(sheets, seriesSheet("Hourly", &f.Hourly, xlsxStyleDateTime))
+	}
+	if f.Daily.Len() > 0 {
+		sheets = append(sheets, seriesSheet("Daily", &f.Daily, xlsxStyleDate))
+	}
+	if len(sheets) == 0 {
+		return fmt.Errorf("forecast has no data to export")
+	}
+
+	z := zip.NewWriter(w)
+	parts := []xlsxPart{
+		{"[Content_Types].xml", xlsxContentTypes(len(sheets))},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook(sheets)},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels(len(sheets))},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for i, sheet := range sheets {
+		parts = append(parts, xlsxPart{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheet.xml()})
+	}
+	for _, part := range parts {
+		fw, err := z.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fw, part.content); err != nil {
+			return err
+		}
+	}
+	return z.Close()
+}
+
+// currentSheet lists the current conditions as variable/value/unit rows, labelled with the
+// units the API returned them in.
+func currentSheet(c *CurrentWeather) xlsxSheet {
+	temperature, windSpeed, precipitation := string(c.Units.temperature()), string(c.Units.windSpeed()), string(c.Units.precipitation())
+	sheet := xlsxSheet{name: "Current", header: []string{"Variable", "Value", "Unit"}, widths: []float64{22, 18, 8}}
+	sheet.rows = append(sheet.rows, []xlsxCell{textCell("time"), timeCell(c.TimeInLocal(), xlsxStyleDateTime), textCell(c.TimeInLocal().Format("MST"))})
+	fields := []struct {
+		name  string
+		value float64
+		unit  string
+	}{
+		{"temperature_2m", c.Temperature, temperature},
+		{"relative_humidity_2m", c.RelativeHumidity, "%"},
+		{"apparent_temperature", c.ApparentTemperature, temperature},
+		{"is_day", boolValue(c.IsDay), ""},
+		{"precipitation", c.Precipitation, precipitation},
+		{"rain", c.Rain, precipitation},
+		{"showers", c.Showers, precipitation},
+		{"snowfall", c.Snowfall, string(c.Units.snowfall())},
+		{"weather_code", float64(c.WeatherCode), "wmo code"},
+		{"cloud_cover", c.CloudCover, "%"},
+		{"pressure_msl", c.PressureMSL, "hPa"},
+		{"surface_pressure", c.SurfacePressure, "hPa"},
+		{"wind_speed_10m", c.WindSpeed, windSpeed},
+		{"wind_direction_10m", c.WindDirection, "°"},
+		{"wind_gusts_10m", c.WindGusts, windSpeed},
+		{"dew_point_2m", c.DewPoint, temperature},
+		{"visibility", c.Visibility, "m"},
+		{"vapour_pressure_deficit", c.VapourPressureDeficit, "kPa"},
+		{"cape", c.CAPE, "J/kg"},
+	}
+	for _, field := range fields {
+		sheet.rows = append(sheet.rows, []xlsxCell{textCell(field.name), numberCell(field.value), textCell(field.unit)})
+	}
+	return sheet
+}
+
+// seriesSheet lays out a series with one row per time step, variables sorted by name.
+func seriesSheet(name string, s *Series, timeStyle int) xlsxSheet {
+	vars := make([]Variable, 0, len(s.Values))
+	for v := range s.Values {
+		vars = append(vars, v)
+	}
+	slices.Sort(vars)
+
+	sheet := xlsxSheet{name: name, header: []string{"time"}, widths: []float64{18}}
+	for _, v := range vars {
+		header := string(v)
+		if unit := s.Unit(v); unit != "" {
+			header += " (" + unit + ")"
+		}
+		sheet.header = append(sheet.header, header)
+		sheet.widths = append(sheet.widths, math.Max(12, float64(len([]rune(header)))+2))
+	}
+	for i, t := range s.TimesInLocal() {
+		row := []xlsxCell{timeCell(t, timeStyle)}
+		for _, v := range vars {
+			row = append(row, numberCell(s.valueAt(v, i)))
+		}
+		sheet.rows = append(sheet.rows, row)
+	}
+	return sheet
+}
+
+// textCell returns a text cell.
+func textCell(s string) xlsxCell { return xlsxCell{text: s} }
+
+// numberCell returns a numeric cell, or an empty one for missing (NaN) values.
+func numberCell(v float64) xlsxCell {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return xlsxCell{}
+	}
+	return xlsxCell{num: v, isNum: true}
+}
+
+// timeCell returns a date cell holding the wall-clock time of t as a spreadsheet serial.
+func timeCell(t time.Time, style int) xlsxCell {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	return xlsxCell{num: wall.Sub(xlsxEpoch).Hours() / 24, isNum: true, style: style}
+}
+
+// boolValue returns 1 for true and 0 for false, matching the API's encoding of is_day.
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// xlsxColumn returns the column letters of the zero-based column index (0 = "A", 26 = "AA").
+func xlsxColumn(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// xml renders the worksheet part.
+func (s xlsxSheet) xml() string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	b.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	b.WriteString(`<cols>`)
+	for i, width := range s.widths {
+		fmt.Fprintf(&b, `<col min="%d" max="%d" width="%g" customWidth="1"/>`, i+1, i+1, width)
+	}
+	b.WriteString(`</cols><sheetData>`)
+
+	header := make([]xlsxCell, len(s.header))
+	for i, h := range s.header {
+		header[i] = xlsxCell{text: h, style: xlsxStyleHeader}
+	}
+	for r, row := range append([][]xlsxCell{header}, s.rows...) {
+		fmt.Fprintf(&b, `<row r="%d">`, r+1)
+		for c, cell := range row {
+			ref := xlsxColumn(c) + strconv.Itoa(r+1)
+			switch {
+			case cell.isNum:
+				fmt.Fprintf(&b, `<c r="%s" s="%d"><v>%s</v></c>`, ref, cell.style, strconv.FormatFloat(cell.num, 'g', -1, 64))
+			case cell.text != "":
+				fmt.Fprintf(&b, `<c r="%s" s="%d" t="inlineStr"><is><t>`, ref, cell.style)
+				_ = xml.EscapeText(&b, []byte(cell.text))
+				b.WriteString(`</t></is></c>`)
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// xlsxContentTypes renders the content types part declaring the workbook, styles and sheets.
+func xlsxContentTypes(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+// xlsxWorkbook renders the workbook part listing the sheets by name.
+func xlsxWorkbook(sheets []xlsxSheet) string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, sheet := range sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, sheet.name, i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+// xlsxWorkbookRels renders the workbook relationships to the sheet and styles parts.
+func xlsxWorkbookRels(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheets+1)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+// xlsxRootRels is the package relationships part pointing at the workbook.
+const xlsxRootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// xlsxStyles defines the cell formats in the order of the xlsxStyle constants.
+const xlsxStyles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="2"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm"/><numFmt numFmtId="165" formatCode="yyyy-mm-dd"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs></styleSheet>`
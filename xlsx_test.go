@@ -0,0 +1,152 @@
+//go:build !openmeteo_lean
+
+package openmeteo
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readXLSX returns the parts of a workbook, checking that each is well-formed XML
+func readXLSX(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Expected a zip archive, got %v", err)
+	}
+	parts := make(map[string]string)
+	for _, f := range z.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(r)
+		r.Close()
+		d := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			if _, err := d.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("Part %s is not well-formed: %v", f.Name, err)
+			}
+		}
+		parts[f.Name] = string(content)
+	}
+	return parts
+}
+
+// TestWriteXLSX tests the workbook layout for all three blocks
+func TestWriteXLSX(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	hourly := hourlySeries(start, map[Variable][]float64{
+		HourlyTemperature2m: {18.5, math.NaN()},
+		HourlyPrecipitation: {0, 1.2},
+	})
+	hourly.Units = map[Variable]string{HourlyTemperature2m: "°C", HourlyPrecipitation: "mm"}
+	hourly.Location = berlin
+	f := &Forecast{
+		Current: &CurrentWeather{Time: start, Location: berlin, Temperature: 18.5, WeatherCode: 3},
+		Hourly:  hourly,
+		Daily: Series{
+			Time:     []time.Time{time.Date(2025, 5, 31, 22, 0, 0, 0, time.UTC)},
+			Values:   map[Variable][]float64{DailyTemperature2mMax: {24}},
+			Units:    map[Variable]string{DailyTemperature2mMax: "°C & more"},
+			Location: berlin,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteXLSX(&buf, f); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	parts := readXLSX(t, buf.Bytes())
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet3.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("Expected part %s", name)
+		}
+	}
+	for _, name := range []string{`name="Current"`, `name="Hourly"`, `name="Daily"`} {
+		if !strings.Contains(parts["xl/workbook.xml"], name) {
+			t.Errorf("Expected sheet %s in workbook", name)
+		}
+	}
+
+	current := parts["xl/worksheets/sheet1.xml"]
+	if !strings.Contains(current, `<t>temperature_2m</t></is></c><c r="B3" s="0"><v>18.5</v>`) {
+		t.Errorf("Expected temperature row in current sheet, got %s", current)
+	}
+
+	// 12:00 local time on 2025-06-01 is serial 45809.5
+	hourlySheet := parts["xl/worksheets/sheet2.xml"]
+	for _, want := range []string{
+		`<c r="A1" s="1" t="inlineStr"><is><t>time</t></is></c>`,
+		`<t>precipitation (mm)</t>`,
+		`<c r="A2" s="2"><v>45809.5</v></c>`,
+		`<row r="3"><c r="A3" s="2"><v>45809.541666666664</v></c><c r="B3" s="0"><v>1.2</v></c></row>`,
+		`state="frozen"`,
+	} {
+		if !strings.Contains(hourlySheet, want) {
+			t.Errorf("Expected %s in hourly sheet, got %s", want, hourlySheet)
+		}
+	}
+
+	daily := parts["xl/worksheets/sheet3.xml"]
+	if !strings.Contains(daily, `<c r="A2" s="3"><v>45809</v></c>`) || !strings.Contains(daily, `°C &amp; more`) {
+		t.Errorf("Unexpected daily sheet %s", daily)
+	}
+}
+
+// TestWriteXLSX_Units tests that the sheets label values with the units in use, including
+// the units of series parsed from the API's wire format
+func TestWriteXLSX_Units(t *testing.T) {
+	hourly, err := parseSeries(map[string]json.RawMessage{
+		"time":           json.RawMessage(`["2025-06-01T10:00"]`),
+		"wind_speed_10m": json.RawMessage(`[7.8]`),
+	}, map[string]string{"wind_speed_10m": "mp/h"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	f := &Forecast{Current: &CurrentWeather{Time: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC), Units: UnitsImperial}, Hourly: hourly}
+
+	var buf bytes.Buffer
+	if err := WriteXLSX(&buf, f); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	current := readXLSX(t, buf.Bytes())["xl/worksheets/sheet1.xml"]
+	for _, want := range []string{"°F", "mph", "inch"} {
+		if !strings.Contains(current, "<t>"+want+"</t>") {
+			t.Errorf("Expected unit %s in current sheet, got %s", want, current)
+		}
+	}
+	if strings.Contains(current, "<t>°C</t>") || strings.Contains(current, "<t>km/h</t>") {
+		t.Errorf("Expected no metric units in current sheet, got %s", current)
+	}
+	if hourlySheet := readXLSX(t, buf.Bytes())["xl/worksheets/sheet2.xml"]; !strings.Contains(hourlySheet, "<t>wind_speed_10m (mph)</t>") {
+		t.Errorf("Expected the API's mp/h as mph in the hourly sheet, got %s", hourlySheet)
+	}
+}
+
+// TestWriteXLSX_Empty tests that an empty forecast is rejected
+func TestWriteXLSX_Empty(t *testing.T) {
+	if err := WriteXLSX(io.Discard, &Forecast{}); err == nil {
+		t.Error("Expected an error for an empty forecast")
+	}
+}
+
+// TestXLSXColumn tests column letters beyond Z
+func TestXLSXColumn(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 702: "AAA"} {
+		if got := xlsxColumn(i); got != want {
+			t.Errorf("xlsxColumn(%d) = %s, want %s", i, got, want)
+		}
+	}
+}